@@ -112,6 +112,7 @@ var maccPerms = map[string][]string{
 	ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
 	fantokentypes.ModuleName:       {authtypes.Minter, authtypes.Burner},
 	wasmtypes.ModuleName:           {authtypes.Burner},
+	cadancetypes.ModuleName:        {authtypes.Burner},
 }
 
 type AppKeepers struct {
@@ -239,11 +240,16 @@ func NewAppKeepers(
 	appKeepers.AuthenticatorManager = authenticator.NewAuthenticatorManager()
 	appKeepers.AuthenticatorManager.InitializeAuthenticators([]authenticator.Authenticator{
 		authenticator.NewSignatureVerification(appKeepers.AccountKeeper),
+		authenticator.NewEd25519SignatureVerification(appKeepers.AccountKeeper),
 		authenticator.NewMessageFilter(encodingConfig),
+		authenticator.NewWebAuthn(appKeepers.AccountKeeper),
 		authenticator.NewAllOf(appKeepers.AuthenticatorManager),
 		authenticator.NewAnyOf(appKeepers.AuthenticatorManager),
 		authenticator.NewPartitionedAnyOf(appKeepers.AuthenticatorManager),
 		authenticator.NewPartitionedAllOf(appKeepers.AuthenticatorManager),
+		authenticator.NewThreshold(appKeepers.AuthenticatorManager),
+		authenticator.NewSpendLimit(appKeepers.keys[smartaccounttypes.StoreKey], appKeepers.BankKeeper),
+		authenticator.NewTimeWindow(appKeepers.AuthenticatorManager),
 	})
 	govModuleAddr := appKeepers.AccountKeeper.GetModuleAddress(govtypes.ModuleName)
 
@@ -296,6 +302,19 @@ func NewAppKeepers(
 		appKeepers.StakingKeeper, appKeepers.UpgradeKeeper, appKeepers.ScopedIBCKeeper, govModAddress,
 	)
 
+	appKeepers.FanTokenKeeper = fantokenkeeper.NewKeeper(
+		appCodec,
+		keys[fantokentypes.StoreKey],
+		appKeepers.GetSubspace(fantokentypes.ModuleName),
+		appKeepers.AccountKeeper,
+		appKeepers.BankKeeper,
+		appKeepers.DistrKeeper,
+		BlockedAddrs(),
+	)
+
+	// block the transfers of expired fan tokens
+	appKeepers.BankKeeper.AppendSendRestriction(appKeepers.FanTokenKeeper.SendRestrictionFn)
+
 	// Configure the ibchooks keeper
 	hooksKeeper := ibchookskeeper.NewKeeper(
 		appKeepers.keys[ibchookstypes.StoreKey],
@@ -321,9 +340,12 @@ func NewAppKeepers(
 		govModAddress,
 	)
 
+	// The fan token middleware enforces the fan tokens IBC transfer policy before the packets reach the PFM
+	fanTokenICS4Wrapper := fantoken.NewICS4Middleware(appKeepers.PacketForwardKeeper, appKeepers.FanTokenKeeper)
+
 	appKeepers.TransferKeeper = ibctransferkeeper.NewKeeper(
 		appCodec, keys[ibctransfertypes.StoreKey], appKeepers.GetSubspace(ibctransfertypes.ModuleName),
-		appKeepers.PacketForwardKeeper,
+		fanTokenICS4Wrapper,
 		appKeepers.IBCKeeper.ChannelKeeper, appKeepers.IBCKeeper.PortKeeper,
 		appKeepers.AccountKeeper, appKeepers.BankKeeper,
 		appKeepers.ScopedTransferKeeper, govModAddress,
@@ -335,6 +357,7 @@ func NewAppKeepers(
 	var transferStack porttypes.IBCModule
 	const middlewareTimeoutRetry = 0
 	transferStack = transfer.NewIBCModule(appKeepers.TransferKeeper)
+	transferStack = fantoken.NewIBCMiddleware(transferStack, fanTokenICS4Wrapper)
 	transferStack = ibchooks.NewIBCMiddleware(transferStack, &appKeepers.HooksICS4Wrapper)
 	transferStack = packetforward.NewIBCMiddleware(
 		transferStack,
@@ -373,16 +396,6 @@ func NewAppKeepers(
 	// If evidence needs to be handled for the app, set routes in router here and seal
 	appKeepers.EvidenceKeeper = *evidenceKeeper
 
-	appKeepers.FanTokenKeeper = fantokenkeeper.NewKeeper(
-		appCodec,
-		keys[fantokentypes.StoreKey],
-		appKeepers.GetSubspace(fantokentypes.ModuleName),
-		appKeepers.AccountKeeper,
-		appKeepers.BankKeeper,
-		appKeepers.DistrKeeper,
-		BlockedAddrs(),
-	)
-
 	// Stargate Queries
 	acceptedStargateQueries := wasmkeeper.AcceptedQueries{
 		// ibc
@@ -462,6 +475,9 @@ func NewAppKeepers(
 		appCodec,
 		appKeepers.WasmKeeper,
 		appKeepers.ContractKeeper,
+		appKeepers.BankKeeper,
+		appKeepers.DistrKeeper,
+		appKeepers.StakingKeeper,
 		govModAddress,
 	)
 
@@ -0,0 +1,111 @@
+package app
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	simcli "github.com/cosmos/cosmos-sdk/x/simulation/client/cli"
+	stakingsim "github.com/cosmos/cosmos-sdk/x/staking/simulation"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bitsongofficial/go-bitsong/app/keepers"
+)
+
+func init() {
+	simcli.GetSimulatorFlags()
+}
+
+// fauxMerkleModeOpt returns a BaseApp option to use a dbStoreAdapter instead of
+// an IAVLStore for faster simulation speed.
+func fauxMerkleModeOpt(bapp *baseapp.BaseApp) {
+	bapp.SetFauxMerkleMode()
+}
+
+// simulationOperations retrieves the simulation params from the provided file path
+// and returns all the modules weighted operations
+func simulationOperations(app *BitsongApp, cdc codec.JSONCodec, config simtypes.Config) []simtypes.WeightedOperation {
+	simState := module.SimulationState{
+		AppParams: make(simtypes.AppParams),
+		Cdc:       cdc,
+		TxConfig:  app.GetTxConfig(),
+		BondDenom: sdk.DefaultBondDenom,
+	}
+
+	if config.ParamsFile != "" {
+		bz, err := os.ReadFile(config.ParamsFile)
+		if err != nil {
+			panic(err)
+		}
+
+		if err := json.Unmarshal(bz, &simState.AppParams); err != nil {
+			panic(err)
+		}
+	}
+
+	// the staking operations pick a random commission rate which is rejected by
+	// the MinValCommissionDecorator when lower than 5%
+	for _, op := range []string{stakingsim.OpWeightMsgCreateValidator, stakingsim.OpWeightMsgEditValidator} {
+		if _, ok := simState.AppParams[op]; !ok {
+			simState.AppParams[op] = json.RawMessage("0")
+		}
+	}
+
+	simState.LegacyProposalContents = app.SimulationManager().GetProposalContents(simState) //nolint:staticcheck // fantoken fees are updated through a legacy proposal
+	simState.ProposalMsgs = app.SimulationManager().GetProposalMsgs(simState)
+	return app.SimulationManager().WeightedOperations(simState)
+}
+
+// TestFullAppSimulation runs the randomized simulation of the app, it is skipped
+// unless enabled with:
+//
+//	go test ./app -run TestFullAppSimulation -Enabled=true -NumBlocks=100 -BlockSize=50 -Commit=true -Seed=1 -v
+func TestFullAppSimulation(t *testing.T) {
+	config := simcli.NewConfigFromFlags()
+	config.ChainID = SimAppChainID
+
+	db, dir, logger, skip, err := simtestutil.SetupSimulation(config, "leveldb-app-sim", "Simulation", simcli.FlagVerboseValue, simcli.FlagEnabledValue)
+	if skip {
+		t.Skip("skipping application simulation")
+	}
+	require.NoError(t, err, "simulation setup failed")
+
+	defer func() {
+		require.NoError(t, db.Close())
+		require.NoError(t, os.RemoveAll(dir))
+	}()
+
+	appOptions := make(simtestutil.AppOptionsMap, 0)
+	appOptions[flags.FlagHome] = dir
+	appOptions[server.FlagInvCheckPeriod] = simcli.FlagPeriodValue
+
+	app := NewBitsongApp(logger, db, nil, true, dir, appOptions, EmptyWasmOpts, fauxMerkleModeOpt, baseapp.SetChainID(SimAppChainID))
+	require.Equal(t, appName, app.Name())
+
+	// run randomized simulation
+	_, _, simErr := simulation.SimulateFromSeed(
+		t,
+		os.Stdout,
+		app.BaseApp,
+		simtestutil.AppStateFn(app.AppCodec(), app.SimulationManager(), NewDefaultGenesisState()),
+		simtypes.RandomAccounts,
+		simulationOperations(app, app.AppCodec(), config),
+		keepers.BlockedAddrs(),
+		config,
+		app.AppCodec(),
+	)
+	require.NoError(t, simErr)
+
+	if config.Commit {
+		simtestutil.PrintStats(db)
+	}
+}
@@ -6,7 +6,6 @@ import (
 	"cosmossdk.io/log"
 
 	storetypes "cosmossdk.io/store/types"
-	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -15,12 +14,31 @@ import (
 	"github.com/bitsongofficial/go-bitsong/x/cadance/types"
 )
 
-var endBlockSudoMessage = []byte(types.EndBlockSudoMessage)
+// BeginBlocker executes on contracts at the beginning of the block.
+func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
+
+	// Unjail the contracts whose cooldown has elapsed before executing them
+	if err := k.UnjailCooledDownContracts(ctx); err != nil {
+		k.Logger(ctx).Error("Failed to unjail contracts", "error", err)
+	}
+
+	executeContracts(ctx, k, types.ExecutionPhaseBeginBlock)
+}
 
 // EndBlocker executes on contracts at the end of the block.
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
 
+	executeContracts(ctx, k, types.ExecutionPhaseEndBlock)
+	executeSchedules(ctx, k)
+}
+
+// Execute the contracts registered for the current phase of the block with their sudo message.
+// The contracts are executed by decreasing priority and, within a priority, in round-robin order
+// within the block gas limit, the executions which do not fit are deferred to the next block, which
+// starts every priority from the first deferred contract.
+func executeContracts(ctx sdk.Context, k keeper.Keeper, phase types.ExecutionPhase) {
 	logger := k.Logger(ctx)
 	p := k.GetParams(ctx)
 
@@ -35,28 +53,92 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 	errorExecs := make([]string, len(contracts))
 	errorExists := false
 
+	// Execute the contracts by decreasing priority, starting the priority of the contract the
+	// previous execution of the phase stopped at from it
+	cursor := k.GetRoundRobinCursor(ctx, phase)
+	contracts = types.ExecutionOrder(contracts, cursor)
+
+	// Track the block gas budget and the first deferred contract
+	blockGasUsed := k.GetBlockGasUsed(ctx)
+	nextCursor := ""
+
 	// Execute all contracts that are not jailed
 	for idx, contract := range contracts {
 
-		// Skip jailed contracts
-		if contract.IsJailed {
+		// Skip jailed contracts and contracts registered for other phases
+		if contract.IsJailed || !contract.Phase.Includes(phase) {
 			continue
 		}
 
-		// Get sdk.AccAddress from contract address
-		contractAddr := sdk.MustAccAddressFromBech32(contract.ContractAddress)
-		if handleError(ctx, k, logger, errorExecs, &errorExists, err, idx, contract.ContractAddress) {
+		// Skip contracts which are not due, unless their execution was deferred
+		pending := k.IsPendingExecution(ctx, phase, contract.ContractAddress)
+		if !pending && !contract.IsDue(ctx.BlockHeight()) {
 			continue
 		}
 
-		// Create context with gas limit
-		childCtx := ctx.WithGasMeter(storetypes.NewGasMeter(p.ContractGasLimit))
+		// Skip contracts paused until their manager confirms a change of their code or manager
+		paused, err := k.CheckContractLifecycle(ctx, &contract)
+		if err != nil {
+			logger.Error("Failed to check contract lifecycle", "contract", contract.ContractAddress, "error", err)
+		}
+		if paused {
+			continue
+		}
 
-		// Execute contract
-		ExecuteContract(k.GetContractKeeper(), childCtx, contractAddr, endBlockSudoMessage, &err)
-		if handleError(ctx, k, logger, errorExecs, &errorExists, err, idx, contract.ContractAddress) {
+		// Defer the execution to the next block if it does not fit the block gas budget
+		gasLimit := contract.EffectiveGasLimit(p)
+		if p.BlockGasLimit > 0 && blockGasUsed+gasLimit > p.BlockGasLimit {
+			if nextCursor == "" {
+				nextCursor = contract.ContractAddress
+			}
+			k.SetPendingExecution(ctx, phase, contract.ContractAddress, true)
 			continue
 		}
+		if pending {
+			k.SetPendingExecution(ctx, phase, contract.ContractAddress, false)
+		}
+
+		// Get sdk.AccAddress from contract address
+		contractAddr, err := sdk.AccAddressFromBech32(contract.ContractAddress)
+		if handleError(ctx, k, logger, errorExecs, &errorExists, err, idx, contract.ContractAddress, phase, 0) {
+			continue
+		}
+
+		// Build the sudo message of the contract
+		msgBz, err := contract.BuildSudoMessage(phase, ctx.BlockHeight(), ctx.BlockTime())
+		if handleError(ctx, k, logger, errorExecs, &errorExists, err, idx, contract.ContractAddress, phase, 0) {
+			continue
+		}
+
+		// Create a cached context with gas limit, whose state and events are only committed when
+		// the execution succeeds
+		childCtx, writeCache := ctx.CacheContext()
+		childCtx = childCtx.WithGasMeter(storetypes.NewGasMeter(gasLimit))
+
+		// Execute contract, tracking the gas used in the block
+		keeper.ExecuteContract(k.GetContractKeeper(), childCtx, contractAddr, msgBz, &err)
+		gasUsed := childCtx.GasMeter().GasConsumedToLimit()
+		blockGasUsed += gasUsed
+		if !handleError(ctx, k, logger, errorExecs, &errorExists, err, idx, contract.ContractAddress, phase, gasUsed) {
+			// Commit the state and events of the execution, then record it
+			writeCache()
+			if err := k.RecordExecutionSuccess(ctx, contract.ContractAddress, phase, gasUsed); err != nil {
+				logger.Error("Failed to record contract execution", "contract", contract.ContractAddress, "error", err)
+			}
+		}
+
+		// Charge the gas used by the execution, whether it succeeded or not
+		if err := k.ChargeExecutionGas(ctx, contract.ContractAddress, gasUsed); err != nil {
+			logger.Error("Failed to charge contract execution gas", "contract", contract.ContractAddress, "error", err)
+		}
+	}
+
+	// Store the block gas used and the contract the next execution of the phase starts from
+	if p.BlockGasLimit > 0 {
+		k.SetBlockGasUsed(ctx, blockGasUsed)
+	}
+	if nextCursor != cursor {
+		k.SetRoundRobinCursor(ctx, phase, nextCursor)
 	}
 
 	// Log errors if present
@@ -75,6 +157,8 @@ func handleError(
 	err error,
 	idx int,
 	contractAddress string,
+	phase types.ExecutionPhase,
+	gasUsed uint64,
 ) bool {
 	// Check if error is present
 	if err != nil {
@@ -83,42 +167,103 @@ func handleError(
 		*errorExists = true
 		errorExecs[idx] = contractAddress
 
-		// Attempt to jail contract, log error if present
-		err := k.SetJailStatus(ctx, contractAddress, true)
-		if err != nil {
-			logger.Error("Failed to jail contract", "contract", contractAddress, "error", err)
+		// Record the failure and jail the contract once the failure threshold is reached, log error if present
+		if recordErr := k.RecordExecutionFailure(ctx, contractAddress, phase, gasUsed, err); recordErr != nil {
+			logger.Error("Failed to record contract failure", "contract", contractAddress, "error", recordErr)
 		}
 	}
 
 	return err != nil
 }
 
-// Execute contract, recover from panic
-func ExecuteContract(k wasmtypes.ContractOpsKeeper, childCtx sdk.Context, contractAddr sdk.AccAddress, msgBz []byte, err *error) {
-	// Recover from panic, return error
-	defer func() {
-		if recoveryError := recover(); recoveryError != nil {
-			// Determine error associated with panic
-			if isOutofGas, msg := IsOutOfGasError(recoveryError); isOutofGas {
-				*err = types.ErrOutOfGas.Wrapf("%s", msg)
-			} else {
-				*err = types.ErrContractExecutionPanic.Wrapf("%s", recoveryError)
+// Run the schedules due at the current block, up to the maximum number of schedules per block and
+// within the gas left in the block gas budget, the schedules which do not fit stay due and run in
+// the next blocks. One-off schedules are removed once run and cron schedules are moved to their
+// next run, whether the call succeeds or not. The gas used is charged to the creator of the
+// schedule, and a schedule whose creator cannot pay is removed.
+func executeSchedules(ctx sdk.Context, k keeper.Keeper) {
+	logger := k.Logger(ctx)
+	p := k.GetParams(ctx)
+
+	schedules, err := k.GetDueSchedules(ctx, p.MaxSchedulesPerBlock)
+	if err != nil {
+		logger.Error("Failed to get due schedules", "error", err)
+		return
+	}
+
+	// Track the block gas budget, shared with the contract executions
+	blockGasUsed := k.GetBlockGasUsed(ctx)
+
+	for _, schedule := range schedules {
+		// Stop once the next call does not fit the block gas budget, the due schedules are run
+		// in order so the remaining ones run in the next block
+		gasLimit := schedule.EffectiveGasLimit(p)
+		if p.BlockGasLimit > 0 && blockGasUsed+gasLimit > p.BlockGasLimit {
+			break
+		}
+
+		// Remove the sudo schedules whose creator is no longer the manager of the contract, e.g.
+		// once its admin changed
+		if schedule.CallType == types.ScheduleCallTypeSudo {
+			if ok, err := k.IsContractManager(ctx, schedule.Creator, schedule.ContractAddress); !ok {
+				logger.Error("Removing schedule of a former contract manager", "schedule", schedule.Id, "contract", schedule.ContractAddress, "error", err)
+				if err := ctx.EventManager().EmitTypedEvent(&types.EventScheduleFailed{
+					Id:              schedule.Id,
+					ContractAddress: schedule.ContractAddress,
+					Error:           types.TruncateError(err.Error()),
+				}); err != nil {
+					logger.Error("Failed to emit schedule event", "schedule", schedule.Id, "error", err)
+				}
+				k.RemoveSchedule(ctx, schedule)
+				continue
 			}
 		}
-	}()
 
-	// Execute contract with sudo
-	_, *err = k.Sudo(childCtx, contractAddr, msgBz)
-}
+		// Create a cached context with gas limit and run the call, committing its state and events
+		// only when it succeeds
+		childCtx, writeCache := ctx.CacheContext()
+		childCtx = childCtx.WithGasMeter(storetypes.NewGasMeter(gasLimit))
+		err := keeper.ExecuteSchedule(k.GetContractKeeper(), childCtx, schedule)
+		gasUsed := childCtx.GasMeter().GasConsumedToLimit()
+		blockGasUsed += gasUsed
+
+		// Emit the result of the call
+		if err != nil {
+			logger.Error("Failed to run schedule", "schedule", schedule.Id, "contract", schedule.ContractAddress, "error", err)
+			err = ctx.EventManager().EmitTypedEvent(&types.EventScheduleFailed{
+				Id:              schedule.Id,
+				ContractAddress: schedule.ContractAddress,
+				GasUsed:         gasUsed,
+				Error:           types.TruncateError(err.Error()),
+			})
+		} else {
+			writeCache()
+			err = ctx.EventManager().EmitTypedEvent(&types.EventScheduleExecuted{
+				Id:              schedule.Id,
+				ContractAddress: schedule.ContractAddress,
+				GasUsed:         gasUsed,
+			})
+		}
+		if err != nil {
+			logger.Error("Failed to emit schedule event", "schedule", schedule.Id, "error", err)
+		}
+
+		// Charge the gas used by the call, whether it succeeded or not, removing the schedule if
+		// its creator cannot pay
+		if err := k.ChargeScheduleGas(ctx, schedule, gasUsed); err != nil {
+			logger.Error("Failed to charge schedule gas", "schedule", schedule.Id, "error", err)
+			k.RemoveSchedule(ctx, schedule)
+			continue
+		}
+
+		// Remove the schedule or move it to its next run
+		if err := k.CompleteScheduleRun(ctx, schedule); err != nil {
+			logger.Error("Failed to complete schedule run", "schedule", schedule.Id, "error", err)
+		}
+	}
 
-// Check if error is out of gas error
-func IsOutOfGasError(err any) (bool, string) {
-	switch e := err.(type) {
-	case storetypes.ErrorOutOfGas:
-		return true, e.Descriptor
-	case storetypes.ErrorGasOverflow:
-		return true, e.Descriptor
-	default:
-		return false, ""
+	// Store the block gas used
+	if p.BlockGasLimit > 0 {
+		k.SetBlockGasUsed(ctx, blockGasUsed)
 	}
 }
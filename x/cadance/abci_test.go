@@ -3,19 +3,24 @@ package cadance_test
 import (
 	"crypto/sha256"
 	"encoding/json"
+	"sort"
 	"testing"
+	"time"
 
 	"cosmossdk.io/math"
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	apptesting "github.com/bitsongofficial/go-bitsong/app/testing"
+	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/stretchr/testify/suite"
 
 	_ "embed"
 
 	cadance "github.com/bitsongofficial/go-bitsong/x/cadance"
+	"github.com/bitsongofficial/go-bitsong/x/cadance/keeper"
 	"github.com/bitsongofficial/go-bitsong/x/cadance/types"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
@@ -92,8 +97,28 @@ func (s *EndBlockerTestSuite) FundAccount(ctx sdk.Context, addr sdk.AccAddress,
 	return s.App.AppKeepers.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, addr, amounts)
 }
 
-// Register a contract. You must store the contract code before registering.
+// Register a contract executed at the end of the block. You must store the contract code before registering.
 func (s *EndBlockerTestSuite) registerContract() string {
+	return s.registerContractWithPhase(types.ExecutionPhaseEndBlock)
+}
+
+// Register a contract executed in the given phases of the block. You must store the contract code before registering.
+func (s *EndBlockerTestSuite) registerContractWithPhase(phase types.ExecutionPhase) string {
+	return s.registerCustomContract(types.CadanceContract{Phase: phase})
+}
+
+// Register a contract executed in the given phases of the block every executionInterval blocks
+// from the start height. You must store the contract code before registering.
+func (s *EndBlockerTestSuite) registerScheduledContract(phase types.ExecutionPhase, executionInterval uint64, startHeight int64) string {
+	return s.registerCustomContract(types.CadanceContract{
+		Phase:             phase,
+		ExecutionInterval: executionInterval,
+		StartHeight:       startHeight,
+	})
+}
+
+// Register a contract with the given execution settings. You must store the contract code before registering.
+func (s *EndBlockerTestSuite) registerCustomContract(settings types.CadanceContract) string {
 	// Create & fund accounts
 	_, _, sender := testdata.KeyTestPubAddr()
 	_, _, admin := testdata.KeyTestPubAddr()
@@ -105,7 +130,8 @@ func (s *EndBlockerTestSuite) registerContract() string {
 
 	// Register contract
 	cadanceKeeper := s.App.AppKeepers.CadanceKeeper
-	err := cadanceKeeper.RegisterContract(s.Ctx, admin.String(), contractAddress)
+	settings.ContractAddress = contractAddress
+	_, err := cadanceKeeper.RegisterContract(s.Ctx, admin.String(), settings)
 	s.Require().NoError(err)
 
 	// Assert contract is registered
@@ -172,6 +198,162 @@ func (s *EndBlockerTestSuite) TestEndBlocker() {
 	s.Require().Equal(int64(2), val)
 }
 
+// Test that contracts receive their custom sudo payload instead of the sudo message of the phase.
+func (s *EndBlockerTestSuite) TestSudoPayload() {
+	// Setup test
+	cadanceKeeper := s.App.AppKeepers.CadanceKeeper
+	s.StoreCode(cadanceContract)
+	contractAddress := s.registerCustomContract(types.CadanceContract{
+		SudoPayload: `{"clock_end_block":{}}`,
+	})
+
+	// Call end blocker
+	s.callEndBlocker()
+
+	// Ensure the contract handled the payload
+	s.Require().Equal(int64(1), s.queryContract(contractAddress))
+
+	contract, err := cadanceKeeper.GetCadanceContract(s.Ctx, contractAddress)
+	s.Require().NoError(err)
+	s.Require().False(contract.IsJailed)
+	s.Require().Equal(s.Ctx.BlockHeight()-1, contract.LastExecutedHeight)
+	s.Require().NotZero(contract.LastGasUsed)
+}
+
+// Test that a contract execution running out of gas after writing to its state leaves no partial
+// state nor events, while a successful execution commits both.
+func (s *EndBlockerTestSuite) TestFailedExecutionIsReverted() {
+	// Setup test
+	cadanceKeeper := s.App.AppKeepers.CadanceKeeper
+	s.StoreCode(cadanceContract)
+	contractAddress := s.registerCustomContract(types.CadanceContract{
+		SudoPayload: `{"clock_end_block":{}}`,
+	})
+
+	// Get the gas used by the execution, and run out of gas right after its state is written
+	contract, err := cadanceKeeper.GetCadanceContract(s.Ctx, contractAddress)
+	s.Require().NoError(err)
+	res, err := cadanceKeeper.SimulateExecution(s.Ctx, *contract, types.ExecutionPhaseEndBlock)
+	s.Require().NoError(err)
+	s.Require().Equal(types.SimulationResultSuccess, res.Result)
+	contract.GasLimit = res.GasUsed - 1
+	s.Require().NoError(cadanceKeeper.SetCadanceContract(s.Ctx, *contract))
+
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+	s.callEndBlocker()
+
+	// Ensure the failure is recorded, without the state and the events of the execution
+	contract, err = cadanceKeeper.GetCadanceContract(s.Ctx, contractAddress)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), contract.ConsecutiveFailures)
+	s.Require().Contains(contract.LastError, "out of gas")
+	s.Require().Equal(contract.GasLimit, contract.LastGasUsed)
+	s.Require().Equal(int64(0), s.queryContract(contractAddress))
+
+	eventTypes := make(map[string]bool)
+	for _, event := range s.Ctx.EventManager().Events() {
+		eventTypes[event.Type] = true
+	}
+	s.Require().True(eventTypes["bitsong.cadance.v1.EventContractExecutionFailed"])
+	s.Require().False(eventTypes[wasmtypes.EventTypeSudo])
+
+	// Ensure the state and the events of a successful execution are committed
+	s.Require().NoError(cadanceKeeper.SetJailStatus(s.Ctx, contractAddress, false))
+	contract, err = cadanceKeeper.GetCadanceContract(s.Ctx, contractAddress)
+	s.Require().NoError(err)
+	contract.GasLimit = 0
+	s.Require().NoError(cadanceKeeper.SetCadanceContract(s.Ctx, *contract))
+
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+	s.callEndBlocker()
+
+	s.Require().Equal(int64(1), s.queryContract(contractAddress))
+
+	eventTypes = make(map[string]bool)
+	for _, event := range s.Ctx.EventManager().Events() {
+		eventTypes[event.Type] = true
+	}
+	s.Require().True(eventTypes["bitsong.cadance.v1.EventContractExecuted"])
+	s.Require().True(eventTypes[wasmtypes.EventTypeSudo])
+}
+
+// Test that contracts are executed by decreasing priority, whatever their address.
+func (s *EndBlockerTestSuite) TestExecutionPriority() {
+	// Setup test
+	s.StoreCode(cadanceContract)
+	low := s.registerCustomContract(types.CadanceContract{SudoPayload: `{"clock_end_block":{}}`})
+	high := s.registerCustomContract(types.CadanceContract{SudoPayload: `{"clock_end_block":{}}`, Priority: 5})
+	medium := s.registerCustomContract(types.CadanceContract{SudoPayload: `{"clock_end_block":{}}`, Priority: 2})
+
+	// Ensure the contracts are executed in the order of their priority
+	s.Require().Equal([]string{high, medium, low}, s.executeEndBlock())
+}
+
+// Test that deferring the contracts of a priority only changes the order of that priority.
+func (s *EndBlockerTestSuite) TestDeferredPriority() {
+	// Setup test
+	cadanceKeeper := s.App.AppKeepers.CadanceKeeper
+	s.StoreCode(cadanceContract)
+
+	addresses := make([]string, 4)
+	for i := range addresses {
+		addresses[i] = s.registerCustomContract(types.CadanceContract{SudoPayload: `{"clock_end_block":{}}`})
+	}
+	sort.Strings(addresses)
+
+	// The first and the last addresses have the highest priority, so that the cursor of the lowest
+	// priority sorts between them
+	high := []string{addresses[0], addresses[3]}
+	low := []string{addresses[1], addresses[2]}
+	for _, address := range high {
+		contract, err := cadanceKeeper.GetCadanceContract(s.Ctx, address)
+		s.Require().NoError(err)
+		contract.Priority = 5
+		s.Require().NoError(cadanceKeeper.SetCadanceContract(s.Ctx, *contract))
+	}
+
+	// Measure the gas used by an execution without deferring any
+	params := types.DefaultParams()
+	params.MaxContractGasLimit = params.ContractGasLimit
+	params.BlockGasLimit = 100 * params.MaxContractGasLimit
+	s.Require().NoError(cadanceKeeper.SetParams(s.Ctx, params))
+	s.Require().Len(s.executeEndBlock(), 4)
+	height := s.Ctx.BlockHeight()
+	s.Require().Len(s.executeEndBlock(), 4)
+	gasUsed := cadanceKeeper.GetBlockGasUsed(s.Ctx.WithBlockHeight(height)) / 4
+
+	// Only three executions fit the budget, the last contract of the lowest priority is deferred
+	params.BlockGasLimit = params.ContractGasLimit + 2*gasUsed + gasUsed/2
+	s.Require().NoError(cadanceKeeper.SetParams(s.Ctx, params))
+	s.Require().Equal([]string{high[0], high[1], low[0]}, s.executeEndBlock())
+	s.Require().True(cadanceKeeper.IsPendingExecution(s.Ctx, types.ExecutionPhaseEndBlock, low[1]))
+	s.Require().Equal(low[1], cadanceKeeper.GetRoundRobinCursor(s.Ctx, types.ExecutionPhaseEndBlock))
+
+	// The lowest priority resumes from the deferred contract, the highest one keeps its order
+	s.Require().Equal([]string{high[0], high[1], low[1]}, s.executeEndBlock())
+	s.Require().False(cadanceKeeper.IsPendingExecution(s.Ctx, types.ExecutionPhaseEndBlock, low[1]))
+	s.Require().True(cadanceKeeper.IsPendingExecution(s.Ctx, types.ExecutionPhaseEndBlock, low[0]))
+	s.Require().Equal(low[0], cadanceKeeper.GetRoundRobinCursor(s.Ctx, types.ExecutionPhaseEndBlock))
+}
+
+// Call the end blocker and return the contracts executed, in their order
+func (s *EndBlockerTestSuite) executeEndBlock() []string {
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+	s.callEndBlocker()
+
+	executed := []string{}
+	for _, event := range s.Ctx.EventManager().Events() {
+		if event.Type != "bitsong.cadance.v1.EventContractExecuted" {
+			continue
+		}
+
+		msg, err := sdk.ParseTypedEvent(abci.Event(event))
+		s.Require().NoError(err)
+		executed = append(executed, msg.(*types.EventContractExecuted).ContractAddress)
+	}
+	return executed
+}
+
 // Test a contract which does not handle the sudo EndBlock msg.
 func (s *EndBlockerTestSuite) TestInvalidContract() {
 	// Setup test
@@ -186,12 +368,626 @@ func (s *EndBlockerTestSuite) TestInvalidContract() {
 	contract, err := cadanceKeeper.GetCadanceContract(s.Ctx, contractAddress)
 	s.Require().NoError(err)
 	s.Require().True(contract.IsJailed)
+
+	// Ensure the failure is recorded
+	s.Require().NotEmpty(contract.JailReason)
+	s.Require().Equal(contract.JailReason, contract.LastError)
+	s.Require().Equal(s.Ctx.BlockHeight()-1, contract.LastFailureHeight)
+	s.Require().Equal(uint64(1), contract.ConsecutiveFailures)
+	s.Require().NotZero(contract.LastGasUsed)
+
+	// Ensure the events are emitted
+	eventTypes := make(map[string]bool)
+	for _, event := range s.Ctx.EventManager().Events() {
+		eventTypes[event.Type] = true
+	}
+	s.Require().True(eventTypes["bitsong.cadance.v1.EventContractExecutionFailed"])
+	s.Require().True(eventTypes["bitsong.cadance.v1.EventContractJailed"])
+
+	// Ensure the jail reason is cleared when unjailed
+	s.Require().NoError(cadanceKeeper.SetJailStatus(s.Ctx, contractAddress, false))
+	contract, err = cadanceKeeper.GetCadanceContract(s.Ctx, contractAddress)
+	s.Require().NoError(err)
+	s.Require().Empty(contract.JailReason)
+	s.Require().Equal(uint64(0), contract.ConsecutiveFailures)
+}
+
+// Test that contracts are only jailed once the failure threshold is reached.
+func (s *EndBlockerTestSuite) TestFailureThreshold() {
+	// Setup test
+	cadanceKeeper := s.App.AppKeepers.CadanceKeeper
+
+	params := types.DefaultParams()
+	params.FailureThreshold = 3
+	s.Require().NoError(cadanceKeeper.SetParams(s.Ctx, params))
+
+	s.StoreCode(burnContract)
+	contractAddress := s.registerContract()
+
+	getContract := func() *types.CadanceContract {
+		contract, err := cadanceKeeper.GetCadanceContract(s.Ctx, contractAddress)
+		s.Require().NoError(err)
+		return contract
+	}
+
+	// The contract is not jailed before the threshold
+	s.callEndBlocker()
+	s.callEndBlocker()
+	contract := getContract()
+	s.Require().False(contract.IsJailed)
+	s.Require().Equal(uint64(2), contract.ConsecutiveFailures)
+
+	// The contract is jailed at the threshold
+	s.callEndBlocker()
+	contract = getContract()
+	s.Require().True(contract.IsJailed)
+	s.Require().Equal(uint64(3), contract.ConsecutiveFailures)
+	s.Require().Equal(uint64(1), contract.JailCount)
+	s.Require().Equal(int64(0), contract.UnjailHeight)
+}
+
+// Test that jailed contracts are unjailed after a cooldown which doubles for repeat offenders.
+func (s *EndBlockerTestSuite) TestUnjailCooldown() {
+	// Setup test
+	cadanceKeeper := s.App.AppKeepers.CadanceKeeper
+
+	params := types.DefaultParams()
+	params.UnjailCooldown = 10
+	params.MaxUnjailCooldown = 25
+	s.Require().NoError(cadanceKeeper.SetParams(s.Ctx, params))
+
+	s.StoreCode(burnContract)
+	contractAddress := s.registerContract()
+
+	getContract := func() *types.CadanceContract {
+		contract, err := cadanceKeeper.GetCadanceContract(s.Ctx, contractAddress)
+		s.Require().NoError(err)
+		return contract
+	}
+
+	// The contract is jailed with the base cooldown
+	s.Ctx = s.Ctx.WithBlockHeight(100)
+	s.callEndBlocker()
+	contract := getContract()
+	s.Require().True(contract.IsJailed)
+	s.Require().Equal(int64(110), contract.UnjailHeight)
+
+	// The contract stays jailed until the cooldown has elapsed
+	s.Ctx = s.Ctx.WithBlockHeight(109)
+	cadance.BeginBlocker(s.Ctx, cadanceKeeper)
+	s.Require().True(getContract().IsJailed)
+
+	s.Ctx = s.Ctx.WithBlockHeight(110)
+	cadance.BeginBlocker(s.Ctx, cadanceKeeper)
+	contract = getContract()
+	s.Require().False(contract.IsJailed)
+	s.Require().Empty(contract.JailReason)
+	s.Require().Equal(int64(0), contract.UnjailHeight)
+	s.Require().Equal(uint64(0), contract.ConsecutiveFailures)
+
+	// The cooldown doubles when the contract is jailed again
+	s.callEndBlocker()
+	contract = getContract()
+	s.Require().True(contract.IsJailed)
+	s.Require().Equal(uint64(2), contract.JailCount)
+	s.Require().Equal(int64(130), contract.UnjailHeight)
+
+	// The cooldown is capped by the max unjail cooldown
+	s.Ctx = s.Ctx.WithBlockHeight(130)
+	cadance.BeginBlocker(s.Ctx, cadanceKeeper)
+	s.callEndBlocker()
+	contract = getContract()
+	s.Require().True(contract.IsJailed)
+	s.Require().Equal(uint64(3), contract.JailCount)
+	s.Require().Equal(int64(155), contract.UnjailHeight)
+}
+
+// Test that contracts are only executed in the phases of the block they are registered for.
+func (s *EndBlockerTestSuite) TestBeginBlocker() {
+	// Setup test
+	cadanceKeeper := s.App.AppKeepers.CadanceKeeper
+	s.StoreCode(burnContract)
+	endBlockContract := s.registerContractWithPhase(types.ExecutionPhaseEndBlock)
+	beginBlockContract := s.registerContractWithPhase(types.ExecutionPhaseBeginBlock)
+	bothContract := s.registerContractWithPhase(types.ExecutionPhaseBeginAndEndBlock)
+
+	isJailed := func(contractAddress string) bool {
+		contract, err := cadanceKeeper.GetCadanceContract(s.Ctx, contractAddress)
+		s.Require().NoError(err)
+		return contract.IsJailed
+	}
+
+	// Run the begin blocker, only the begin block contracts are executed and jailed
+	cadance.BeginBlocker(s.Ctx, cadanceKeeper)
+	s.Require().False(isJailed(endBlockContract))
+	s.Require().True(isJailed(beginBlockContract))
+	s.Require().True(isJailed(bothContract))
+
+	// Unjail the contract executed in both phases
+	err := cadanceKeeper.SetJailStatus(s.Ctx, bothContract, false)
+	s.Require().NoError(err)
+
+	// Run the end blocker, the end block contracts are executed and jailed
+	s.callEndBlocker()
+	s.Require().True(isJailed(endBlockContract))
+	s.Require().True(isJailed(bothContract))
+}
+
+// Test that contracts are only executed when their execution interval has elapsed from the start height.
+func (s *EndBlockerTestSuite) TestExecutionInterval() {
+	// Setup test
+	cadanceKeeper := s.App.AppKeepers.CadanceKeeper
+	s.StoreCode(burnContract)
+	intervalContract := s.registerScheduledContract(types.ExecutionPhaseEndBlock, 10, 0)
+	startContract := s.registerScheduledContract(types.ExecutionPhaseEndBlock, 0, 30)
+
+	isJailed := func(contractAddress string) bool {
+		contract, err := cadanceKeeper.GetCadanceContract(s.Ctx, contractAddress)
+		s.Require().NoError(err)
+		return contract.IsJailed
+	}
+
+	// Height 11, none of the contracts is due
+	s.callEndBlocker()
+	s.Require().False(isJailed(intervalContract))
+	s.Require().False(isJailed(startContract))
+
+	// Height 20, the interval contract is due
+	s.Ctx = s.Ctx.WithBlockHeight(20)
+	s.callEndBlocker()
+	s.Require().True(isJailed(intervalContract))
+	s.Require().False(isJailed(startContract))
+
+	// Height 29, the start height of the other contract is not reached yet
+	s.Ctx = s.Ctx.WithBlockHeight(29)
+	s.callEndBlocker()
+	s.Require().False(isJailed(startContract))
+
+	// Height 30, the start height is reached
+	s.callEndBlocker()
+	s.Require().True(isJailed(startContract))
+
+	// Failed executions are not recorded
+	contract, err := cadanceKeeper.GetCadanceContract(s.Ctx, startContract)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(0), contract.LastExecutedHeight)
+}
+
+// Test that the jail penalty is taken from the deposit of the jailed contracts.
+func (s *EndBlockerTestSuite) TestJailPenalty() {
+	// Setup test
+	cadanceKeeper := s.App.AppKeepers.CadanceKeeper
+	bankKeeper := s.App.AppKeepers.BankKeeper
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+
+	params := types.DefaultParams()
+	params.RegistrationDeposit = sdk.NewCoin("stake", math.NewInt(1_000))
+	params.JailPenalty = math.LegacyNewDecWithPrec(5, 1)
+	s.Require().NoError(cadanceKeeper.SetParams(s.Ctx, params))
+
+	s.StoreCode(burnContract)
+	contractAddress := s.registerContract()
+
+	deposit := func() math.Int {
+		contract, err := cadanceKeeper.GetCadanceContract(s.Ctx, contractAddress)
+		s.Require().NoError(err)
+		return contract.Deposit.Amount
+	}
+
+	// The penalty is burned
+	supply := bankKeeper.GetSupply(s.Ctx, "stake").Amount
+	s.callEndBlocker()
+	s.Require().Equal(math.NewInt(500), deposit())
+	s.Require().Equal(math.NewInt(500), bankKeeper.GetBalance(s.Ctx, moduleAddr, "stake").Amount)
+	s.Require().Equal(supply.SubRaw(500), bankKeeper.GetSupply(s.Ctx, "stake").Amount)
+
+	// The penalty is sent to the community pool
+	params.JailPenaltyDestination = types.PenaltyDestinationCommunityPool
+	s.Require().NoError(cadanceKeeper.SetParams(s.Ctx, params))
+	s.Require().NoError(cadanceKeeper.SetJailStatus(s.Ctx, contractAddress, false))
+
+	pool, err := s.App.AppKeepers.DistrKeeper.FeePool.Get(s.Ctx)
+	s.Require().NoError(err)
+	s.callEndBlocker()
+	s.Require().Equal(math.NewInt(250), deposit())
+
+	newPool, err := s.App.AppKeepers.DistrKeeper.FeePool.Get(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(
+		pool.CommunityPool.AmountOf("stake").Add(math.LegacyNewDec(250)),
+		newPool.CommunityPool.AmountOf("stake"),
+	)
+}
+
+// Test that the contracts beyond the block gas limit are executed in the next blocks in round-robin order.
+func (s *EndBlockerTestSuite) TestBlockGasLimit() {
+	// Setup test
+	cadanceKeeper := s.App.AppKeepers.CadanceKeeper
+
+	params := types.DefaultParams()
+	params.MaxContractGasLimit = params.ContractGasLimit
+	params.BlockGasLimit = params.ContractGasLimit
+	s.Require().NoError(cadanceKeeper.SetParams(s.Ctx, params))
+
+	s.StoreCode(burnContract)
+	for i := 0; i < 3; i++ {
+		s.registerContract()
+	}
+
+	contracts, err := cadanceKeeper.GetAllContracts(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Len(contracts, 3)
+
+	isJailed := func(contractAddress string) bool {
+		contract, err := cadanceKeeper.GetCadanceContract(s.Ctx, contractAddress)
+		s.Require().NoError(err)
+		return contract.IsJailed
+	}
+
+	// Only one contract fits the budget of every block, the others are deferred
+	for i, contract := range contracts {
+		s.Require().False(isJailed(contract.ContractAddress))
+
+		height := s.Ctx.BlockHeight()
+		s.callEndBlocker()
+		s.Require().True(isJailed(contract.ContractAddress))
+		s.Require().False(cadanceKeeper.IsPendingExecution(s.Ctx, types.ExecutionPhaseEndBlock, contract.ContractAddress))
+
+		for _, deferred := range contracts[i+1:] {
+			s.Require().False(isJailed(deferred.ContractAddress))
+			s.Require().True(cadanceKeeper.IsPendingExecution(s.Ctx, types.ExecutionPhaseEndBlock, deferred.ContractAddress))
+		}
+
+		// The block gas used is tracked
+		s.Require().NotZero(cadanceKeeper.GetBlockGasUsed(s.Ctx.WithBlockHeight(height)))
+	}
+
+	s.Require().Empty(cadanceKeeper.GetRoundRobinCursor(s.Ctx, types.ExecutionPhaseEndBlock))
+}
+
+// Test that the due schedules are run at the end of the block, one-off schedules once and cron
+// schedules at every time matching their expression.
+func (s *EndBlockerTestSuite) TestSchedules() {
+	// Setup test
+	cadanceKeeper := s.App.AppKeepers.CadanceKeeper
+	s.StoreCode(cadanceContract)
+	_, _, admin := testdata.KeyTestPubAddr()
+	_ = s.FundAccount(s.Ctx, admin, sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(10_000_000))))
+	contractAddress := s.InstantiateContract(admin.String(), admin.String())
+
+	s.Ctx = s.Ctx.WithBlockHeight(10).WithBlockTime(time.Date(2024, 1, 1, 0, 30, 0, 0, time.UTC))
+	runAtTime := time.Date(2024, 1, 1, 1, 0, 0, 0, time.UTC)
+
+	createSchedule := func(schedule types.Schedule) uint64 {
+		schedule.Creator = admin.String()
+		schedule.ContractAddress = contractAddress
+		schedule.Msg = `{"clock_end_block":{}}`
+		id, err := cadanceKeeper.CreateSchedule(s.Ctx, schedule)
+		s.Require().NoError(err)
+		return id
+	}
+	heightSchedule := createSchedule(types.Schedule{RunAtHeight: 20})
+	timeSchedule := createSchedule(types.Schedule{RunAtTime: &runAtTime})
+	cronSchedule := createSchedule(types.Schedule{Cron: "0 * * * *"})
+
+	isScheduled := func(id uint64) bool {
+		_, err := cadanceKeeper.GetSchedule(s.Ctx, id)
+		return err == nil
+	}
+
+	// Height 19, none of the schedules is due
+	s.Ctx = s.Ctx.WithBlockHeight(19)
+	s.callEndBlocker()
+	s.Require().Equal(int64(0), s.queryContract(contractAddress))
+
+	// Height 20, the height schedule is run once
+	s.callEndBlocker()
+	s.Require().Equal(int64(1), s.queryContract(contractAddress))
+	s.Require().False(isScheduled(heightSchedule))
+
+	// 01:00, the time and the cron schedules are run
+	s.Ctx = s.Ctx.WithBlockTime(runAtTime)
+	s.callEndBlocker()
+	s.Require().Equal(int64(3), s.queryContract(contractAddress))
+	s.Require().False(isScheduled(timeSchedule))
+
+	schedule, err := cadanceKeeper.GetSchedule(s.Ctx, cronSchedule)
+	s.Require().NoError(err)
+	s.Require().Equal(runAtTime.Add(time.Hour), *schedule.NextRunTime)
+
+	// 01:30, the cron schedule is not due
+	s.Ctx = s.Ctx.WithBlockTime(runAtTime.Add(30 * time.Minute))
+	s.callEndBlocker()
+	s.Require().Equal(int64(3), s.queryContract(contractAddress))
+
+	// 02:00, the cron schedule is run again
+	s.Ctx = s.Ctx.WithBlockTime(runAtTime.Add(time.Hour))
+	s.callEndBlocker()
+	s.Require().Equal(int64(4), s.queryContract(contractAddress))
+	s.Require().True(isScheduled(cronSchedule))
+}
+
+// Test that a sudo schedule is removed when it runs after its creator is no longer the manager of
+// the contract.
+func (s *EndBlockerTestSuite) TestScheduleOfFormerManager() {
+	// Setup test
+	cadanceKeeper := s.App.AppKeepers.CadanceKeeper
+	s.StoreCode(cadanceContract)
+	_, _, admin := testdata.KeyTestPubAddr()
+	_, _, newAdmin := testdata.KeyTestPubAddr()
+	_ = s.FundAccount(s.Ctx, admin, sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(10_000_000))))
+	contractAddress := s.InstantiateContract(admin.String(), admin.String())
+
+	s.Ctx = s.Ctx.WithBlockHeight(10)
+	id, err := cadanceKeeper.CreateSchedule(s.Ctx, types.Schedule{
+		Creator:         admin.String(),
+		ContractAddress: contractAddress,
+		Msg:             `{"clock_end_block":{}}`,
+		RunAtHeight:     20,
+	})
+	s.Require().NoError(err)
+
+	// The admin of the contract changes before the schedule runs
+	contractKeeper := cadanceKeeper.GetContractKeeper()
+	err = contractKeeper.UpdateContractAdmin(s.Ctx, sdk.MustAccAddressFromBech32(contractAddress), admin, newAdmin)
+	s.Require().NoError(err)
+
+	s.Ctx = s.Ctx.WithBlockHeight(20).WithEventManager(sdk.NewEventManager())
+	s.callEndBlocker()
+
+	// The call is not run and the schedule is removed
+	s.Require().Equal(int64(0), s.queryContract(contractAddress))
+	_, err = cadanceKeeper.GetSchedule(s.Ctx, id)
+	s.Require().Error(err)
+
+	failed := false
+	for _, event := range s.Ctx.EventManager().Events() {
+		if event.Type == "bitsong.cadance.v1.EventScheduleFailed" {
+			failed = true
+		}
+	}
+	s.Require().True(failed)
+}
+
+// Test that the due schedules beyond the maximum number of schedules per block are run in the next blocks.
+func (s *EndBlockerTestSuite) TestMaxSchedulesPerBlock() {
+	// Setup test
+	cadanceKeeper := s.App.AppKeepers.CadanceKeeper
+	s.StoreCode(cadanceContract)
+	_, _, admin := testdata.KeyTestPubAddr()
+	_ = s.FundAccount(s.Ctx, admin, sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(10_000_000))))
+	contractAddress := s.InstantiateContract(admin.String(), admin.String())
+
+	params := types.DefaultParams()
+	params.MaxSchedulesPerBlock = 2
+	s.Require().NoError(cadanceKeeper.SetParams(s.Ctx, params))
+
+	s.Ctx = s.Ctx.WithBlockHeight(10)
+	for i := 0; i < 3; i++ {
+		_, err := cadanceKeeper.CreateSchedule(s.Ctx, types.Schedule{
+			Creator:         admin.String(),
+			ContractAddress: contractAddress,
+			Msg:             `{"clock_end_block":{}}`,
+			RunAtHeight:     20,
+		})
+		s.Require().NoError(err)
+	}
+
+	// Height 20, only two schedules are run
+	s.Ctx = s.Ctx.WithBlockHeight(20)
+	s.callEndBlocker()
+	s.Require().Equal(int64(2), s.queryContract(contractAddress))
+
+	// Height 21, the last schedule is run
+	s.callEndBlocker()
+	s.Require().Equal(int64(3), s.queryContract(contractAddress))
+
+	schedules, err := cadanceKeeper.GetAllSchedules(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Empty(schedules)
+}
+
+// Test that the gas used by the schedules counts against the block gas budget and is paid to the
+// fee collector by their creator, and that schedules whose creator cannot pay are removed.
+func (s *EndBlockerTestSuite) TestScheduleGas() {
+	// Setup test
+	cadanceKeeper := s.App.AppKeepers.CadanceKeeper
+	bankKeeper := s.App.AppKeepers.BankKeeper
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	s.StoreCode(cadanceContract)
+	_, _, admin := testdata.KeyTestPubAddr()
+	_ = s.FundAccount(s.Ctx, admin, sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(10_000_000))))
+	contractAddress := s.InstantiateContract(admin.String(), admin.String())
+
+	// Fit a single schedule in the block gas budget
+	params := types.DefaultParams()
+	params.BlockGasLimit = params.MaxContractGasLimit
+	params.GasPrice = sdk.NewDecCoinFromDec("stake", math.LegacyNewDecWithPrec(1, 2))
+	s.Require().NoError(cadanceKeeper.SetParams(s.Ctx, params))
+
+	s.Ctx = s.Ctx.WithBlockHeight(10)
+	ids := []uint64{}
+	for i := 0; i < 3; i++ {
+		id, err := cadanceKeeper.CreateSchedule(s.Ctx, types.Schedule{
+			Creator:         admin.String(),
+			ContractAddress: contractAddress,
+			Msg:             `{"clock_end_block":{}}`,
+			RunAtHeight:     20,
+			GasLimit:        params.MaxContractGasLimit,
+		})
+		s.Require().NoError(err)
+		ids = append(ids, id)
+	}
+
+	balance := func(addr sdk.AccAddress) math.Int {
+		return bankKeeper.GetBalance(s.Ctx, addr, "stake").Amount
+	}
+
+	// Height 20, a single schedule is run and its gas is charged to its creator
+	s.Ctx = s.Ctx.WithBlockHeight(20)
+	adminBalance, collected := balance(admin), balance(feeCollector)
+	s.callEndBlocker()
+	s.Require().Equal(int64(1), s.queryContract(contractAddress))
+
+	gasUsed := cadanceKeeper.GetBlockGasUsed(s.Ctx.WithBlockHeight(20))
+	fee := params.GasFee(gasUsed).Amount
+	s.Require().True(fee.IsPositive())
+	s.Require().Equal(adminBalance.Sub(fee), balance(admin))
+	s.Require().Equal(collected.Add(fee), balance(feeCollector))
+
+	// Height 21, the next schedule is run
+	s.callEndBlocker()
+	s.Require().Equal(int64(2), s.queryContract(contractAddress))
+
+	// Height 22, the last schedule is removed since its creator cannot pay
+	_, _, other := testdata.KeyTestPubAddr()
+	s.Require().NoError(bankKeeper.SendCoins(s.Ctx, admin, other, bankKeeper.GetAllBalances(s.Ctx, admin)))
+	s.callEndBlocker()
+
+	_, err := cadanceKeeper.GetSchedule(s.Ctx, ids[2])
+	s.Require().ErrorIs(err, types.ErrScheduleNotFound)
+	schedules, err := cadanceKeeper.GetAllSchedules(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Empty(schedules)
+}
+
+// Test that the gas used by the executions is paid to the fee collector by the contract or its fee
+// payer, and that contracts which cannot pay are jailed.
+func (s *EndBlockerTestSuite) TestGasBilling() {
+	// Setup test
+	cadanceKeeper := s.App.AppKeepers.CadanceKeeper
+	bankKeeper := s.App.AppKeepers.BankKeeper
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+
+	params := types.DefaultParams()
+	params.GasPrice = sdk.NewDecCoinFromDec("stake", math.LegacyNewDecWithPrec(1, 2))
+	s.Require().NoError(cadanceKeeper.SetParams(s.Ctx, params))
+
+	s.StoreCode(cadanceContract)
+	settings := types.CadanceContract{SudoPayload: `{"clock_end_block":{}}`}
+	contractAddress := s.registerCustomContract(settings)
+	contractAddr := sdk.MustAccAddressFromBech32(contractAddress)
+	s.Require().NoError(s.FundAccount(s.Ctx, contractAddr, sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(1_000_000)))))
+
+	getContract := func() *types.CadanceContract {
+		contract, err := cadanceKeeper.GetCadanceContract(s.Ctx, contractAddress)
+		s.Require().NoError(err)
+		return contract
+	}
+	balance := func(addr sdk.AccAddress) math.Int {
+		return bankKeeper.GetBalance(s.Ctx, addr, "stake").Amount
+	}
+
+	// The contract pays its execution gas
+	contractBalance, collected := balance(contractAddr), balance(feeCollector)
+	s.callEndBlocker()
+	fee := params.GasFee(getContract().LastGasUsed).Amount
+	s.Require().True(fee.IsPositive())
+	s.Require().Equal(contractBalance.Sub(fee), balance(contractAddr))
+	s.Require().Equal(collected.Add(fee), balance(feeCollector))
+
+	// The admin pays the execution gas once it is the fee payer
+	admin := sdk.MustAccAddressFromBech32(s.App.AppKeepers.WasmKeeper.GetContractInfo(s.Ctx, contractAddr).Admin)
+	settings.ContractAddress = contractAddress
+	settings.FeePayer = admin.String()
+	s.Require().NoError(cadanceKeeper.UpdateContract(s.Ctx, admin.String(), settings))
+
+	contractBalance, adminBalance := balance(contractAddr), balance(admin)
+	s.callEndBlocker()
+	fee = params.GasFee(getContract().LastGasUsed).Amount
+	s.Require().Equal(contractBalance, balance(contractAddr))
+	s.Require().Equal(adminBalance.Sub(fee), balance(admin))
+
+	// The contract is jailed once the fee payer cannot pay
+	_, _, other := testdata.KeyTestPubAddr()
+	s.Require().NoError(bankKeeper.SendCoins(s.Ctx, admin, other, bankKeeper.GetAllBalances(s.Ctx, admin)))
+	s.callEndBlocker()
+
+	contract := getContract()
+	s.Require().True(contract.IsJailed)
+	s.Require().Contains(contract.JailReason, types.ErrInsufficientGasFunds.Error())
+	s.Require().Equal(int64(3), s.queryContract(contractAddress))
+}
+
+// Test that contracts whose admin changes or is cleared are paused until their new manager
+// confirms them, or keep executing with their new manager recorded.
+func (s *EndBlockerTestSuite) TestLifecycleChange() {
+	// Setup test
+	cadanceKeeper := s.App.AppKeepers.CadanceKeeper
+	contractKeeper := cadanceKeeper.GetContractKeeper()
+	msgServer := keeper.NewMsgServerImpl(cadanceKeeper)
+
+	params := types.DefaultParams()
+	params.PauseOnLifecycleChange = true
+	s.Require().NoError(cadanceKeeper.SetParams(s.Ctx, params))
+
+	s.StoreCode(cadanceContract)
+	contractAddress := s.registerCustomContract(types.CadanceContract{SudoPayload: `{"clock_end_block":{}}`})
+	contractAddr := sdk.MustAccAddressFromBech32(contractAddress)
+	contractInfo := s.App.AppKeepers.WasmKeeper.GetContractInfo(s.Ctx, contractAddr)
+	admin := sdk.MustAccAddressFromBech32(contractInfo.Admin)
+
+	getContract := func() *types.CadanceContract {
+		contract, err := cadanceKeeper.GetCadanceContract(s.Ctx, contractAddress)
+		s.Require().NoError(err)
+		return contract
+	}
+
+	// The code id and the manager are recorded at registration
+	contract := getContract()
+	s.Require().Equal(contractInfo.CodeID, contract.CodeId)
+	s.Require().Equal(admin.String(), contract.Manager)
+
+	s.callEndBlocker()
+	s.Require().Equal(int64(1), s.queryContract(contractAddress))
+
+	// The contract is paused once its admin changes
+	_, _, newAdmin := testdata.KeyTestPubAddr()
+	s.Require().NoError(contractKeeper.UpdateContractAdmin(s.Ctx, contractAddr, admin, newAdmin))
+	s.callEndBlocker()
+	s.Require().Equal(int64(1), s.queryContract(contractAddress))
+	s.Require().True(getContract().PendingConfirmation)
+
+	// Only the new admin can confirm the contract
+	_, err := msgServer.ConfirmCadanceContract(s.Ctx, &types.MsgConfirmCadanceContract{
+		SenderAddress:   admin.String(),
+		ContractAddress: contractAddress,
+	})
+	s.Require().Error(err)
+	_, err = msgServer.ConfirmCadanceContract(s.Ctx, &types.MsgConfirmCadanceContract{
+		SenderAddress:   newAdmin.String(),
+		ContractAddress: contractAddress,
+	})
+	s.Require().NoError(err)
+
+	contract = getContract()
+	s.Require().False(contract.PendingConfirmation)
+	s.Require().Equal(newAdmin.String(), contract.Manager)
+
+	s.callEndBlocker()
+	s.Require().Equal(int64(2), s.queryContract(contractAddress))
+
+	// Without pause, the contract keeps executing and its new manager is recorded
+	params.PauseOnLifecycleChange = false
+	s.Require().NoError(cadanceKeeper.SetParams(s.Ctx, params))
+	s.Require().NoError(contractKeeper.ClearContractAdmin(s.Ctx, contractAddr, newAdmin))
+	s.callEndBlocker()
+	s.Require().Equal(int64(3), s.queryContract(contractAddress))
+
+	contract = getContract()
+	s.Require().False(contract.PendingConfirmation)
+	s.Require().Equal(contractInfo.Creator, contract.Manager)
 }
 
 // Test the endblocker with numerous contracts that all panic
 func (s *EndBlockerTestSuite) TestPerformance() {
 	s.StoreCode(burnContract)
 
+	// Execute all the contracts in a single block
+	params := types.DefaultParams()
+	params.BlockGasLimit = 0
+	s.Require().NoError(s.App.AppKeepers.CadanceKeeper.SetParams(s.Ctx, params))
+
 	numContracts := 1000
 
 	// Register numerous contracts
@@ -1,6 +1,8 @@
 package cli
 
 import (
+	"strconv"
+
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -20,6 +22,9 @@ func GetQueryCmd() *cobra.Command {
 	queryCmd.AddCommand(
 		GetCmdShowContracts(),
 		GetCmdShowContract(),
+		GetCmdShowSchedules(),
+		GetCmdShowSchedule(),
+		GetCmdSimulate(),
 		GetCmdParams(),
 	)
 	return queryCmd
@@ -28,7 +33,7 @@ func GetQueryCmd() *cobra.Command {
 func GetCmdShowContracts() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "contracts",
-		Short: "Show addresses of all current cadance contract s",
+		Short: "Show addresses of all current cadance contracts",
 		Args:  cobra.ExactArgs(0),
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -86,6 +91,132 @@ func GetCmdShowContract() *cobra.Command {
 	return cmd
 }
 
+func GetCmdShowSchedules() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedules",
+		Short: "Show all scheduled contract calls",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Schedules(cmd.Context(), &types.QuerySchedulesRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "schedules")
+	return cmd
+}
+
+func GetCmdShowSchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule [id]",
+		Short: "Get scheduled contract call by id",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Schedule(cmd.Context(), &types.QueryScheduleRequest{Id: id})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdSimulate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate [contract_address]",
+		Short: "Simulate the execution of a contract in the current block",
+		Long:  "Simulate the execution of a contract with its sudo message in the current block, without persisting anything, and show the gas used, the error and the emitted events. A registered contract is executed with its registered settings, any other contract with the custom --payload and the --sudo-version given. The sudo message of the end of the block is sent unless --phase begin is given, within the gas limit of the contract unless a --gas-limit is given.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			phaseStr, err := cmd.Flags().GetString(flagPhase)
+			if err != nil {
+				return err
+			}
+
+			phase, err := parseExecutionPhase(phaseStr)
+			if err != nil {
+				return err
+			}
+
+			gasLimit, err := cmd.Flags().GetUint64(flagGasLimit)
+			if err != nil {
+				return err
+			}
+
+			sudoPayload, err := cmd.Flags().GetString(flagSudoPayload)
+			if err != nil {
+				return err
+			}
+
+			sudoVersionStr, err := cmd.Flags().GetString(flagSudoVersion)
+			if err != nil {
+				return err
+			}
+
+			sudoVersion, err := parseSudoMessageVersion(sudoVersionStr)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.SimulateCadance(cmd.Context(), &types.QuerySimulateCadanceRequest{
+				ContractAddress:    args[0],
+				Phase:              phase,
+				GasLimit:           gasLimit,
+				SudoPayload:        sudoPayload,
+				SudoMessageVersion: sudoVersion,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	cmd.Flags().String(flagPhase, "end", "Phase of the block whose sudo message is sent (begin or end)")
+	cmd.Flags().Uint64(flagGasLimit, 0, "Gas limit of the execution, the gas limit of the contract when zero")
+	cmd.Flags().String(flagSudoPayload, "", "Custom JSON payload sent to an unregistered contract")
+	cmd.Flags().String(flagSudoVersion, "v1", "Sudo message version of an unregistered contract (v1 or v2)")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 func GetCmdParams() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "params",
@@ -1,6 +1,10 @@
 package cli
 
 import (
+	"fmt"
+	"strconv"
+	"time"
+
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -10,6 +14,21 @@ import (
 	"github.com/bitsongofficial/go-bitsong/x/cadance/types"
 )
 
+const (
+	flagPhase             = "phase"
+	flagExecutionInterval = "interval"
+	flagStartHeight       = "start-height"
+	flagGasLimit          = "gas-limit"
+	flagSudoPayload       = "payload"
+	flagSudoVersion       = "sudo-version"
+	flagRunAtHeight       = "height"
+	flagRunAtTime         = "time"
+	flagCron              = "cron"
+	flagExecute           = "execute"
+	flagPayGas            = "pay-gas"
+	flagPriority          = "priority"
+)
+
 // NewTxCmd returns a root CLI command handler for certain modules/Clock
 // transaction commands.
 func NewTxCmd() *cobra.Command {
@@ -24,7 +43,11 @@ func NewTxCmd() *cobra.Command {
 	txCmd.AddCommand(
 		NewRegisterCadanceContract(),
 		NewUnregisterCadanceContract(),
+		NewUpdateCadanceContract(),
 		NewUnjailCadanceContract(),
+		NewConfirmCadanceContract(),
+		NewCreateSchedule(),
+		NewCancelSchedule(),
 	)
 	return txCmd
 }
@@ -34,8 +57,8 @@ func NewTxCmd() *cobra.Command {
 func NewRegisterCadanceContract() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "register [contract_bech32]",
-		Short: "Register a cadance contract .",
-		Long:  "Register a cadance contract . Sender must be admin of the contract.",
+		Short: "Register a cadance contract.",
+		Long:  "Register a cadance contract. Sender must be admin of the contract. The contract is executed at the end of the block unless a different --phase (begin, end or both) is given, every --interval blocks from the --start-height, with an optional --gas-limit. The contract receives its custom --payload, or the sudo message of the phase, unless --sudo-version v2 is given, in which case the message of the phase carries the block context and the payload. When gas billing is enabled, the contract pays its execution gas from its balance, unless --pay-gas is given, in which case the sender pays it. Contracts of a higher --priority, up to the max_priority param, are executed first.",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx, err := client.GetClientTxContext(cmd)
@@ -46,9 +69,72 @@ func NewRegisterCadanceContract() *cobra.Command {
 			senderAddress := cliCtx.GetFromAddress()
 			contractAddress := args[0]
 
+			phaseStr, err := cmd.Flags().GetString(flagPhase)
+			if err != nil {
+				return err
+			}
+
+			phase, err := parseExecutionPhase(phaseStr)
+			if err != nil {
+				return err
+			}
+
+			executionInterval, err := cmd.Flags().GetUint64(flagExecutionInterval)
+			if err != nil {
+				return err
+			}
+
+			startHeight, err := cmd.Flags().GetInt64(flagStartHeight)
+			if err != nil {
+				return err
+			}
+
+			gasLimit, err := cmd.Flags().GetUint64(flagGasLimit)
+			if err != nil {
+				return err
+			}
+
+			sudoPayload, err := cmd.Flags().GetString(flagSudoPayload)
+			if err != nil {
+				return err
+			}
+
+			sudoVersionStr, err := cmd.Flags().GetString(flagSudoVersion)
+			if err != nil {
+				return err
+			}
+
+			sudoVersion, err := parseSudoMessageVersion(sudoVersionStr)
+			if err != nil {
+				return err
+			}
+
+			payGas, err := cmd.Flags().GetBool(flagPayGas)
+			if err != nil {
+				return err
+			}
+
+			priority, err := cmd.Flags().GetUint32(flagPriority)
+			if err != nil {
+				return err
+			}
+
+			feePayer := ""
+			if payGas {
+				feePayer = senderAddress.String()
+			}
+
 			msg := &types.MsgRegisterCadanceContract{
-				SenderAddress:   senderAddress.String(),
-				ContractAddress: contractAddress,
+				SenderAddress:      senderAddress.String(),
+				ContractAddress:    contractAddress,
+				Phase:              phase,
+				ExecutionInterval:  executionInterval,
+				StartHeight:        startHeight,
+				GasLimit:           gasLimit,
+				SudoPayload:        sudoPayload,
+				SudoMessageVersion: sudoVersion,
+				FeePayer:           feePayer,
+				Priority:           priority,
 			}
 
 			if err := msg.ValidateBasic(); err != nil {
@@ -59,17 +145,56 @@ func NewRegisterCadanceContract() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(flagPhase, "end", "Phases of the block in which the contract is executed (begin, end or both)")
+	addExecutionFlags(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
 
+// addExecutionFlags adds the execution interval, start height, gas limit, sudo message, fee payer and priority flags to the command
+func addExecutionFlags(cmd *cobra.Command) {
+	cmd.Flags().Uint64(flagExecutionInterval, 0, "Number of blocks between two executions of the contract, every block when zero")
+	cmd.Flags().Int64(flagStartHeight, 0, "Height from which the contract is executed")
+	cmd.Flags().Uint64(flagGasLimit, 0, "Gas limit of the contract executions, the contract_gas_limit param when zero")
+	cmd.Flags().String(flagSudoPayload, "", "Custom JSON payload sent to the contract")
+	cmd.Flags().String(flagSudoVersion, "v1", "Schema of the sudo message sent to the contract (v1 or v2)")
+	cmd.Flags().Bool(flagPayGas, false, "Pay the execution gas of the contract from the sender instead of the contract balance")
+	cmd.Flags().Uint32(flagPriority, 0, "Priority of the contract, contracts of higher priority are executed first")
+}
+
+// parseExecutionPhase parses the execution phase given on the command line
+func parseExecutionPhase(phase string) (types.ExecutionPhase, error) {
+	switch phase {
+	case "begin":
+		return types.ExecutionPhaseBeginBlock, nil
+	case "end":
+		return types.ExecutionPhaseEndBlock, nil
+	case "both":
+		return types.ExecutionPhaseBeginAndEndBlock, nil
+	default:
+		return 0, fmt.Errorf("invalid phase %s, expected begin, end or both", phase)
+	}
+}
+
+// parseSudoMessageVersion parses the sudo message version given on the command line
+func parseSudoMessageVersion(version string) (types.SudoMessageVersion, error) {
+	switch version {
+	case "v1":
+		return types.SudoMessageVersionV1, nil
+	case "v2":
+		return types.SudoMessageVersionV2, nil
+	default:
+		return 0, fmt.Errorf("invalid sudo message version %s, expected v1 or v2", version)
+	}
+}
+
 // NewUnregisterCadanceContract returns a CLI command handler for unregistering a
 // contract for the cadance module.
 func NewUnregisterCadanceContract() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "unregister [contract_bech32]",
-		Short: "Unregister a cadance contract .",
-		Long:  "Unregister a cadance contract . Sender must be admin of the contract.",
+		Short: "Unregister a cadance contract.",
+		Long:  "Unregister a cadance contract. Sender must be admin of the contract.",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx, err := client.GetClientTxContext(cmd)
@@ -97,13 +222,100 @@ func NewUnregisterCadanceContract() *cobra.Command {
 	return cmd
 }
 
+// NewUpdateCadanceContract returns a CLI command handler for updating the execution
+// interval of a contract for the cadance module.
+func NewUpdateCadanceContract() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update [contract_bech32]",
+		Short: "Update the execution interval, the gas limit and the sudo message of a cadance contract.",
+		Long:  "Update the execution interval, the start height, the gas limit, the sudo message, the payer of the execution gas and the priority of a cadance contract. Sender must be admin of the contract.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			senderAddress := cliCtx.GetFromAddress()
+			contractAddress := args[0]
+
+			executionInterval, err := cmd.Flags().GetUint64(flagExecutionInterval)
+			if err != nil {
+				return err
+			}
+
+			startHeight, err := cmd.Flags().GetInt64(flagStartHeight)
+			if err != nil {
+				return err
+			}
+
+			gasLimit, err := cmd.Flags().GetUint64(flagGasLimit)
+			if err != nil {
+				return err
+			}
+
+			sudoPayload, err := cmd.Flags().GetString(flagSudoPayload)
+			if err != nil {
+				return err
+			}
+
+			sudoVersionStr, err := cmd.Flags().GetString(flagSudoVersion)
+			if err != nil {
+				return err
+			}
+
+			sudoVersion, err := parseSudoMessageVersion(sudoVersionStr)
+			if err != nil {
+				return err
+			}
+
+			payGas, err := cmd.Flags().GetBool(flagPayGas)
+			if err != nil {
+				return err
+			}
+
+			priority, err := cmd.Flags().GetUint32(flagPriority)
+			if err != nil {
+				return err
+			}
+
+			feePayer := ""
+			if payGas {
+				feePayer = senderAddress.String()
+			}
+
+			msg := &types.MsgUpdateCadanceContract{
+				SenderAddress:      senderAddress.String(),
+				ContractAddress:    contractAddress,
+				ExecutionInterval:  executionInterval,
+				StartHeight:        startHeight,
+				GasLimit:           gasLimit,
+				SudoPayload:        sudoPayload,
+				SudoMessageVersion: sudoVersion,
+				FeePayer:           feePayer,
+				Priority:           priority,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addExecutionFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // NewUnjailCadanceContract returns a CLI command handler for unjailing a
 // contract for the cadance module.
 func NewUnjailCadanceContract() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "unjail [contract_bech32]",
-		Short: "Unjail a cadance contract .",
-		Long:  "Unjail a cadance contract . Sender must be admin of the contract.",
+		Short: "Unjail a cadance contract.",
+		Long:  "Unjail a cadance contract. Sender must be admin of the contract.",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx, err := client.GetClientTxContext(cmd)
@@ -130,3 +342,153 @@ func NewUnjailCadanceContract() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// NewConfirmCadanceContract returns a CLI command handler for confirming a
+// contract of the cadance module after its code or its manager changed.
+func NewConfirmCadanceContract() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "confirm [contract_bech32]",
+		Short: "Confirm a cadance contract after its code or admin changed.",
+		Long:  "Confirm a cadance contract after it was migrated or its admin changed, resuming its executions if it was paused. Sender must be admin of the contract.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			senderAddress := cliCtx.GetFromAddress()
+			contractAddress := args[0]
+
+			msg := &types.MsgConfirmCadanceContract{
+				SenderAddress:   senderAddress.String(),
+				ContractAddress: contractAddress,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCreateSchedule returns a CLI command handler for scheduling a contract call
+// with the cadance module.
+func NewCreateSchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule [contract_bech32] [json_msg]",
+		Short: "Schedule a one-off or cron contract call.",
+		Long:  "Schedule a contract call run once at the --height or from the block --time (RFC3339), or repeatedly at the times of the --cron expression in UTC. The message is sent with sudo, in which case the sender must be admin of the contract, or executed with the sender as caller when --execute is given. The sender pays the schedule fee.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			runAtHeight, err := cmd.Flags().GetInt64(flagRunAtHeight)
+			if err != nil {
+				return err
+			}
+
+			var runAtTime *time.Time
+			timeStr, err := cmd.Flags().GetString(flagRunAtTime)
+			if err != nil {
+				return err
+			}
+			if timeStr != "" {
+				t, err := time.Parse(time.RFC3339, timeStr)
+				if err != nil {
+					return err
+				}
+				runAtTime = &t
+			}
+
+			cron, err := cmd.Flags().GetString(flagCron)
+			if err != nil {
+				return err
+			}
+
+			execute, err := cmd.Flags().GetBool(flagExecute)
+			if err != nil {
+				return err
+			}
+
+			callType := types.ScheduleCallTypeSudo
+			if execute {
+				callType = types.ScheduleCallTypeExecute
+			}
+
+			gasLimit, err := cmd.Flags().GetUint64(flagGasLimit)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgCreateSchedule{
+				Creator:         cliCtx.GetFromAddress().String(),
+				ContractAddress: args[0],
+				Msg:             args[1],
+				CallType:        callType,
+				GasLimit:        gasLimit,
+				RunAtHeight:     runAtHeight,
+				RunAtTime:       runAtTime,
+				Cron:            cron,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Int64(flagRunAtHeight, 0, "Height at which the call is run once")
+	cmd.Flags().String(flagRunAtTime, "", "Block time (RFC3339) from which the call is run once")
+	cmd.Flags().String(flagCron, "", "Cron expression, in UTC, of the times at which the call is run")
+	cmd.Flags().Bool(flagExecute, false, "Execute the message with the sender as caller instead of sudo")
+	cmd.Flags().Uint64(flagGasLimit, 0, "Gas limit of the call, the contract_gas_limit param when zero")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCancelSchedule returns a CLI command handler for cancelling a scheduled
+// contract call of the cadance module.
+func NewCancelSchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-schedule [id]",
+		Short: "Cancel a scheduled contract call.",
+		Long:  "Cancel a scheduled contract call. Sender must be the creator of the schedule, the schedule fee is not refunded.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgCancelSchedule{
+				Creator: cliCtx.GetFromAddress().String(),
+				Id:      id,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
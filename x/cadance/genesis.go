@@ -2,6 +2,7 @@ package cadance
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -11,15 +12,25 @@ import (
 )
 
 // NewGenesisState - Create a new genesis state
-func NewGenesisState(params types.Params) *types.GenesisState {
+func NewGenesisState(
+	params types.Params,
+	contracts []types.CadanceContract,
+	nextRegistrationID uint64,
+	schedules []types.Schedule,
+	nextScheduleID uint64,
+) *types.GenesisState {
 	return &types.GenesisState{
-		Params: params,
+		Params:             params,
+		Contracts:          contracts,
+		NextRegistrationId: nextRegistrationID,
+		Schedules:          schedules,
+		NextScheduleId:     nextScheduleID,
 	}
 }
 
 // DefaultGenesisState - Return a default genesis state
 func DefaultGenesisState() *types.GenesisState {
-	return NewGenesisState(types.DefaultParams())
+	return NewGenesisState(types.DefaultParams(), []types.CadanceContract{}, 1, []types.Schedule{}, 1)
 }
 
 // GetGenesisStateFromAppState returns x/auth GenesisState given raw application
@@ -40,6 +51,140 @@ func ValidateGenesis(data types.GenesisState) error {
 		return err
 	}
 
+	if err := validateGenesisContracts(data.Contracts, data.NextRegistrationId, data.Params); err != nil {
+		return err
+	}
+
+	if err := validateGenesisSchedules(data.Schedules, data.NextScheduleId, data.Params); err != nil {
+		return err
+	}
+
+	return validateGenesisExecutionStates(data.ExecutionStates)
+}
+
+// Validate the registered contracts of the genesis. The addresses must be valid and unique, and the
+// registration ids below the next registration id.
+func validateGenesisContracts(contracts []types.CadanceContract, nextRegistrationID uint64, params types.Params) error {
+	addresses := make(map[string]bool, len(contracts))
+	registrationIDs := make(map[uint64]bool, len(contracts))
+	for _, contract := range contracts {
+		if _, err := sdk.AccAddressFromBech32(contract.ContractAddress); err != nil {
+			return fmt.Errorf("invalid contract address %s: %w", contract.ContractAddress, err)
+		}
+		if addresses[contract.ContractAddress] {
+			return fmt.Errorf("duplicate contract %s", contract.ContractAddress)
+		}
+		addresses[contract.ContractAddress] = true
+
+		// Contracts registered before registration ids were introduced have none
+		if contract.RegistrationId != 0 {
+			if contract.RegistrationId >= max(nextRegistrationID, 1) {
+				return fmt.Errorf("contract %s registration id %d must be below the next registration id %d", contract.ContractAddress, contract.RegistrationId, nextRegistrationID)
+			}
+			if registrationIDs[contract.RegistrationId] {
+				return fmt.Errorf("duplicate registration id %d", contract.RegistrationId)
+			}
+			registrationIDs[contract.RegistrationId] = true
+		}
+
+		for _, address := range []string{contract.Depositor, contract.FeePayer} {
+			if _, err := sdk.AccAddressFromBech32(address); address != "" && err != nil {
+				return fmt.Errorf("contract %s: invalid address %s: %w", contract.ContractAddress, address, err)
+			}
+		}
+		if !contract.Deposit.IsNil() {
+			if err := contract.Deposit.Validate(); err != nil {
+				return fmt.Errorf("contract %s: invalid deposit: %w", contract.ContractAddress, err)
+			}
+		}
+
+		if err := contract.Phase.Validate(); err != nil {
+			return fmt.Errorf("contract %s: %w", contract.ContractAddress, err)
+		}
+		if err := types.ValidateStartHeight(contract.StartHeight); err != nil {
+			return fmt.Errorf("contract %s: %w", contract.ContractAddress, err)
+		}
+		if err := types.ValidateGasLimit(contract.GasLimit, params); err != nil {
+			return fmt.Errorf("contract %s: %w", contract.ContractAddress, err)
+		}
+		if err := types.ValidateSudoMessage(contract.SudoMessageVersion, contract.SudoPayload); err != nil {
+			return fmt.Errorf("contract %s: %w", contract.ContractAddress, err)
+		}
+	}
+
+	return nil
+}
+
+// Validate the schedules of the genesis. The ids must be unique and below the next schedule id, and
+// the schedules valid.
+func validateGenesisSchedules(schedules []types.Schedule, nextScheduleID uint64, params types.Params) error {
+	ids := make(map[uint64]bool, len(schedules))
+	for _, schedule := range schedules {
+		if schedule.Id == 0 || schedule.Id >= max(nextScheduleID, 1) {
+			return fmt.Errorf("schedule id %d must be between 1 and the next schedule id %d", schedule.Id, nextScheduleID)
+		}
+		if ids[schedule.Id] {
+			return fmt.Errorf("duplicate schedule id %d", schedule.Id)
+		}
+		ids[schedule.Id] = true
+
+		if err := validateAddresses(schedule.Creator, schedule.ContractAddress); err != nil {
+			return fmt.Errorf("schedule %d: %w", schedule.Id, err)
+		}
+		if err := schedule.CallType.Validate(); err != nil {
+			return fmt.Errorf("schedule %d: %w", schedule.Id, err)
+		}
+		if err := types.ValidateScheduleMsg(schedule.Msg); err != nil {
+			return fmt.Errorf("schedule %d: %w", schedule.Id, err)
+		}
+		if err := types.ValidateScheduleTrigger(schedule.RunAtHeight, schedule.RunAtTime, schedule.Cron); err != nil {
+			return fmt.Errorf("schedule %d: %w", schedule.Id, err)
+		}
+		if schedule.IsCron() && schedule.NextRunTime == nil {
+			return fmt.Errorf("schedule %d: cron schedule without next run time", schedule.Id)
+		}
+		if err := types.ValidateGasLimit(schedule.GasLimit, params); err != nil {
+			return fmt.Errorf("schedule %d: %w", schedule.Id, err)
+		}
+	}
+
+	return nil
+}
+
+// Validate the execution states of the genesis. There must be at most one state for the beginning
+// and one for the end of the block, whose contracts are valid addresses.
+func validateGenesisExecutionStates(states []types.ExecutionState) error {
+	phases := make(map[types.ExecutionPhase]bool, len(states))
+	for _, state := range states {
+		if state.Phase != types.ExecutionPhaseBeginBlock && state.Phase != types.ExecutionPhaseEndBlock {
+			return fmt.Errorf("invalid execution state phase %s", state.Phase)
+		}
+		if phases[state.Phase] {
+			return fmt.Errorf("duplicate execution state phase %s", state.Phase)
+		}
+		phases[state.Phase] = true
+
+		if state.RoundRobinCursor != "" {
+			if err := validateAddresses(state.RoundRobinCursor); err != nil {
+				return fmt.Errorf("execution state %s: %w", state.Phase, err)
+			}
+		}
+		if err := validateAddresses(state.PendingContracts...); err != nil {
+			return fmt.Errorf("execution state %s: %w", state.Phase, err)
+		}
+	}
+
+	return nil
+}
+
+// Validate the given bech32 addresses
+func validateAddresses(addresses ...string) error {
+	for _, address := range addresses {
+		if _, err := sdk.AccAddressFromBech32(address); err != nil {
+			return fmt.Errorf("invalid address %s: %w", address, err)
+		}
+	}
+
 	return nil
 }
 
@@ -58,13 +203,69 @@ func InitGenesis(
 	if err := k.SetParams(ctx, data.Params); err != nil {
 		panic(err)
 	}
+
+	// Set the contracts, which must exist in x/wasm
+	for _, contract := range data.Contracts {
+		if !k.GetWasmKeeper().HasContractInfo(ctx, sdk.MustAccAddressFromBech32(contract.ContractAddress)) {
+			panic(fmt.Errorf("contract %s: %w", contract.ContractAddress, types.ErrInvalidCWContract))
+		}
+		if err := k.SetCadanceContract(ctx, contract); err != nil {
+			panic(err)
+		}
+	}
+	if data.NextRegistrationId != 0 {
+		k.SetNextRegistrationID(ctx, data.NextRegistrationId)
+	}
+
+	// Set the schedules, whose contracts must exist in x/wasm
+	for _, schedule := range data.Schedules {
+		if !k.GetWasmKeeper().HasContractInfo(ctx, sdk.MustAccAddressFromBech32(schedule.ContractAddress)) {
+			panic(fmt.Errorf("schedule %d: contract %s: %w", schedule.Id, schedule.ContractAddress, types.ErrInvalidCWContract))
+		}
+		if err := k.SetSchedule(ctx, schedule); err != nil {
+			panic(err)
+		}
+	}
+	if data.NextScheduleId != 0 {
+		k.SetNextScheduleID(ctx, data.NextScheduleId)
+	}
+
+	// Set the round-robin cursors and the deferred executions
+	for _, state := range data.ExecutionStates {
+		k.SetRoundRobinCursor(ctx, state.Phase, state.RoundRobinCursor)
+		for _, contractAddress := range state.PendingContracts {
+			k.SetPendingExecution(ctx, state.Phase, contractAddress, true)
+		}
+	}
 }
 
 // ExportGenesis export module state
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	params := k.GetParams(ctx)
 
-	return &types.GenesisState{
-		Params: params,
+	contracts, err := k.GetAllContracts(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	schedules, err := k.GetAllSchedules(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	genesis := NewGenesisState(params, contracts, k.GetNextRegistrationID(ctx), schedules, k.GetNextScheduleID(ctx))
+
+	// Export the phases whose executions are in progress
+	for _, phase := range []types.ExecutionPhase{types.ExecutionPhaseBeginBlock, types.ExecutionPhaseEndBlock} {
+		state := types.ExecutionState{
+			Phase:            phase,
+			RoundRobinCursor: k.GetRoundRobinCursor(ctx, phase),
+			PendingContracts: k.GetPendingExecutions(ctx, phase),
+		}
+		if state.RoundRobinCursor != "" || len(state.PendingContracts) > 0 {
+			genesis.ExecutionStates = append(genesis.ExecutionStates, state)
+		}
 	}
+
+	return genesis
 }
@@ -2,10 +2,18 @@ package cadance_test
 
 import (
 	"fmt"
+	"sort"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+
 	"github.com/bitsongofficial/go-bitsong/x/cadance"
 	"github.com/bitsongofficial/go-bitsong/x/cadance/types"
 )
@@ -32,18 +40,14 @@ func (suite *CadanceModuleSuite) TestClockInitGenesis() {
 		{
 			"Success - Custom Genesis",
 			types.GenesisState{
-				Params: types.Params{
-					ContractGasLimit: 500_000,
-				},
+				Params: types.NewParams(500_000, sdk.NewCoin("ubtsg", math.NewInt(1_000_000)), math.LegacyNewDecWithPrec(1, 1), types.PenaltyDestinationCommunityPool, 100, 1_000_000, 10_000_000, 1, 0, 0, sdk.NewCoin("ubtsg", math.NewInt(1_000)), 10, sdk.NewDecCoin("ubtsg", math.ZeroInt()), false, 10),
 			},
 			true,
 		},
 		{
 			"Fail - Invalid Gas Amount",
 			types.GenesisState{
-				Params: types.Params{
-					ContractGasLimit: 1,
-				},
+				Params: types.NewParams(1, sdk.NewCoin("ubtsg", math.NewInt(1_000_000)), math.LegacyNewDecWithPrec(1, 1), types.PenaltyDestinationCommunityPool, 100, 1_000_000, 10_000_000, 1, 0, 0, sdk.NewCoin("ubtsg", math.NewInt(1_000)), 10, sdk.NewDecCoin("ubtsg", math.ZeroInt()), false, 10),
 			},
 			false,
 		},
@@ -68,3 +72,240 @@ func (suite *CadanceModuleSuite) TestClockInitGenesis() {
 		})
 	}
 }
+
+// Instantiate a cadance contract, returning its address
+func (suite *CadanceModuleSuite) instantiateContract() string {
+	_, _, creator := testdata.KeyTestPubAddr()
+	coins := sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(1_000_000)))
+	suite.Require().NoError(suite.App.AppKeepers.BankKeeper.MintCoins(suite.Ctx, minttypes.ModuleName, coins))
+	suite.Require().NoError(suite.App.AppKeepers.BankKeeper.SendCoinsFromModuleToAccount(suite.Ctx, minttypes.ModuleName, creator, coins))
+
+	contractKeeper := suite.App.AppKeepers.CadanceKeeper.GetContractKeeper()
+	codeID, _, err := contractKeeper.Create(suite.Ctx, creator, cadanceContract, nil)
+	suite.Require().NoError(err)
+	contractAddr, _, err := contractKeeper.Instantiate(suite.Ctx, codeID, creator, creator, []byte(`{}`), "cadance", nil)
+	suite.Require().NoError(err)
+
+	return contractAddr.String()
+}
+
+// Test that the contracts and the schedules are exported as they were imported.
+func (suite *CadanceModuleSuite) TestGenesisRoundTrip() {
+	suite.SetupTest()
+	cadanceKeeper := suite.App.AppKeepers.CadanceKeeper
+	contractAddress1 := suite.instantiateContract()
+	contractAddress2 := suite.instantiateContract()
+	_, _, creator := testdata.KeyTestPubAddr()
+	runAtTime := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	nextRunTime := time.Date(2030, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	contracts := []types.CadanceContract{
+		{
+			ContractAddress:     contractAddress1,
+			IsJailed:            true,
+			Phase:               types.ExecutionPhaseBeginAndEndBlock,
+			ExecutionInterval:   10,
+			StartHeight:         5,
+			Deposit:             sdk.NewCoin("stake", math.NewInt(1_000)),
+			Depositor:           creator.String(),
+			GasLimit:            200_000,
+			LastFailureHeight:   7,
+			ConsecutiveFailures: 3,
+			LastError:           "out of gas",
+			JailReason:          "out of gas",
+			JailCount:           2,
+			UnjailHeight:        100,
+			RegistrationId:      1,
+			FeePayer:            creator.String(),
+		},
+		{
+			ContractAddress:    contractAddress2,
+			Deposit:            sdk.NewCoin("stake", math.ZeroInt()),
+			LastExecutedHeight: 9,
+			LastGasUsed:        50_000,
+			RegistrationId:     2,
+			SudoPayload:        `{"custom":{}}`,
+			SudoMessageVersion: types.SudoMessageVersionV2,
+		},
+	}
+	sort.Slice(contracts, func(i, j int) bool {
+		return contracts[i].ContractAddress < contracts[j].ContractAddress
+	})
+
+	schedules := []types.Schedule{
+		{
+			Id:              1,
+			Creator:         creator.String(),
+			ContractAddress: contractAddress1,
+			Msg:             `{"clock_end_block":{}}`,
+			RunAtHeight:     50,
+		},
+		{
+			Id:              2,
+			Creator:         creator.String(),
+			ContractAddress: contractAddress2,
+			Msg:             `{"increment":{}}`,
+			CallType:        types.ScheduleCallTypeExecute,
+			RunAtTime:       &runAtTime,
+		},
+		{
+			Id:              4,
+			Creator:         creator.String(),
+			ContractAddress: contractAddress2,
+			Msg:             `{"increment":{}}`,
+			CallType:        types.ScheduleCallTypeExecute,
+			Cron:            "0 */12 * * *",
+			NextRunTime:     &nextRunTime,
+		},
+	}
+
+	genesis := cadance.NewGenesisState(types.DefaultParams(), contracts, 3, schedules, 5)
+	genesis.ExecutionStates = []types.ExecutionState{
+		{Phase: types.ExecutionPhaseBeginBlock, PendingContracts: []string{contractAddress1}},
+		{Phase: types.ExecutionPhaseEndBlock, RoundRobinCursor: contractAddress2, PendingContracts: []string{contractAddress2}},
+	}
+	suite.Require().NoError(cadance.ValidateGenesis(*genesis))
+	suite.Require().NotPanics(func() {
+		cadance.InitGenesis(suite.Ctx, cadanceKeeper, *genesis)
+	})
+
+	// The state is exported as it was imported
+	suite.Require().Equal(genesis, cadance.ExportGenesis(suite.Ctx, cadanceKeeper))
+	suite.Require().Equal(uint64(3), cadanceKeeper.GetNextRegistrationID(suite.Ctx))
+	suite.Require().Equal(uint64(5), cadanceKeeper.GetNextScheduleID(suite.Ctx))
+
+	// The executions resume from where they were deferred
+	suite.Require().Empty(cadanceKeeper.GetRoundRobinCursor(suite.Ctx, types.ExecutionPhaseBeginBlock))
+	suite.Require().True(cadanceKeeper.IsPendingExecution(suite.Ctx, types.ExecutionPhaseBeginBlock, contractAddress1))
+	suite.Require().Equal(contractAddress2, cadanceKeeper.GetRoundRobinCursor(suite.Ctx, types.ExecutionPhaseEndBlock))
+	suite.Require().True(cadanceKeeper.IsPendingExecution(suite.Ctx, types.ExecutionPhaseEndBlock, contractAddress2))
+
+	// The schedules are indexed by their next run
+	due, err := cadanceKeeper.GetDueSchedules(suite.Ctx.WithBlockHeight(50).WithBlockTime(nextRunTime), 0)
+	suite.Require().NoError(err)
+	suite.Require().Equal(schedules, due)
+
+	// Contracts which do not exist in x/wasm are rejected
+	suite.SetupTest()
+	suite.Require().Panics(func() {
+		cadance.InitGenesis(suite.Ctx, suite.App.AppKeepers.CadanceKeeper, *genesis)
+	})
+}
+
+func (suite *CadanceModuleSuite) TestValidateGenesis() {
+	_, _, addr1 := testdata.KeyTestPubAddr()
+	_, _, addr2 := testdata.KeyTestPubAddr()
+	nextRunTime := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	contract := func(addr sdk.AccAddress, registrationID uint64) types.CadanceContract {
+		return types.CadanceContract{ContractAddress: addr.String(), RegistrationId: registrationID}
+	}
+	schedule := func(id uint64) types.Schedule {
+		return types.Schedule{Id: id, Creator: addr1.String(), ContractAddress: addr2.String(), Msg: `{}`, RunAtHeight: 10}
+	}
+	cronSchedule := schedule(1)
+	cronSchedule.RunAtHeight = 0
+	cronSchedule.Cron = "* * * * *"
+	withExecutionStates := func(states ...types.ExecutionState) *types.GenesisState {
+		genesis := cadance.DefaultGenesisState()
+		genesis.ExecutionStates = states
+		return genesis
+	}
+
+	testCases := []struct {
+		name    string
+		genesis *types.GenesisState
+		success bool
+	}{
+		{
+			"Success - Default Genesis",
+			cadance.DefaultGenesisState(),
+			true,
+		},
+		{
+			"Success - Contracts And Schedules",
+			cadance.NewGenesisState(types.DefaultParams(), []types.CadanceContract{contract(addr1, 1), contract(addr2, 0)}, 2, []types.Schedule{schedule(1), schedule(2)}, 3),
+			true,
+		},
+		{
+			"Fail - Invalid Contract Address",
+			cadance.NewGenesisState(types.DefaultParams(), []types.CadanceContract{{ContractAddress: "invalid"}}, 1, nil, 1),
+			false,
+		},
+		{
+			"Fail - Duplicate Contract",
+			cadance.NewGenesisState(types.DefaultParams(), []types.CadanceContract{contract(addr1, 1), contract(addr1, 2)}, 3, nil, 1),
+			false,
+		},
+		{
+			"Fail - Duplicate Registration Id",
+			cadance.NewGenesisState(types.DefaultParams(), []types.CadanceContract{contract(addr1, 1), contract(addr2, 1)}, 2, nil, 1),
+			false,
+		},
+		{
+			"Fail - Registration Id Above Next Registration Id",
+			cadance.NewGenesisState(types.DefaultParams(), []types.CadanceContract{contract(addr1, 2)}, 2, nil, 1),
+			false,
+		},
+		{
+			"Fail - Invalid Sudo Payload",
+			cadance.NewGenesisState(types.DefaultParams(), []types.CadanceContract{{ContractAddress: addr1.String(), SudoPayload: "invalid"}}, 1, nil, 1),
+			false,
+		},
+		{
+			"Fail - Duplicate Schedule Id",
+			cadance.NewGenesisState(types.DefaultParams(), nil, 1, []types.Schedule{schedule(1), schedule(1)}, 2),
+			false,
+		},
+		{
+			"Fail - Schedule Id Above Next Schedule Id",
+			cadance.NewGenesisState(types.DefaultParams(), nil, 1, []types.Schedule{schedule(2)}, 2),
+			false,
+		},
+		{
+			"Fail - Cron Schedule Without Next Run Time",
+			cadance.NewGenesisState(types.DefaultParams(), nil, 1, []types.Schedule{cronSchedule}, 2),
+			false,
+		},
+		{
+			"Success - Execution States",
+			withExecutionStates(
+				types.ExecutionState{Phase: types.ExecutionPhaseBeginBlock, RoundRobinCursor: addr1.String()},
+				types.ExecutionState{Phase: types.ExecutionPhaseEndBlock, PendingContracts: []string{addr1.String(), addr2.String()}},
+			),
+			true,
+		},
+		{
+			"Fail - Duplicate Execution State Phase",
+			withExecutionStates(
+				types.ExecutionState{Phase: types.ExecutionPhaseEndBlock},
+				types.ExecutionState{Phase: types.ExecutionPhaseEndBlock},
+			),
+			false,
+		},
+		{
+			"Fail - Execution State Of Both Phases",
+			withExecutionStates(types.ExecutionState{Phase: types.ExecutionPhaseBeginAndEndBlock}),
+			false,
+		},
+		{
+			"Fail - Invalid Pending Contract",
+			withExecutionStates(types.ExecutionState{Phase: types.ExecutionPhaseEndBlock, PendingContracts: []string{"invalid"}}),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			err := cadance.ValidateGenesis(*tc.genesis)
+			if tc.success {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+
+	// A cron schedule with its next run time is valid
+	cronSchedule.NextRunTime = &nextRunTime
+	suite.Require().NoError(cadance.ValidateGenesis(*cadance.NewGenesisState(types.DefaultParams(), nil, 1, []types.Schedule{cronSchedule}, 2)))
+}
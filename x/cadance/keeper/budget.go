@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bitsongofficial/go-bitsong/x/cadance/types"
+)
+
+// Store Keys for the block gas budget of the cadance contracts
+var (
+	StoreKeyBlockGasUsed      = []byte("block_gas_used")
+	StoreKeyRoundRobinCursor  = []byte("round_robin_cursor")
+	StoreKeyPendingExecutions = []byte("pending_executions")
+)
+
+// Get the gas used by the cadance contracts in the current block.
+func (k Keeper) GetBlockGasUsed(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(StoreKeyBlockGasUsed)
+	if len(bz) != 16 {
+		return 0
+	}
+
+	// The gas used is stored along with the height of the block, so that it is reset every block
+	if int64(binary.BigEndian.Uint64(bz[:8])) != ctx.BlockHeight() {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(bz[8:])
+}
+
+// Set the gas used by the cadance contracts and the schedules in the current block.
+func (k Keeper) SetBlockGasUsed(ctx sdk.Context, gasUsed uint64) {
+	bz := make([]byte, 16)
+	binary.BigEndian.PutUint64(bz[:8], uint64(ctx.BlockHeight()))
+	binary.BigEndian.PutUint64(bz[8:], gasUsed)
+
+	ctx.KVStore(k.storeKey).Set(StoreKeyBlockGasUsed, bz)
+}
+
+// Get the address of the contract from which the execution of the given phase starts.
+func (k Keeper) GetRoundRobinCursor(ctx sdk.Context, phase types.ExecutionPhase) string {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), StoreKeyRoundRobinCursor)
+	return string(store.Get([]byte{byte(phase)}))
+}
+
+// Set the address of the contract from which the execution of the given phase starts, the
+// execution starts from the first contract when empty.
+func (k Keeper) SetRoundRobinCursor(ctx sdk.Context, phase types.ExecutionPhase, contractAddress string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), StoreKeyRoundRobinCursor)
+	if contractAddress == "" {
+		store.Delete([]byte{byte(phase)})
+		return
+	}
+
+	store.Set([]byte{byte(phase)}, []byte(contractAddress))
+}
+
+// Get the store of the contract executions of a phase deferred because of the block gas limit.
+func (k Keeper) getPendingStore(ctx sdk.Context, phase types.ExecutionPhase) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), append(StoreKeyPendingExecutions, byte(phase)))
+}
+
+// Check if the execution of a contract in the given phase has been deferred.
+func (k Keeper) IsPendingExecution(ctx sdk.Context, phase types.ExecutionPhase, contractAddress string) bool {
+	return k.getPendingStore(ctx, phase).Has([]byte(contractAddress))
+}
+
+// Set whether the execution of a contract in the given phase has been deferred.
+func (k Keeper) SetPendingExecution(ctx sdk.Context, phase types.ExecutionPhase, contractAddress string, pending bool) {
+	store := k.getPendingStore(ctx, phase)
+	if pending {
+		store.Set([]byte(contractAddress), []byte{1})
+		return
+	}
+
+	store.Delete([]byte(contractAddress))
+}
+
+// Get the contracts whose execution in the given phase has been deferred.
+func (k Keeper) GetPendingExecutions(ctx sdk.Context, phase types.ExecutionPhase) []string {
+	iterator := k.getPendingStore(ctx, phase).Iterator(nil, nil)
+	defer iterator.Close()
+
+	contracts := []string{}
+	for ; iterator.Valid(); iterator.Next() {
+		contracts = append(contracts, string(iterator.Key()))
+	}
+
+	return contracts
+}
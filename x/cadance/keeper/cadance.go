@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"encoding/binary"
 	"fmt"
 
 	"cosmossdk.io/store/prefix"
@@ -10,17 +11,33 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
-// Store Keys for cadance contract s (both jailed and unjailed)
+// Store Keys for cadance contracts (both jailed and unjailed)
 var (
-	StoreKeyContracts = []byte("contracts")
+	StoreKeyContracts          = []byte("contracts")
+	StoreKeyNextRegistrationID = []byte("next_registration_id")
 )
 
-// Get the store for the cadance contract s.
+// Get the id assigned to the next registration of a cadance contract.
+func (k Keeper) GetNextRegistrationID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(StoreKeyNextRegistrationID)
+	if bz == nil {
+		return 1
+	}
+
+	return binary.BigEndian.Uint64(bz)
+}
+
+// Set the id assigned to the next registration of a cadance contract.
+func (k Keeper) SetNextRegistrationID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(StoreKeyNextRegistrationID, sdk.Uint64ToBigEndian(id))
+}
+
+// Get the store for the cadance contracts.
 func (k Keeper) getStore(ctx sdk.Context) prefix.Store {
 	return prefix.NewStore(ctx.KVStore(k.storeKey), StoreKeyContracts)
 }
 
-// Set a cadance contract  address in the KV store.
+// Set a cadance contract address in the KV store.
 func (k Keeper) SetCadanceContract(ctx sdk.Context, contract types.CadanceContract) error {
 	// Get store, marshal content
 	store := k.getStore(ctx)
@@ -34,13 +51,13 @@ func (k Keeper) SetCadanceContract(ctx sdk.Context, contract types.CadanceContra
 	return nil
 }
 
-// Check if a cadance contract  address is in the KV store.
+// Check if a cadance contract address is in the KV store.
 func (k Keeper) IsCadanceContract(ctx sdk.Context, contractAddress string) bool {
 	store := k.getStore(ctx)
 	return store.Has([]byte(contractAddress))
 }
 
-// Get a cadance contract  address from the KV store.
+// Get a cadance contract address from the KV store.
 func (k Keeper) GetCadanceContract(ctx sdk.Context, contractAddress string) (*types.CadanceContract, error) {
 	// Check if the contract is registered
 	if !k.IsCadanceContract(ctx, contractAddress) {
@@ -62,7 +79,7 @@ func (k Keeper) GetCadanceContract(ctx sdk.Context, contractAddress string) (*ty
 	return &contract, nil
 }
 
-// Get all cadance contract  addresses from the KV store.
+// Get all cadance contract addresses from the KV store.
 func (k Keeper) GetAllContracts(ctx sdk.Context) ([]types.CadanceContract, error) {
 	// Get the KV store
 	store := k.getStore(ctx)
@@ -122,7 +139,7 @@ func (k Keeper) GetPaginatedContracts(ctx sdk.Context, pag *query.PageRequest) (
 	}, nil
 }
 
-// Remove a cadance contract  address from the KV store.
+// Remove a cadance contract address from the KV store.
 func (k Keeper) RemoveContract(ctx sdk.Context, contractAddress string) {
 	store := k.getStore(ctx)
 	key := []byte(contractAddress)
@@ -130,32 +147,155 @@ func (k Keeper) RemoveContract(ctx sdk.Context, contractAddress string) {
 	if store.Has(key) {
 		store.Delete(key)
 	}
+
+	// Remove the deferred executions
+	k.SetPendingExecution(ctx, types.ExecutionPhaseBeginBlock, contractAddress, false)
+	k.SetPendingExecution(ctx, types.ExecutionPhaseEndBlock, contractAddress, false)
+}
+
+// Register a cadance contract address in the KV store, with the execution settings (phase,
+// execution interval, start height, gas limit, sudo message, fee payer and priority) requested by the
+// sender. Returns the id assigned to the registration.
+func (k Keeper) RegisterContract(ctx sdk.Context, senderAddress string, contract types.CadanceContract) (uint64, error) {
+	// Ensure the execution settings are valid and the contract is not registered
+	if err := k.validateRegistration(ctx, contract); err != nil {
+		return 0, err
+	}
+	if err := types.ValidateFeePayer(contract.FeePayer, senderAddress); err != nil {
+		return 0, err
+	}
+	if err := types.ValidatePriority(contract.Priority, k.GetParams(ctx)); err != nil {
+		return 0, err
+	}
+
+	// Ensure the sender is the contract admin or creator
+	if ok, err := k.IsContractManager(ctx, senderAddress, contract.ContractAddress); !ok {
+		return 0, err
+	}
+
+	return k.storeRegistration(ctx, contract, senderAddress, k.GetParams(ctx).RegistrationDeposit)
 }
 
-// Register a cadance contract  address in the KV store.
-func (k Keeper) RegisterContract(ctx sdk.Context, senderAddress string, contractAddress string) error {
+// Ensure the execution settings of a cadance contract are valid and that it is not registered yet.
+func (k Keeper) validateRegistration(ctx sdk.Context, contract types.CadanceContract) error {
+	if err := contract.Phase.Validate(); err != nil {
+		return err
+	}
+	if err := types.ValidateStartHeight(contract.StartHeight); err != nil {
+		return err
+	}
+	if err := types.ValidateGasLimit(contract.GasLimit, k.GetParams(ctx)); err != nil {
+		return err
+	}
+	if err := types.ValidateSudoMessage(contract.SudoMessageVersion, contract.SudoPayload); err != nil {
+		return err
+	}
+
 	// Check if the contract is already registered
-	if k.IsCadanceContract(ctx, contractAddress) {
+	if k.IsCadanceContract(ctx, contract.ContractAddress) {
 		return types.ErrContractAlreadyRegistered
 	}
 
+	return nil
+}
+
+// Store the registration of a cadance contract, collecting the deposit from the depositor. Returns
+// the id assigned to the registration.
+func (k Keeper) storeRegistration(ctx sdk.Context, contract types.CadanceContract, depositor string, deposit sdk.Coin) (uint64, error) {
+	// Ensure the maximum number of contracts is not reached
+	if p := k.GetParams(ctx); p.MaxContracts > 0 && k.GetContractCount(ctx) >= p.MaxContracts {
+		return 0, types.ErrMaxContractsReached.Wrapf("max %d", p.MaxContracts)
+	}
+
+	// Record the code id and the manager the contract is registered with
+	codeID, manager, err := k.getContractLifecycle(ctx, contract.ContractAddress)
+	if err != nil {
+		return 0, err
+	}
+
+	// Collect the registration deposit
+	if err := k.collectDeposit(ctx, depositor, deposit); err != nil {
+		return 0, err
+	}
+
+	// Assign the registration id
+	registrationID := k.GetNextRegistrationID(ctx)
+	k.SetNextRegistrationID(ctx, registrationID+1)
+
+	// Register contract, keeping only its execution settings
+	return registrationID, k.SetCadanceContract(ctx, types.CadanceContract{
+		ContractAddress:    contract.ContractAddress,
+		Phase:              contract.Phase,
+		ExecutionInterval:  contract.ExecutionInterval,
+		StartHeight:        contract.StartHeight,
+		GasLimit:           contract.GasLimit,
+		Deposit:            deposit,
+		Depositor:          depositor,
+		RegistrationId:     registrationID,
+		SudoPayload:        contract.SudoPayload,
+		SudoMessageVersion: contract.SudoMessageVersion,
+		FeePayer:           contract.FeePayer,
+		CodeId:             codeID,
+		Manager:            manager,
+		Priority:           contract.Priority,
+	})
+}
+
+// Update the execution interval, the start height, the gas limit, the sudo message, the fee payer
+// and the priority of a cadance contract with the settings requested by the sender. An unchanged
+// priority is kept even if it is above the maximum, so that the priority granted by governance is
+// not lost.
+func (k Keeper) UpdateContract(ctx sdk.Context, senderAddress string, settings types.CadanceContract) error {
+	// Ensure the start height, the gas limit, the sudo message and the fee payer are valid
+	if err := types.ValidateStartHeight(settings.StartHeight); err != nil {
+		return err
+	}
+	if err := types.ValidateGasLimit(settings.GasLimit, k.GetParams(ctx)); err != nil {
+		return err
+	}
+	if err := types.ValidateSudoMessage(settings.SudoMessageVersion, settings.SudoPayload); err != nil {
+		return err
+	}
+	if err := types.ValidateFeePayer(settings.FeePayer, senderAddress); err != nil {
+		return err
+	}
+
+	// Get the contract
+	contract, err := k.GetCadanceContract(ctx, settings.ContractAddress)
+	if err != nil {
+		return err
+	}
+
+	// Ensure a changed priority is valid
+	if settings.Priority != contract.Priority {
+		if err := types.ValidatePriority(settings.Priority, k.GetParams(ctx)); err != nil {
+			return err
+		}
+	}
+
 	// Ensure the sender is the contract admin or creator
-	if ok, err := k.IsContractManager(ctx, senderAddress, contractAddress); !ok {
+	if ok, err := k.IsContractManager(ctx, senderAddress, settings.ContractAddress); !ok {
 		return err
 	}
 
-	// Register contract
-	return k.SetCadanceContract(ctx, types.CadanceContract{
-		ContractAddress: contractAddress,
-		IsJailed:        false,
-	})
+	// Update the schedule, the sudo message, the fee payer and the priority
+	contract.ExecutionInterval = settings.ExecutionInterval
+	contract.StartHeight = settings.StartHeight
+	contract.GasLimit = settings.GasLimit
+	contract.SudoPayload = settings.SudoPayload
+	contract.SudoMessageVersion = settings.SudoMessageVersion
+	contract.FeePayer = settings.FeePayer
+	contract.Priority = settings.Priority
+
+	return k.SetCadanceContract(ctx, *contract)
 }
 
-// Unregister a cadance contract  from either the jailed or unjailed KV store.
+// Unregister a cadance contract from either the jailed or unjailed KV store, refunding its deposit.
 func (k Keeper) UnregisterContract(ctx sdk.Context, senderAddress string, contractAddress string) error {
-	// Check if the contract is registered in either store
-	if !k.IsCadanceContract(ctx, contractAddress) {
-		return types.ErrContractNotRegistered
+	// Get the contract, ensuring it is registered in either store
+	contract, err := k.GetCadanceContract(ctx, contractAddress)
+	if err != nil {
+		return err
 	}
 
 	// Ensure the sender is the contract admin or creator
@@ -163,12 +303,27 @@ func (k Keeper) UnregisterContract(ctx sdk.Context, senderAddress string, contra
 		return err
 	}
 
+	// Ensure the contract is not jailed by governance, which would release it on re-registration
+	if contract.JailedByAuthority {
+		return types.ErrJailedByAuthority
+	}
+
+	return k.unregisterContract(ctx, *contract)
+}
+
+// Unregister a cadance contract, refunding its deposit.
+func (k Keeper) unregisterContract(ctx sdk.Context, contract types.CadanceContract) error {
+	// Refund the deposit
+	if err := k.refundDeposit(ctx, contract); err != nil {
+		return err
+	}
+
 	// Remove contract from both stores
-	k.RemoveContract(ctx, contractAddress)
+	k.RemoveContract(ctx, contract.ContractAddress)
 	return nil
 }
 
-// Set the jail status of a cadance contract  in the KV store.
+// Set the jail status of a cadance contract in the KV store.
 func (k Keeper) SetJailStatus(ctx sdk.Context, contractAddress string, isJailed bool) error {
 	// Get the contract
 	contract, err := k.GetCadanceContract(ctx, contractAddress)
@@ -185,20 +340,33 @@ func (k Keeper) SetJailStatus(ctx sdk.Context, contractAddress string, isJailed
 		return types.ErrContractNotJailed
 	}
 
-	// Set the jail status
-	contract.IsJailed = isJailed
+	// Set the jail status, the jail state is cleared on unjail
+	if isJailed {
+		contract.IsJailed = true
+	} else {
+		contract.Unjail()
+	}
 
 	// Set the contract
 	return k.SetCadanceContract(ctx, *contract)
 }
 
-// Set the jail status of a cadance contract  by the sender address.
+// Set the jail status of a cadance contract by the sender address.
 func (k Keeper) SetJailStatusBySender(ctx sdk.Context, senderAddress string, contractAddress string, jailStatus bool) error {
 	// Ensure the sender is the contract admin or creator
 	if ok, err := k.IsContractManager(ctx, senderAddress, contractAddress); !ok {
 		return err
 	}
 
+	// Ensure the contract is not jailed by governance
+	contract, err := k.GetCadanceContract(ctx, contractAddress)
+	if err != nil {
+		return err
+	}
+	if contract.JailedByAuthority {
+		return types.ErrJailedByAuthority
+	}
+
 	return k.SetJailStatus(ctx, contractAddress, jailStatus)
 }
 
@@ -0,0 +1,126 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/bitsongofficial/go-bitsong/x/cadance/types"
+)
+
+// Get the number of registered cadance contracts.
+func (k Keeper) GetContractCount(ctx sdk.Context) uint64 {
+	iterator := storetypes.KVStorePrefixIterator(k.getStore(ctx), []byte(nil))
+	defer iterator.Close()
+
+	count := uint64(0)
+	for ; iterator.Valid(); iterator.Next() {
+		count++
+	}
+
+	return count
+}
+
+// Collect the registration deposit from the depositor into the module account.
+func (k Keeper) collectDeposit(ctx sdk.Context, depositor string, deposit sdk.Coin) error {
+	if !deposit.IsPositive() {
+		return nil
+	}
+
+	depositorAddr, err := sdk.AccAddressFromBech32(depositor)
+	if err != nil {
+		return err
+	}
+
+	return k.bankKeeper.SendCoinsFromAccountToModule(ctx, depositorAddr, types.ModuleName, sdk.NewCoins(deposit))
+}
+
+// Refund the remaining deposit of a cadance contract to its depositor.
+func (k Keeper) refundDeposit(ctx sdk.Context, contract types.CadanceContract) error {
+	if contract.Deposit.IsNil() || !contract.Deposit.IsPositive() {
+		return nil
+	}
+
+	depositorAddr, err := sdk.AccAddressFromBech32(contract.Depositor)
+	if err != nil {
+		return err
+	}
+
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, depositorAddr, sdk.NewCoins(contract.Deposit))
+}
+
+// Take the jail penalty from the deposit of a cadance contract, burning it or sending it to the
+// community pool according to the params.
+func (k Keeper) slashDeposit(ctx sdk.Context, contract *types.CadanceContract) error {
+	if contract.Deposit.IsNil() || !contract.Deposit.IsPositive() {
+		return nil
+	}
+
+	p := k.GetParams(ctx)
+	if p.JailPenalty.IsNil() {
+		return nil
+	}
+
+	penalty := math.LegacyNewDecFromInt(contract.Deposit.Amount).Mul(p.JailPenalty).TruncateInt()
+	if !penalty.IsPositive() {
+		return nil
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(contract.Deposit.Denom, penalty))
+	switch p.JailPenaltyDestination {
+	case types.PenaltyDestinationCommunityPool:
+		if err := k.distrKeeper.FundCommunityPool(ctx, coins, authtypes.NewModuleAddress(types.ModuleName)); err != nil {
+			return err
+		}
+	case types.PenaltyDestinationBurn:
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unknown jail penalty destination %d", p.JailPenaltyDestination)
+	}
+
+	contract.Deposit = contract.Deposit.SubAmount(penalty)
+	return nil
+}
+
+// Jail a cadance contract for the given reason, taking the jail penalty from its deposit. The
+// contract is unjailed automatically after the unjail cooldown, which doubles for every jail.
+func (k Keeper) JailContract(ctx sdk.Context, contractAddress string, reason string) error {
+	// Get the contract
+	contract, err := k.GetCadanceContract(ctx, contractAddress)
+	if err != nil {
+		return err
+	}
+
+	if contract.IsJailed {
+		return types.ErrContractAlreadyJailed
+	}
+
+	// Take the penalty
+	if err := k.slashDeposit(ctx, contract); err != nil {
+		return err
+	}
+
+	contract.IsJailed = true
+	contract.JailReason = types.TruncateError(reason)
+	contract.JailCount++
+
+	// Schedule the automatic unjail
+	if cooldown := k.GetParams(ctx).JailCooldown(contract.JailCount); cooldown > 0 {
+		contract.UnjailHeight = ctx.BlockHeight() + int64(cooldown)
+	}
+
+	if err := k.SetCadanceContract(ctx, *contract); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventContractJailed{
+		ContractAddress: contractAddress,
+		Reason:          contract.JailReason,
+		UnjailHeight:    contract.UnjailHeight,
+	})
+}
@@ -0,0 +1,207 @@
+package keeper
+
+import (
+	"errors"
+
+	storetypes "cosmossdk.io/store/types"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/bitsongofficial/go-bitsong/x/cadance/types"
+)
+
+// Record the successful execution of a cadance contract in the given phase of the block.
+func (k Keeper) RecordExecutionSuccess(ctx sdk.Context, contractAddress string, phase types.ExecutionPhase, gasUsed uint64) error {
+	// Get the contract
+	contract, err := k.GetCadanceContract(ctx, contractAddress)
+	if err != nil {
+		return err
+	}
+
+	contract.LastExecutedHeight = ctx.BlockHeight()
+	contract.LastGasUsed = gasUsed
+	contract.ConsecutiveFailures = 0
+
+	if err := k.SetCadanceContract(ctx, *contract); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventContractExecuted{
+		ContractAddress: contractAddress,
+		Phase:           phase,
+		GasUsed:         gasUsed,
+	})
+}
+
+// Record the failed execution of a cadance contract in the given phase of the block, jailing it
+// when the number of consecutive failures reaches the failure threshold.
+func (k Keeper) RecordExecutionFailure(ctx sdk.Context, contractAddress string, phase types.ExecutionPhase, gasUsed uint64, execErr error) error {
+	// Get the contract
+	contract, err := k.GetCadanceContract(ctx, contractAddress)
+	if err != nil {
+		return err
+	}
+
+	contract.LastFailureHeight = ctx.BlockHeight()
+	contract.LastGasUsed = gasUsed
+	contract.ConsecutiveFailures++
+	contract.LastError = types.TruncateError(execErr.Error())
+
+	if err := k.SetCadanceContract(ctx, *contract); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventContractExecutionFailed{
+		ContractAddress: contractAddress,
+		Phase:           phase,
+		GasUsed:         gasUsed,
+		Error:           contract.LastError,
+	}); err != nil {
+		return err
+	}
+
+	// Jail the contract once the failure threshold is reached
+	if contract.ConsecutiveFailures < k.GetParams(ctx).FailureThreshold {
+		return nil
+	}
+
+	return k.JailContract(ctx, contractAddress, execErr.Error())
+}
+
+// Charge the gas used by the execution of a cadance contract at the gas price of the params, from
+// the contract or its fee payer to the fee collector. A contract whose payer cannot pay is jailed.
+func (k Keeper) ChargeExecutionGas(ctx sdk.Context, contractAddress string, gasUsed uint64) error {
+	fee := k.GetParams(ctx).GasFee(gasUsed)
+	if !fee.IsPositive() {
+		return nil
+	}
+
+	// Get the contract
+	contract, err := k.GetCadanceContract(ctx, contractAddress)
+	if err != nil {
+		return err
+	}
+
+	payer := contract.GasPayer()
+	payerAddr, err := sdk.AccAddressFromBech32(payer)
+	if err != nil {
+		return err
+	}
+
+	// Jail the contract if the payer cannot pay, unless it is already jailed
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, payerAddr, authtypes.FeeCollectorName, sdk.NewCoins(fee)); err != nil {
+		if contract.IsJailed {
+			return nil
+		}
+
+		return k.JailContract(ctx, contractAddress, types.ErrInsufficientGasFunds.Wrapf("%s from %s: %s", fee, payer, err).Error())
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventContractGasCharged{
+		ContractAddress: contractAddress,
+		Payer:           payer,
+		GasUsed:         gasUsed,
+		Fee:             fee,
+	})
+}
+
+// Simulate the execution of a contract in the given phase of the current block on a cached context,
+// which is discarded so that nothing is persisted. The contract is not required to be registered.
+func (k Keeper) SimulateExecution(ctx sdk.Context, contract types.CadanceContract, phase types.ExecutionPhase) (*types.QuerySimulateCadanceResponse, error) {
+	contractAddr, err := sdk.AccAddressFromBech32(contract.ContractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the sudo message of the contract
+	msgBz, err := contract.BuildSudoMessage(phase, ctx.BlockHeight(), ctx.BlockTime())
+	if err != nil {
+		return nil, err
+	}
+
+	// Execute the contract on a cached context with its own gas meter and event manager
+	gasLimit := contract.EffectiveGasLimit(k.GetParams(ctx))
+	cacheCtx, _ := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(storetypes.NewGasMeter(gasLimit)).WithEventManager(sdk.NewEventManager())
+	ExecuteContract(k.GetContractKeeper(), cacheCtx, contractAddr, msgBz, &err)
+
+	res := &types.QuerySimulateCadanceResponse{
+		Result:      types.SimulationResultSuccess,
+		GasUsed:     cacheCtx.GasMeter().GasConsumedToLimit(),
+		GasLimit:    gasLimit,
+		SudoMessage: string(msgBz),
+		Events:      cacheCtx.EventManager().ABCIEvents(),
+	}
+	if err != nil {
+		res.Error = err.Error()
+		switch {
+		case errors.Is(err, types.ErrOutOfGas):
+			res.Result = types.SimulationResultOutOfGas
+		case errors.Is(err, types.ErrContractExecutionPanic):
+			res.Result = types.SimulationResultPanic
+		default:
+			res.Result = types.SimulationResultError
+		}
+	}
+
+	return res, nil
+}
+
+// Execute contract, recover from panic
+func ExecuteContract(k wasmtypes.ContractOpsKeeper, childCtx sdk.Context, contractAddr sdk.AccAddress, msgBz []byte, err *error) {
+	// Recover from panic, return error
+	defer recoverContractPanic(err)
+
+	// Execute contract with sudo
+	_, *err = k.Sudo(childCtx, contractAddr, msgBz)
+}
+
+// Run the call of a schedule with sudo, or as the creator of the schedule, recover from panic
+func ExecuteSchedule(k wasmtypes.ContractOpsKeeper, childCtx sdk.Context, schedule types.Schedule) (err error) {
+	// Recover from panic, return error
+	defer recoverContractPanic(&err)
+
+	contractAddr, err := sdk.AccAddressFromBech32(schedule.ContractAddress)
+	if err != nil {
+		return err
+	}
+
+	if schedule.CallType == types.ScheduleCallTypeExecute {
+		creatorAddr, err := sdk.AccAddressFromBech32(schedule.Creator)
+		if err != nil {
+			return err
+		}
+
+		_, err = k.Execute(childCtx, contractAddr, creatorAddr, []byte(schedule.Msg), nil)
+		return err
+	}
+
+	_, err = k.Sudo(childCtx, contractAddr, []byte(schedule.Msg))
+	return err
+}
+
+// Recover from the panic of a contract call, setting the error associated with the panic
+func recoverContractPanic(err *error) {
+	if recoveryError := recover(); recoveryError != nil {
+		// Determine error associated with panic
+		if isOutofGas, msg := IsOutOfGasError(recoveryError); isOutofGas {
+			*err = types.ErrOutOfGas.Wrapf("%s", msg)
+		} else {
+			*err = types.ErrContractExecutionPanic.Wrapf("%s", recoveryError)
+		}
+	}
+}
+
+// Check if error is out of gas error
+func IsOutOfGasError(err any) (bool, string) {
+	switch e := err.(type) {
+	case storetypes.ErrorOutOfGas:
+		return true, e.Descriptor
+	case storetypes.ErrorGasOverflow:
+		return true, e.Descriptor
+	default:
+		return false, ""
+	}
+}
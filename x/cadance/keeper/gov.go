@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bitsongofficial/go-bitsong/x/cadance/types"
+)
+
+// Register a cadance contract on behalf of its admin or creator, without deposit. The contract
+// pays its own execution gas. Returns the id assigned to the registration.
+func (k Keeper) ForceRegisterContract(ctx sdk.Context, contract types.CadanceContract) (uint64, error) {
+	// Ensure the execution settings are valid and the contract is not registered
+	if err := k.validateRegistration(ctx, contract); err != nil {
+		return 0, err
+	}
+
+	// Ensure the contract is a cosm wasm contract
+	contractAddr, err := sdk.AccAddressFromBech32(contract.ContractAddress)
+	if err != nil {
+		return 0, err
+	}
+	if !k.wasmKeeper.HasContractInfo(ctx, contractAddr) {
+		return 0, types.ErrInvalidCWContract
+	}
+
+	contract.FeePayer = ""
+	deposit := sdk.NewCoin(k.GetParams(ctx).RegistrationDeposit.Denom, math.ZeroInt())
+	registrationID, err := k.storeRegistration(ctx, contract, "", deposit)
+	if err != nil {
+		return 0, err
+	}
+
+	return registrationID, ctx.EventManager().EmitTypedEvent(&types.EventContractForceRegistered{
+		ContractAddress: contract.ContractAddress,
+		RegistrationId:  registrationID,
+	})
+}
+
+// Jail a cadance contract by governance for the given reason. The contract is not penalized, but
+// it is not unjailed automatically nor by its manager, only unregistering it releases it.
+func (k Keeper) ForceJailContract(ctx sdk.Context, contractAddress string, reason string) error {
+	// Get the contract
+	contract, err := k.GetCadanceContract(ctx, contractAddress)
+	if err != nil {
+		return err
+	}
+
+	if contract.JailedByAuthority {
+		return types.ErrContractAlreadyJailed
+	}
+
+	if reason == "" {
+		reason = types.ErrJailedByAuthority.Error()
+	}
+
+	contract.IsJailed = true
+	contract.JailedByAuthority = true
+	contract.JailReason = types.TruncateError(reason)
+	contract.UnjailHeight = 0
+
+	if err := k.SetCadanceContract(ctx, *contract); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventContractJailed{
+		ContractAddress: contractAddress,
+		Reason:          contract.JailReason,
+	})
+}
+
+// Unregister a cadance contract by governance, refunding its deposit to its depositor.
+func (k Keeper) ForceUnregisterContract(ctx sdk.Context, contractAddress string) error {
+	// Get the contract
+	contract, err := k.GetCadanceContract(ctx, contractAddress)
+	if err != nil {
+		return err
+	}
+
+	if err := k.unregisterContract(ctx, *contract); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventContractForceUnregistered{
+		ContractAddress: contractAddress,
+	})
+}
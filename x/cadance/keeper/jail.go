@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bitsongofficial/go-bitsong/x/cadance/types"
+)
+
+// Unjail the cadance contracts whose unjail cooldown has elapsed.
+func (k Keeper) UnjailCooledDownContracts(ctx sdk.Context) error {
+	contracts, err := k.GetAllContracts(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, contract := range contracts {
+		if !contract.IsUnjailDue(ctx.BlockHeight()) {
+			continue
+		}
+
+		contract.Unjail()
+		if err := k.SetCadanceContract(ctx, contract); err != nil {
+			return err
+		}
+
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventContractUnjailed{
+			ContractAddress: contract.ContractAddress,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -21,6 +21,9 @@ type Keeper struct {
 
 	wasmKeeper     wasmkeeper.Keeper
 	contractKeeper *wasmkeeper.PermissionedKeeper
+	bankKeeper     types.BankKeeper
+	distrKeeper    types.DistrKeeper
+	stakingKeeper  types.StakingKeeper
 
 	authority string
 }
@@ -30,6 +33,9 @@ func NewKeeper(
 	cdc codec.BinaryCodec,
 	wasmKeeper wasmkeeper.Keeper,
 	contractKeeper *wasmkeeper.PermissionedKeeper,
+	bankKeeper types.BankKeeper,
+	distrKeeper types.DistrKeeper,
+	stakingKeeper types.StakingKeeper,
 	authority string,
 ) Keeper {
 	return Keeper{
@@ -37,6 +43,9 @@ func NewKeeper(
 		storeKey:       key,
 		wasmKeeper:     wasmKeeper,
 		contractKeeper: contractKeeper,
+		bankKeeper:     bankKeeper,
+		distrKeeper:    distrKeeper,
+		stakingKeeper:  stakingKeeper,
 		authority:      authority,
 	}
 }
@@ -81,6 +90,11 @@ func (k Keeper) GetContractKeeper() *wasmkeeper.PermissionedKeeper {
 	return k.contractKeeper
 }
 
+// GetWasmKeeper returns the x/wasm module's keeper.
+func (k Keeper) GetWasmKeeper() wasmkeeper.Keeper {
+	return k.wasmKeeper
+}
+
 // GetCdc returns the x/cadance module's codec.
 func (k Keeper) GetCdc() codec.BinaryCodec {
 	return k.cdc
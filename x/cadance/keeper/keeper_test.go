@@ -107,7 +107,7 @@ func (s *IntegrationTestSuite) InstantiateContract(sender string, admin string)
 
 // Helper method for quickly registering a cadance contract
 func (s *IntegrationTestSuite) RegisterCadanceContract(senderAddress string, contractAddress string) {
-	err := s.App.AppKeepers.CadanceKeeper.RegisterContract(s.Ctx, senderAddress, contractAddress)
+	_, err := s.App.AppKeepers.CadanceKeeper.RegisterContract(s.Ctx, senderAddress, types.CadanceContract{ContractAddress: contractAddress})
 	s.Require().NoError(err)
 }
 
@@ -128,3 +128,29 @@ func (s *IntegrationTestSuite) UnjailCadanceContract(senderAddress string, contr
 	err := s.App.AppKeepers.CadanceKeeper.SetJailStatusBySender(s.Ctx, senderAddress, contractAddress, false)
 	s.Require().NoError(err)
 }
+
+// Helper method for submitting a gov v1 proposal and executing its messages as the gov module does
+// once the proposal passes
+func (s *IntegrationTestSuite) ExecuteGovProposal(msgs ...sdk.Msg) error {
+	_, _, proposer := testdata.KeyTestPubAddr()
+	proposal, err := s.App.AppKeepers.GovKeeper.SubmitProposal(s.Ctx, msgs, "", "cadance", "cadance proposal", proposer, false)
+	if err != nil {
+		return err
+	}
+
+	proposalMsgs, err := proposal.GetMsgs()
+	if err != nil {
+		return err
+	}
+
+	// Messages are executed in a cached context which is only written if all of them succeed
+	cacheCtx, writeCache := s.Ctx.CacheContext()
+	for _, msg := range proposalMsgs {
+		if _, err := s.App.MsgServiceRouter().Handler(msg)(cacheCtx, msg); err != nil {
+			return err
+		}
+	}
+	writeCache()
+
+	return nil
+}
@@ -0,0 +1,107 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bitsongofficial/go-bitsong/x/cadance/types"
+)
+
+// Get the code id and the manager of a contract from x/wasm. The manager is the contract admin, if
+// exists, or else the contract creator.
+func (k Keeper) getContractLifecycle(ctx sdk.Context, contractAddress string) (uint64, string, error) {
+	contractAddr, err := sdk.AccAddressFromBech32(contractAddress)
+	if err != nil {
+		return 0, "", err
+	}
+
+	contractInfo := k.wasmKeeper.GetContractInfo(ctx, contractAddr)
+	if contractInfo == nil {
+		return 0, "", types.ErrInvalidCWContract
+	}
+
+	if contractInfo.Admin != "" {
+		return contractInfo.CodeID, contractInfo.Admin, nil
+	}
+
+	return contractInfo.CodeID, contractInfo.Creator, nil
+}
+
+// Check whether the code or the manager of a cadance contract changed, through a migration, an
+// admin change or a cleared admin, since it was registered or last confirmed. When the
+// pause_on_lifecycle_change param is set, a changed contract is paused until its new manager
+// confirms it, otherwise its new code id and manager are recorded. Returns true if the contract is
+// paused.
+func (k Keeper) CheckContractLifecycle(ctx sdk.Context, contract *types.CadanceContract) (bool, error) {
+	if contract.PendingConfirmation {
+		return true, nil
+	}
+
+	codeID, manager, err := k.getContractLifecycle(ctx, contract.ContractAddress)
+	if err != nil {
+		return false, err
+	}
+
+	// Record the code id and the manager of the contracts registered before they were tracked
+	if contract.CodeId == 0 && contract.Manager == "" {
+		contract.CodeId = codeID
+		contract.Manager = manager
+		return false, k.SetCadanceContract(ctx, *contract)
+	}
+
+	if contract.CodeId == codeID && contract.Manager == manager {
+		return false, nil
+	}
+
+	paused := k.GetParams(ctx).PauseOnLifecycleChange
+	if paused {
+		contract.PendingConfirmation = true
+	} else {
+		contract.CodeId = codeID
+		contract.Manager = manager
+	}
+
+	if err := k.SetCadanceContract(ctx, *contract); err != nil {
+		return false, err
+	}
+
+	return paused, ctx.EventManager().EmitTypedEvent(&types.EventContractLifecycleChanged{
+		ContractAddress: contract.ContractAddress,
+		CodeId:          codeID,
+		Manager:         manager,
+		Paused:          paused,
+	})
+}
+
+// Confirm a cadance contract by its current manager, recording its current code id and manager and
+// resuming its executions if it was paused.
+func (k Keeper) ConfirmContract(ctx sdk.Context, senderAddress string, contractAddress string) error {
+	// Ensure the sender is the contract admin or creator
+	if ok, err := k.IsContractManager(ctx, senderAddress, contractAddress); !ok {
+		return err
+	}
+
+	// Get the contract
+	contract, err := k.GetCadanceContract(ctx, contractAddress)
+	if err != nil {
+		return err
+	}
+
+	codeID, manager, err := k.getContractLifecycle(ctx, contractAddress)
+	if err != nil {
+		return err
+	}
+
+	contract.CodeId = codeID
+	contract.Manager = manager
+	contract.PendingConfirmation = false
+
+	if err := k.SetCadanceContract(ctx, *contract); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventContractConfirmed{
+		ContractAddress: contractAddress,
+		CodeId:          codeID,
+		Manager:         manager,
+	})
+}
@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bitsongofficial/go-bitsong/x/cadance/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the x/cadance store from version 1 to 2. The params added in version 2
+// decode as zero from the version 1 params, which rejects every contract, so they are set to their
+// defaults in the bond denom of the chain, keeping the contract gas limit. The contracts registered
+// before the registration ids are assigned one.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	legacy := m.keeper.GetParams(ctx)
+
+	bondDenom, err := m.keeper.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return err
+	}
+
+	params := types.DefaultParams()
+	params.RegistrationDeposit.Denom = bondDenom
+	params.ScheduleFee.Denom = bondDenom
+	params.GasPrice.Denom = bondDenom
+	if legacy.ContractGasLimit > params.ContractGasLimit {
+		params.ContractGasLimit = legacy.ContractGasLimit
+	}
+	params.MaxContractGasLimit = max(params.MaxContractGasLimit, params.ContractGasLimit)
+	if params.BlockGasLimit != 0 {
+		params.BlockGasLimit = max(params.BlockGasLimit, params.MaxContractGasLimit)
+	}
+	if err := m.keeper.SetParams(ctx, params); err != nil {
+		return err
+	}
+
+	contracts, err := m.keeper.GetAllContracts(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, contract := range contracts {
+		if contract.RegistrationId != 0 {
+			continue
+		}
+
+		contract.RegistrationId = m.keeper.GetNextRegistrationID(ctx)
+		m.keeper.SetNextRegistrationID(ctx, contract.RegistrationId+1)
+		if err := m.keeper.SetCadanceContract(ctx, contract); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
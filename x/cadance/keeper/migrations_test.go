@@ -0,0 +1,55 @@
+package keeper_test
+
+import (
+	appparams "github.com/bitsongofficial/go-bitsong/app/params"
+	"github.com/bitsongofficial/go-bitsong/x/cadance/keeper"
+	"github.com/bitsongofficial/go-bitsong/x/cadance/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+)
+
+// Test the migration of the params and the contracts stored by version 1 of the module.
+func (s *IntegrationTestSuite) TestMigrate1to2() {
+	k := s.App.AppKeepers.CadanceKeeper
+
+	// The params are migrated in the bond denom of the chain
+	stakingParams, err := s.App.AppKeepers.StakingKeeper.GetParams(s.Ctx)
+	s.Require().NoError(err)
+	stakingParams.BondDenom = appparams.DefaultBondDenom
+	s.Require().NoError(s.App.AppKeepers.StakingKeeper.SetParams(s.Ctx, stakingParams))
+
+	// The version 1 params only have a contract gas limit, the other params decode as zero
+	legacyParams := types.Params{ContractGasLimit: 2_000_000}
+	s.Ctx.KVStore(k.GetStore()).Set(types.ParamsKey, s.App.AppCodec().MustMarshal(&legacyParams))
+	s.Require().Error(k.GetParams(s.Ctx).Validate())
+
+	// The version 1 contracts have no registration id
+	_, _, addr1 := testdata.KeyTestPubAddr()
+	_, _, addr2 := testdata.KeyTestPubAddr()
+	s.Require().NoError(k.SetCadanceContract(s.Ctx, types.CadanceContract{ContractAddress: addr1.String()}))
+	s.Require().NoError(k.SetCadanceContract(s.Ctx, types.CadanceContract{ContractAddress: addr2.String(), IsJailed: true}))
+
+	s.Require().NoError(keeper.NewMigrator(k).Migrate1to2(s.Ctx))
+
+	params := k.GetParams(s.Ctx)
+	s.Require().NoError(params.Validate())
+	s.Require().Equal(uint64(2_000_000), params.ContractGasLimit)
+	s.Require().Equal(uint64(2_000_000), params.MaxContractGasLimit)
+	s.Require().Equal(types.DefaultParams().FailureThreshold, params.FailureThreshold)
+	s.Require().Equal(types.DefaultParams().MaxPriority, params.MaxPriority)
+	s.Require().Equal(appparams.DefaultBondDenom, params.ScheduleFee.Denom)
+	s.Require().Equal(appparams.DefaultBondDenom, params.RegistrationDeposit.Denom)
+	s.Require().Equal(appparams.DefaultBondDenom, params.GasPrice.Denom)
+
+	contracts, err := k.GetAllContracts(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Len(contracts, 2)
+
+	ids := map[uint64]bool{}
+	for _, contract := range contracts {
+		s.Require().NotZero(contract.RegistrationId)
+		ids[contract.RegistrationId] = true
+	}
+	s.Require().Len(ids, 2)
+	s.Require().Equal(uint64(3), k.GetNextRegistrationID(s.Ctx))
+}
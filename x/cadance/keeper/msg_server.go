@@ -25,7 +25,7 @@ func NewMsgServerImpl(k Keeper) types.MsgServer {
 	}
 }
 
-// RegisterCadanceContract handles incoming transactions to register cadance contract s.
+// RegisterCadanceContract handles incoming transactions to register cadance contracts.
 func (k msgServer) RegisterCadanceContract(goCtx context.Context, req *types.MsgRegisterCadanceContract) (*types.MsgRegisterCadanceContractResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -34,10 +34,25 @@ func (k msgServer) RegisterCadanceContract(goCtx context.Context, req *types.Msg
 		return nil, err
 	}
 
-	return &types.MsgRegisterCadanceContractResponse{}, k.RegisterContract(ctx, req.SenderAddress, req.ContractAddress)
+	registrationID, err := k.RegisterContract(ctx, req.SenderAddress, types.CadanceContract{
+		ContractAddress:    req.ContractAddress,
+		Phase:              req.Phase,
+		ExecutionInterval:  req.ExecutionInterval,
+		StartHeight:        req.StartHeight,
+		GasLimit:           req.GasLimit,
+		SudoPayload:        req.SudoPayload,
+		SudoMessageVersion: req.SudoMessageVersion,
+		FeePayer:           req.FeePayer,
+		Priority:           req.Priority,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterCadanceContractResponse{RegistrationId: registrationID}, nil
 }
 
-// UnregisterCadanceContract handles incoming transactions to unregister cadance contract s.
+// UnregisterCadanceContract handles incoming transactions to unregister cadance contracts.
 func (k msgServer) UnregisterCadanceContract(goCtx context.Context, req *types.MsgUnregisterCadanceContract) (*types.MsgUnregisterCadanceContractResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -49,7 +64,28 @@ func (k msgServer) UnregisterCadanceContract(goCtx context.Context, req *types.M
 	return &types.MsgUnregisterCadanceContractResponse{}, k.UnregisterContract(ctx, req.SenderAddress, req.ContractAddress)
 }
 
-// UnjailCadanceContract handles incoming transactions to unjail cadance contract s.
+// UpdateCadanceContract handles incoming transactions to update the execution interval of cadance contracts.
+func (k msgServer) UpdateCadanceContract(goCtx context.Context, req *types.MsgUpdateCadanceContract) (*types.MsgUpdateCadanceContractResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate request
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateCadanceContractResponse{}, k.UpdateContract(ctx, req.SenderAddress, types.CadanceContract{
+		ContractAddress:    req.ContractAddress,
+		ExecutionInterval:  req.ExecutionInterval,
+		StartHeight:        req.StartHeight,
+		GasLimit:           req.GasLimit,
+		SudoPayload:        req.SudoPayload,
+		SudoMessageVersion: req.SudoMessageVersion,
+		FeePayer:           req.FeePayer,
+		Priority:           req.Priority,
+	})
+}
+
+// UnjailCadanceContract handles incoming transactions to unjail cadance contracts.
 func (k msgServer) UnjailCadanceContract(goCtx context.Context, req *types.MsgUnjailCadanceContract) (*types.MsgUnjailCadanceContractResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -61,6 +97,54 @@ func (k msgServer) UnjailCadanceContract(goCtx context.Context, req *types.MsgUn
 	return &types.MsgUnjailCadanceContractResponse{}, k.SetJailStatusBySender(ctx, req.SenderAddress, req.ContractAddress, false)
 }
 
+// ConfirmCadanceContract handles incoming transactions to confirm cadance contracts after their code or manager changed.
+func (k msgServer) ConfirmCadanceContract(goCtx context.Context, req *types.MsgConfirmCadanceContract) (*types.MsgConfirmCadanceContractResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate request
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgConfirmCadanceContractResponse{}, k.ConfirmContract(ctx, req.SenderAddress, req.ContractAddress)
+}
+
+func (k msgServer) CreateSchedule(goCtx context.Context, req *types.MsgCreateSchedule) (*types.MsgCreateScheduleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate request
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	id, err := k.Keeper.CreateSchedule(ctx, types.Schedule{
+		Creator:         req.Creator,
+		ContractAddress: req.ContractAddress,
+		Msg:             req.Msg,
+		CallType:        req.CallType,
+		GasLimit:        req.GasLimit,
+		RunAtHeight:     req.RunAtHeight,
+		RunAtTime:       req.RunAtTime,
+		Cron:            req.Cron,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateScheduleResponse{Id: id}, nil
+}
+
+func (k msgServer) CancelSchedule(goCtx context.Context, req *types.MsgCancelSchedule) (*types.MsgCancelScheduleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate request
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelScheduleResponse{}, k.Keeper.CancelSchedule(ctx, req.Creator, req.Id)
+}
+
 func (k msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
 	if k.authority != req.Authority {
 		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, req.Authority)
@@ -73,3 +157,55 @@ func (k msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParam
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// ForceRegisterCadanceContract registers a cadance contract on behalf of its admin or creator.
+func (k msgServer) ForceRegisterCadanceContract(goCtx context.Context, req *types.MsgForceRegisterCadanceContract) (*types.MsgForceRegisterCadanceContractResponse, error) {
+	if k.authority != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	registrationID, err := k.ForceRegisterContract(ctx, types.CadanceContract{
+		ContractAddress:    req.ContractAddress,
+		Phase:              req.Phase,
+		ExecutionInterval:  req.ExecutionInterval,
+		StartHeight:        req.StartHeight,
+		GasLimit:           req.GasLimit,
+		SudoPayload:        req.SudoPayload,
+		SudoMessageVersion: req.SudoMessageVersion,
+		Priority:           req.Priority,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgForceRegisterCadanceContractResponse{RegistrationId: registrationID}, nil
+}
+
+// ForceJailCadanceContract jails a cadance contract until it is unregistered.
+func (k msgServer) ForceJailCadanceContract(goCtx context.Context, req *types.MsgForceJailCadanceContract) (*types.MsgForceJailCadanceContractResponse, error) {
+	if k.authority != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.ForceJailContract(ctx, req.ContractAddress, req.Reason); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgForceJailCadanceContractResponse{}, nil
+}
+
+// ForceUnregisterCadanceContract unregisters a cadance contract, refunding its deposit.
+func (k msgServer) ForceUnregisterCadanceContract(goCtx context.Context, req *types.MsgForceUnregisterCadanceContract) (*types.MsgForceUnregisterCadanceContractResponse, error) {
+	if k.authority != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.ForceUnregisterContract(ctx, req.ContractAddress); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgForceUnregisterCadanceContractResponse{}, nil
+}
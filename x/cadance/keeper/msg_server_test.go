@@ -2,15 +2,18 @@ package keeper_test
 
 import (
 	_ "embed"
+	"time"
 
 	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/bitsongofficial/go-bitsong/x/cadance/types"
 )
 
-// Test register cadance contract .
+// Test register cadance contract.
 func (s *IntegrationTestSuite) TestRegisterCadanceContract() {
 	_, _, addr := testdata.KeyTestPubAddr()
 	_, _, addr2 := testdata.KeyTestPubAddr()
@@ -22,11 +25,16 @@ func (s *IntegrationTestSuite) TestRegisterCadanceContract() {
 	contractAddressWithAdmin := s.InstantiateContract(addr.String(), addr2.String())
 
 	for _, tc := range []struct {
-		desc     string
-		sender   string
-		contract string
-		isJailed bool
-		success  bool
+		desc        string
+		sender      string
+		contract    string
+		phase       types.ExecutionPhase
+		gasLimit    uint64
+		sudoPayload string
+		sudoVersion types.SudoMessageVersion
+		feePayer    string
+		isJailed    bool
+		success     bool
 	}{
 		{
 			desc:     "Success - Register Contract",
@@ -34,6 +42,70 @@ func (s *IntegrationTestSuite) TestRegisterCadanceContract() {
 			contract: contractAddress,
 			success:  true,
 		},
+		{
+			desc:        "Success - Register Contract With Sudo Payload",
+			sender:      addr.String(),
+			contract:    contractAddress,
+			sudoPayload: `{"custom":{"id":1}}`,
+			sudoVersion: types.SudoMessageVersionV2,
+			success:     true,
+		},
+		{
+			desc:        "Fail - Invalid Sudo Payload",
+			sender:      addr.String(),
+			contract:    contractAddress,
+			sudoPayload: `not json`,
+			success:     false,
+		},
+		{
+			desc:        "Fail - Invalid Sudo Message Version",
+			sender:      addr.String(),
+			contract:    contractAddress,
+			sudoVersion: types.SudoMessageVersion(2),
+			success:     false,
+		},
+		{
+			desc:     "Success - Register Contract With Sender As Fee Payer",
+			sender:   addr.String(),
+			contract: contractAddress,
+			feePayer: addr.String(),
+			success:  true,
+		},
+		{
+			desc:     "Fail - Fee Payer Is Not The Sender",
+			sender:   addr.String(),
+			contract: contractAddress,
+			feePayer: addr2.String(),
+			success:  false,
+		},
+		{
+			desc:     "Success - Register Contract At Begin And End Block",
+			sender:   addr.String(),
+			contract: contractAddress,
+			phase:    types.ExecutionPhaseBeginAndEndBlock,
+			success:  true,
+		},
+		{
+			desc:     "Success - Register Contract With Gas Limit",
+			sender:   addr.String(),
+			contract: contractAddress,
+			gasLimit: types.DefaultParams().MaxContractGasLimit,
+			success:  true,
+		},
+		{
+			desc:     "Fail - Gas Limit Above Maximum",
+			sender:   addr.String(),
+			contract: contractAddress,
+			gasLimit: types.DefaultParams().MaxContractGasLimit + 1,
+			success:  false,
+		},
+		{
+			desc:     "Fail - Invalid Execution Phase",
+			sender:   addr.String(),
+			contract: contractAddress,
+			phase:    types.ExecutionPhase(3),
+			success:  false,
+		},
 		{
 			desc:     "Success - Register Contract With Admin",
 			sender:   addr2.String(),
@@ -88,15 +160,29 @@ func (s *IntegrationTestSuite) TestRegisterCadanceContract() {
 
 			// Try to register contract
 			res, err := s.cadanceMsgServer.RegisterCadanceContract(s.Ctx, &types.MsgRegisterCadanceContract{
-				SenderAddress:   tc.sender,
-				ContractAddress: tc.contract,
+				SenderAddress:      tc.sender,
+				ContractAddress:    tc.contract,
+				Phase:              tc.phase,
+				GasLimit:           tc.gasLimit,
+				SudoPayload:        tc.sudoPayload,
+				SudoMessageVersion: tc.sudoVersion,
+				FeePayer:           tc.feePayer,
 			})
 
 			if !tc.success {
 				s.Require().Error(err)
 			} else {
 				s.Require().NoError(err)
-				s.Require().Equal(res, &types.MsgRegisterCadanceContractResponse{})
+				s.Require().NotZero(res.RegistrationId)
+
+				contract, err := s.App.AppKeepers.CadanceKeeper.GetCadanceContract(s.Ctx, tc.contract)
+				s.Require().NoError(err)
+				s.Require().Equal(res.RegistrationId, contract.RegistrationId)
+				s.Require().Equal(tc.phase, contract.Phase)
+				s.Require().Equal(tc.gasLimit, contract.GasLimit)
+				s.Require().Equal(tc.sudoPayload, contract.SudoPayload)
+				s.Require().Equal(tc.sudoVersion, contract.SudoMessageVersion)
+				s.Require().Equal(tc.feePayer, contract.FeePayer)
 			}
 
 			// Ensure contract is unregistered
@@ -106,7 +192,7 @@ func (s *IntegrationTestSuite) TestRegisterCadanceContract() {
 	}
 }
 
-// Test standard unregistration of cadance contract s.
+// Test standard unregistration of cadance contracts.
 func (s *IntegrationTestSuite) TestUnregisterCadanceContract() {
 	_, _, addr := testdata.KeyTestPubAddr()
 	_, _, addr2 := testdata.KeyTestPubAddr()
@@ -189,7 +275,7 @@ func (s *IntegrationTestSuite) TestUnregisterCadanceContract() {
 	}
 }
 
-// Test duplicate register/unregister cadance contract s.
+// Test duplicate register/unregister cadance contracts.
 func (s *IntegrationTestSuite) TestDuplicateRegistrationChecks() {
 	_, _, addr := testdata.KeyTestPubAddr()
 	_ = s.FundAccount(s.Ctx, addr, sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(1_000_000))))
@@ -224,7 +310,252 @@ func (s *IntegrationTestSuite) TestDuplicateRegistrationChecks() {
 	s.Require().Error(err)
 }
 
-// Test unjailing cadance contract s.
+// Test updating the execution interval of cadance contracts.
+func (s *IntegrationTestSuite) TestUpdateCadanceContract() {
+	_, _, addr := testdata.KeyTestPubAddr()
+	_, _, addr2 := testdata.KeyTestPubAddr()
+	_ = s.FundAccount(s.Ctx, addr, sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(1_000_000))))
+
+	s.StoreCode()
+	contractAddress := s.InstantiateContract(addr.String(), "")
+	unregisteredContractAddress := s.InstantiateContract(addr.String(), "")
+	s.RegisterCadanceContract(addr.String(), contractAddress)
+
+	for _, tc := range []struct {
+		desc        string
+		sender      string
+		contract    string
+		interval    uint64
+		startHeight int64
+		sudoPayload string
+		success     bool
+	}{
+		{
+			desc:        "Success - Update Contract",
+			sender:      addr.String(),
+			contract:    contractAddress,
+			interval:    100,
+			startHeight: 1_000,
+			success:     true,
+		},
+		{
+			desc:        "Success - Update Sudo Payload",
+			sender:      addr.String(),
+			contract:    contractAddress,
+			sudoPayload: `{"custom":{}}`,
+			success:     true,
+		},
+		{
+			desc:        "Fail - Invalid Sudo Payload",
+			sender:      addr.String(),
+			contract:    contractAddress,
+			sudoPayload: `"custom"`,
+			success:     false,
+		},
+		{
+			desc:     "Success - Reset Contract Interval",
+			sender:   addr.String(),
+			contract: contractAddress,
+			success:  true,
+		},
+		{
+			desc:     "Fail - Invalid Sender",
+			sender:   addr2.String(),
+			contract: contractAddress,
+			interval: 10,
+			success:  false,
+		},
+		{
+			desc:        "Fail - Negative Start Height",
+			sender:      addr.String(),
+			contract:    contractAddress,
+			startHeight: -1,
+			success:     false,
+		},
+		{
+			desc:     "Fail - Contract Not Registered",
+			sender:   addr.String(),
+			contract: unregisteredContractAddress,
+			interval: 10,
+			success:  false,
+		},
+	} {
+		tc := tc
+		s.Run(tc.desc, func() {
+			before, err := s.App.AppKeepers.CadanceKeeper.GetCadanceContract(s.Ctx, contractAddress)
+			s.Require().NoError(err)
+
+			res, err := s.cadanceMsgServer.UpdateCadanceContract(s.Ctx, &types.MsgUpdateCadanceContract{
+				SenderAddress:     tc.sender,
+				ContractAddress:   tc.contract,
+				ExecutionInterval: tc.interval,
+				StartHeight:       tc.startHeight,
+				SudoPayload:       tc.sudoPayload,
+			})
+
+			contract, getErr := s.App.AppKeepers.CadanceKeeper.GetCadanceContract(s.Ctx, contractAddress)
+			s.Require().NoError(getErr)
+
+			if !tc.success {
+				s.Require().Error(err)
+				s.Require().Equal(before, contract)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(res, &types.MsgUpdateCadanceContractResponse{})
+				s.Require().Equal(tc.interval, contract.ExecutionInterval)
+				s.Require().Equal(tc.startHeight, contract.StartHeight)
+				s.Require().Equal(tc.sudoPayload, contract.SudoPayload)
+			}
+		})
+	}
+}
+
+// Test the priority of the contracts, bounded by the max priority param unless granted by governance.
+func (s *IntegrationTestSuite) TestContractPriority() {
+	_, _, addr := testdata.KeyTestPubAddr()
+	_ = s.FundAccount(s.Ctx, addr, sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(1_000_000))))
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	cadanceKeeper := s.App.AppKeepers.CadanceKeeper
+	maxPriority := cadanceKeeper.GetParams(s.Ctx).MaxPriority
+
+	s.StoreCode()
+	contractAddress := s.InstantiateContract(addr.String(), "")
+	govContractAddress := s.InstantiateContract(addr.String(), "")
+
+	// The manager cannot register a contract above the max priority
+	_, err := s.cadanceMsgServer.RegisterCadanceContract(s.Ctx, &types.MsgRegisterCadanceContract{
+		SenderAddress:   addr.String(),
+		ContractAddress: contractAddress,
+		Priority:        maxPriority + 1,
+	})
+	s.Require().ErrorIs(err, types.ErrInvalidPriority)
+
+	_, err = s.cadanceMsgServer.RegisterCadanceContract(s.Ctx, &types.MsgRegisterCadanceContract{
+		SenderAddress:   addr.String(),
+		ContractAddress: contractAddress,
+		Priority:        maxPriority,
+	})
+	s.Require().NoError(err)
+
+	contract, err := cadanceKeeper.GetCadanceContract(s.Ctx, contractAddress)
+	s.Require().NoError(err)
+	s.Require().Equal(maxPriority, contract.Priority)
+
+	// Governance can register a contract above the max priority
+	s.Require().NoError(s.ExecuteGovProposal(&types.MsgForceRegisterCadanceContract{
+		Authority:       authority,
+		ContractAddress: govContractAddress,
+		Priority:        maxPriority + 40,
+	}))
+
+	for _, tc := range []struct {
+		desc     string
+		priority uint32
+		expected uint32
+		success  bool
+	}{
+		{
+			desc:     "Success - Keep Priority Granted By Governance",
+			priority: maxPriority + 40,
+			expected: maxPriority + 40,
+			success:  true,
+		},
+		{
+			desc:     "Fail - Change Priority Above Max Priority",
+			priority: maxPriority + 10,
+			expected: maxPriority + 40,
+			success:  false,
+		},
+		{
+			desc:     "Success - Lower Priority",
+			priority: 1,
+			expected: 1,
+			success:  true,
+		},
+		{
+			desc:     "Fail - Restore Priority Granted By Governance",
+			priority: maxPriority + 40,
+			expected: 1,
+			success:  false,
+		},
+	} {
+		tc := tc
+		s.Run(tc.desc, func() {
+			_, err := s.cadanceMsgServer.UpdateCadanceContract(s.Ctx, &types.MsgUpdateCadanceContract{
+				SenderAddress:   addr.String(),
+				ContractAddress: govContractAddress,
+				Priority:        tc.priority,
+			})
+			if tc.success {
+				s.Require().NoError(err)
+			} else {
+				s.Require().ErrorIs(err, types.ErrInvalidPriority)
+			}
+
+			contract, err := cadanceKeeper.GetCadanceContract(s.Ctx, govContractAddress)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expected, contract.Priority)
+		})
+	}
+}
+
+// Test the registration deposit and the maximum number of contracts.
+func (s *IntegrationTestSuite) TestRegistrationDeposit() {
+	_, _, addr := testdata.KeyTestPubAddr()
+	_, _, poorAddr := testdata.KeyTestPubAddr()
+	_ = s.FundAccount(s.Ctx, addr, sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(1_000_000))))
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+
+	params := types.DefaultParams()
+	params.RegistrationDeposit = sdk.NewCoin("stake", math.NewInt(1_000))
+	params.MaxContracts = 2
+	err := s.App.AppKeepers.CadanceKeeper.SetParams(s.Ctx, params)
+	s.Require().NoError(err)
+
+	s.StoreCode()
+	contractAddress := s.InstantiateContract(addr.String(), "")
+	contractAddress2 := s.InstantiateContract(addr.String(), "")
+	contractAddress3 := s.InstantiateContract(addr.String(), "")
+	poorContractAddress := s.InstantiateContract(addr.String(), poorAddr.String())
+
+	register := func(sender string, contract string) error {
+		_, err := s.cadanceMsgServer.RegisterCadanceContract(s.Ctx, &types.MsgRegisterCadanceContract{
+			SenderAddress:   sender,
+			ContractAddress: contract,
+		})
+		return err
+	}
+
+	// The deposit is held by the module
+	balance := s.App.AppKeepers.BankKeeper.GetBalance(s.Ctx, addr, "stake").Amount
+	s.Require().NoError(register(addr.String(), contractAddress))
+	s.Require().Equal(balance.SubRaw(1_000), s.App.AppKeepers.BankKeeper.GetBalance(s.Ctx, addr, "stake").Amount)
+	s.Require().Equal(math.NewInt(1_000), s.App.AppKeepers.BankKeeper.GetBalance(s.Ctx, moduleAddr, "stake").Amount)
+
+	contract, err := s.App.AppKeepers.CadanceKeeper.GetCadanceContract(s.Ctx, contractAddress)
+	s.Require().NoError(err)
+	s.Require().Equal(params.RegistrationDeposit, contract.Deposit)
+	s.Require().Equal(addr.String(), contract.Depositor)
+
+	// The maximum number of contracts is enforced
+	s.Require().NoError(register(addr.String(), contractAddress2))
+	s.Require().ErrorIs(register(addr.String(), contractAddress3), types.ErrMaxContractsReached)
+
+	// The deposit is refunded on unregister
+	_, err = s.cadanceMsgServer.UnregisterCadanceContract(s.Ctx, &types.MsgUnregisterCadanceContract{
+		SenderAddress:   addr.String(),
+		ContractAddress: contractAddress,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(balance.SubRaw(1_000), s.App.AppKeepers.BankKeeper.GetBalance(s.Ctx, addr, "stake").Amount)
+	s.Require().Equal(math.NewInt(1_000), s.App.AppKeepers.BankKeeper.GetBalance(s.Ctx, moduleAddr, "stake").Amount)
+
+	// The deposit must be paid
+	s.Require().Error(register(poorAddr.String(), poorContractAddress))
+	s.Require().False(s.App.AppKeepers.CadanceKeeper.IsCadanceContract(s.Ctx, poorContractAddress))
+}
+
+// Test unjailing cadance contracts.
 func (s *IntegrationTestSuite) TestUnjailCadanceContract() {
 	_, _, addr := testdata.KeyTestPubAddr()
 	_, _, addr2 := testdata.KeyTestPubAddr()
@@ -322,3 +653,245 @@ func (s *IntegrationTestSuite) TestUnjailCadanceContract() {
 		})
 	}
 }
+
+// Test the creation of schedules.
+func (s *IntegrationTestSuite) TestCreateSchedule() {
+	_, _, addr := testdata.KeyTestPubAddr()
+	_, _, addr2 := testdata.KeyTestPubAddr()
+	_ = s.FundAccount(s.Ctx, addr, sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(1_000_000))))
+	_ = s.FundAccount(s.Ctx, addr2, sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(1_000_000))))
+
+	s.StoreCode()
+	contractAddress := s.InstantiateContract(addr.String(), "")
+	future := s.Ctx.BlockTime().Add(time.Hour)
+	past := s.Ctx.BlockTime().Add(-time.Hour)
+
+	for _, tc := range []struct {
+		desc        string
+		creator     string
+		contract    string
+		callType    types.ScheduleCallType
+		runAtHeight int64
+		runAtTime   *time.Time
+		cron        string
+		success     bool
+	}{
+		{
+			desc:        "Success - Sudo At Height",
+			creator:     addr.String(),
+			contract:    contractAddress,
+			runAtHeight: s.Ctx.BlockHeight() + 10,
+			success:     true,
+		},
+		{
+			desc:      "Success - Execute At Time By Any Sender",
+			creator:   addr2.String(),
+			contract:  contractAddress,
+			callType:  types.ScheduleCallTypeExecute,
+			runAtTime: &future,
+			success:   true,
+		},
+		{
+			desc:     "Success - Cron",
+			creator:  addr.String(),
+			contract: contractAddress,
+			cron:     "0 0 * * *",
+			success:  true,
+		},
+		{
+			desc:        "Fail - Sudo By Another Sender",
+			creator:     addr2.String(),
+			contract:    contractAddress,
+			runAtHeight: s.Ctx.BlockHeight() + 10,
+			success:     false,
+		},
+		{
+			desc:        "Fail - Past Height",
+			creator:     addr.String(),
+			contract:    contractAddress,
+			runAtHeight: s.Ctx.BlockHeight(),
+			success:     false,
+		},
+		{
+			desc:      "Fail - Past Time",
+			creator:   addr.String(),
+			contract:  contractAddress,
+			runAtTime: &past,
+			success:   false,
+		},
+		{
+			desc:     "Fail - Cron Never Running",
+			creator:  addr.String(),
+			contract: contractAddress,
+			cron:     "0 0 31 2 *",
+			success:  false,
+		},
+		{
+			desc:        "Fail - Not A Contract",
+			creator:     addr.String(),
+			contract:    addr2.String(),
+			callType:    types.ScheduleCallTypeExecute,
+			runAtHeight: s.Ctx.BlockHeight() + 10,
+			success:     false,
+		},
+	} {
+		tc := tc
+		s.Run(tc.desc, func() {
+			// Set params
+			params := types.DefaultParams()
+			params.ScheduleFee = sdk.NewCoin("stake", math.NewInt(1_000))
+			s.Require().NoError(s.App.AppKeepers.CadanceKeeper.SetParams(s.Ctx, params))
+
+			balance := s.App.AppKeepers.BankKeeper.GetBalance(s.Ctx, sdk.MustAccAddressFromBech32(tc.creator), "stake")
+
+			res, err := s.cadanceMsgServer.CreateSchedule(s.Ctx, &types.MsgCreateSchedule{
+				Creator:         tc.creator,
+				ContractAddress: tc.contract,
+				Msg:             `{"clock_end_block":{}}`,
+				CallType:        tc.callType,
+				RunAtHeight:     tc.runAtHeight,
+				RunAtTime:       tc.runAtTime,
+				Cron:            tc.cron,
+			})
+
+			newBalance := s.App.AppKeepers.BankKeeper.GetBalance(s.Ctx, sdk.MustAccAddressFromBech32(tc.creator), "stake")
+			if !tc.success {
+				s.Require().Error(err)
+				s.Require().Equal(balance, newBalance)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(balance.SubAmount(math.NewInt(1_000)), newBalance)
+
+				schedule, err := s.App.AppKeepers.CadanceKeeper.GetSchedule(s.Ctx, res.Id)
+				s.Require().NoError(err)
+				s.Require().Equal(tc.creator, schedule.Creator)
+				s.Require().Equal(tc.callType, schedule.CallType)
+				s.Require().Equal(tc.cron != "", schedule.NextRunTime != nil)
+			}
+		})
+	}
+}
+
+// Test the cancellation of schedules.
+func (s *IntegrationTestSuite) TestCancelSchedule() {
+	_, _, addr := testdata.KeyTestPubAddr()
+	_, _, addr2 := testdata.KeyTestPubAddr()
+	_ = s.FundAccount(s.Ctx, addr, sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(10_000_000))))
+
+	s.StoreCode()
+	contractAddress := s.InstantiateContract(addr.String(), "")
+
+	res, err := s.cadanceMsgServer.CreateSchedule(s.Ctx, &types.MsgCreateSchedule{
+		Creator:         addr.String(),
+		ContractAddress: contractAddress,
+		Msg:             `{"clock_end_block":{}}`,
+		RunAtHeight:     s.Ctx.BlockHeight() + 10,
+	})
+	s.Require().NoError(err)
+
+	// Only the creator can cancel the schedule
+	_, err = s.cadanceMsgServer.CancelSchedule(s.Ctx, &types.MsgCancelSchedule{Creator: addr2.String(), Id: res.Id})
+	s.Require().ErrorIs(err, types.ErrNotScheduleCreator)
+
+	_, err = s.cadanceMsgServer.CancelSchedule(s.Ctx, &types.MsgCancelSchedule{Creator: addr.String(), Id: res.Id})
+	s.Require().NoError(err)
+
+	_, err = s.App.AppKeepers.CadanceKeeper.GetSchedule(s.Ctx, res.Id)
+	s.Require().ErrorIs(err, types.ErrScheduleNotFound)
+
+	// The cancelled schedule is not due anymore
+	schedules, err := s.App.AppKeepers.CadanceKeeper.GetDueSchedules(s.Ctx.WithBlockHeight(s.Ctx.BlockHeight()+10), 0)
+	s.Require().NoError(err)
+	s.Require().Empty(schedules)
+
+	// Unknown schedules cannot be cancelled
+	_, err = s.cadanceMsgServer.CancelSchedule(s.Ctx, &types.MsgCancelSchedule{Creator: addr.String(), Id: res.Id})
+	s.Require().ErrorIs(err, types.ErrScheduleNotFound)
+}
+
+// Test that governance can register adminless contracts, jail and unregister contracts.
+func (s *IntegrationTestSuite) TestGovernanceControl() {
+	_, _, creator := testdata.KeyTestPubAddr()
+	_ = s.FundAccount(s.Ctx, creator, sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(1_000_000))))
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	cadanceKeeper := s.App.AppKeepers.CadanceKeeper
+
+	// Store code and instantiate a contract without admin
+	s.StoreCode()
+	contractAddress := s.InstantiateContract(creator.String(), "")
+
+	// Only governance can force register
+	_, err := s.cadanceMsgServer.ForceRegisterCadanceContract(s.Ctx, &types.MsgForceRegisterCadanceContract{
+		Authority:       creator.String(),
+		ContractAddress: contractAddress,
+	})
+	s.Require().Error(err)
+
+	// Register the contract on behalf of its creator, without deposit
+	s.Require().NoError(s.ExecuteGovProposal(&types.MsgForceRegisterCadanceContract{
+		Authority:       authority,
+		ContractAddress: contractAddress,
+		Phase:           types.ExecutionPhaseBeginAndEndBlock,
+		GasLimit:        200_000,
+	}))
+
+	contract, err := cadanceKeeper.GetCadanceContract(s.Ctx, contractAddress)
+	s.Require().NoError(err)
+	s.Require().NotZero(contract.RegistrationId)
+	s.Require().Equal(types.ExecutionPhaseBeginAndEndBlock, contract.Phase)
+	s.Require().Equal(uint64(200_000), contract.GasLimit)
+	s.Require().True(contract.Deposit.IsZero())
+	s.Require().Empty(contract.Depositor)
+
+	// A registered contract cannot be registered again
+	s.Require().ErrorIs(s.ExecuteGovProposal(&types.MsgForceRegisterCadanceContract{
+		Authority:       authority,
+		ContractAddress: contractAddress,
+	}), types.ErrContractAlreadyRegistered)
+
+	// Jail the contract
+	s.Require().NoError(s.ExecuteGovProposal(&types.MsgForceJailCadanceContract{
+		Authority:       authority,
+		ContractAddress: contractAddress,
+		Reason:          "spam",
+	}))
+
+	contract, err = cadanceKeeper.GetCadanceContract(s.Ctx, contractAddress)
+	s.Require().NoError(err)
+	s.Require().True(contract.IsJailed)
+	s.Require().True(contract.JailedByAuthority)
+	s.Require().Equal("spam", contract.JailReason)
+	s.Require().Zero(contract.JailCount)
+
+	// The contract cannot be jailed again by governance, nor unjailed by its manager
+	s.Require().ErrorIs(s.ExecuteGovProposal(&types.MsgForceJailCadanceContract{
+		Authority:       authority,
+		ContractAddress: contractAddress,
+	}), types.ErrContractAlreadyJailed)
+	_, err = s.cadanceMsgServer.UnjailCadanceContract(s.Ctx, &types.MsgUnjailCadanceContract{
+		SenderAddress:   creator.String(),
+		ContractAddress: contractAddress,
+	})
+	s.Require().ErrorIs(err, types.ErrJailedByAuthority)
+
+	// Nor unregistered by its manager, which would release it on re-registration
+	_, err = s.cadanceMsgServer.UnregisterCadanceContract(s.Ctx, &types.MsgUnregisterCadanceContract{
+		SenderAddress:   creator.String(),
+		ContractAddress: contractAddress,
+	})
+	s.Require().ErrorIs(err, types.ErrJailedByAuthority)
+	s.Require().True(cadanceKeeper.IsCadanceContract(s.Ctx, contractAddress))
+
+	// Unregister the contract
+	s.Require().NoError(s.ExecuteGovProposal(&types.MsgForceUnregisterCadanceContract{
+		Authority:       authority,
+		ContractAddress: contractAddress,
+	}))
+	s.Require().False(cadanceKeeper.IsCadanceContract(s.Ctx, contractAddress))
+
+	// An unregistered contract cannot be unregistered again
+	s.Require().Error(s.ExecuteGovProposal(&types.MsgForceUnregisterCadanceContract{
+		Authority:       authority,
+		ContractAddress: contractAddress,
+	}))
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -52,6 +53,72 @@ func (q Querier) CadanceContract(stdCtx context.Context, req *types.QueryCadance
 	}, nil
 }
 
+// Schedules returns the scheduled contract calls
+func (q Querier) Schedules(stdCtx context.Context, req *types.QuerySchedulesRequest) (*types.QuerySchedulesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+
+	return q.keeper.GetPaginatedSchedules(ctx, req.Pagination)
+}
+
+// Schedule returns a scheduled contract call
+func (q Querier) Schedule(stdCtx context.Context, req *types.QueryScheduleRequest) (*types.QueryScheduleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+
+	schedule, err := q.keeper.GetSchedule(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryScheduleResponse{
+		Schedule: *schedule,
+	}, nil
+}
+
+// SimulateCadance simulates the execution of a contract without persisting anything
+func (q Querier) SimulateCadance(stdCtx context.Context, req *types.QuerySimulateCadanceRequest) (*types.QuerySimulateCadanceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+	p := q.keeper.GetParams(ctx)
+
+	// Ensure the contract address is valid
+	contractAddr, err := sdk.AccAddressFromBech32(req.ContractAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress
+	}
+
+	// Ensure the phase and the gas limit are valid
+	if err := req.Phase.Validate(); err != nil {
+		return nil, err
+	}
+	if err := types.ValidateGasLimit(req.GasLimit, p); err != nil {
+		return nil, err
+	}
+
+	// Use the registered contract, or the settings of the request for an unregistered one
+	contract, err := q.keeper.GetCadanceContract(ctx, req.ContractAddress)
+	if errors.Is(err, types.ErrContractNotRegistered) {
+		if !q.keeper.GetWasmKeeper().HasContractInfo(ctx, contractAddr) {
+			return nil, types.ErrInvalidCWContract
+		}
+		if err := types.ValidateSudoMessage(req.SudoMessageVersion, req.SudoPayload); err != nil {
+			return nil, err
+		}
+
+		contract = &types.CadanceContract{
+			ContractAddress:    req.ContractAddress,
+			SudoPayload:        req.SudoPayload,
+			SudoMessageVersion: req.SudoMessageVersion,
+		}
+	} else if err != nil {
+		return nil, err
+	}
+
+	if req.GasLimit != 0 {
+		contract.GasLimit = req.GasLimit
+	}
+
+	return q.keeper.SimulateExecution(ctx, *contract, req.Phase)
+}
+
 // Params returns the total set of cadance parameters.
 func (q Querier) Params(stdCtx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(stdCtx)
@@ -19,16 +19,12 @@ func (s *IntegrationTestSuite) TestQueryClockParams() {
 			params: types.DefaultParams(),
 		},
 		{
-			desc: "On 500_000",
-			params: types.Params{
-				ContractGasLimit: 500_000,
-			},
+			desc:   "On 500_000",
+			params: types.NewParams(500_000, sdk.NewCoin("stake", math.NewInt(1_000)), math.LegacyNewDecWithPrec(1, 1), types.PenaltyDestinationBurn, 10, 2_000_000, 0, 1, 0, 0, sdk.NewCoin("stake", math.NewInt(1_000)), 10, sdk.NewDecCoinFromDec("stake", math.LegacyNewDecWithPrec(25, 3)), false, 10),
 		},
 		{
-			desc: "On 1_000_000",
-			params: types.Params{
-				ContractGasLimit: 1_000_000,
-			},
+			desc:   "On 1_000_000",
+			params: types.NewParams(1_000_000, sdk.NewCoin("stake", math.NewInt(1_000)), math.LegacyNewDecWithPrec(1, 1), types.PenaltyDestinationBurn, 10, 2_000_000, 0, 1, 0, 0, sdk.NewCoin("stake", math.NewInt(1_000)), 10, sdk.NewDecCoinFromDec("stake", math.LegacyNewDecWithPrec(25, 3)), false, 10),
 		},
 	} {
 		tc := tc
@@ -230,3 +226,120 @@ func (s *IntegrationTestSuite) TestQueryCadanceContract() {
 		})
 	}
 }
+
+// Query the simulation of a contract execution
+func (s *IntegrationTestSuite) TestQuerySimulateCadance() {
+	_, _, addr := testdata.KeyTestPubAddr()
+	_ = s.FundAccount(s.Ctx, addr, sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(1_000_000))))
+	_, _, invalidAddr := testdata.KeyTestPubAddr()
+
+	s.StoreCode()
+
+	contract := s.InstantiateContract(addr.String(), addr.String())
+	registeredContract := s.InstantiateContract(addr.String(), addr.String())
+	_, err := s.App.AppKeepers.CadanceKeeper.RegisterContract(s.Ctx, addr.String(), types.CadanceContract{
+		ContractAddress: registeredContract,
+		SudoPayload:     `{"clock_end_block":{}}`,
+	})
+	s.Require().NoError(err)
+
+	p := s.App.AppKeepers.CadanceKeeper.GetParams(s.Ctx)
+
+	for _, tc := range []struct {
+		desc     string
+		req      *types.QuerySimulateCadanceRequest
+		result   types.SimulationResult
+		gasLimit uint64
+		success  bool
+	}{
+		{
+			desc: "Unregistered Contract",
+			req: &types.QuerySimulateCadanceRequest{
+				ContractAddress: contract,
+				SudoPayload:     `{"clock_end_block":{}}`,
+			},
+			result:   types.SimulationResultSuccess,
+			gasLimit: p.ContractGasLimit,
+			success:  true,
+		},
+		{
+			desc:     "Unregistered Contract - Unknown Sudo Message",
+			req:      &types.QuerySimulateCadanceRequest{ContractAddress: contract},
+			result:   types.SimulationResultError,
+			gasLimit: p.ContractGasLimit,
+			success:  true,
+		},
+		{
+			desc: "Unregistered Contract - Out Of Gas",
+			req: &types.QuerySimulateCadanceRequest{
+				ContractAddress: contract,
+				SudoPayload:     `{"clock_end_block":{}}`,
+				GasLimit:        1_000,
+			},
+			result:   types.SimulationResultOutOfGas,
+			gasLimit: 1_000,
+			success:  true,
+		},
+		{
+			desc: "Registered Contract - Registered Payload",
+			req: &types.QuerySimulateCadanceRequest{
+				ContractAddress: registeredContract,
+				SudoPayload:     `{"unknown":{}}`,
+			},
+			result:   types.SimulationResultSuccess,
+			gasLimit: p.ContractGasLimit,
+			success:  true,
+		},
+		{
+			desc: "Invalid Payload",
+			req: &types.QuerySimulateCadanceRequest{
+				ContractAddress: contract,
+				SudoPayload:     "not json",
+			},
+			success: false,
+		},
+		{
+			desc: "Gas Limit Above Maximum",
+			req: &types.QuerySimulateCadanceRequest{
+				ContractAddress: contract,
+				GasLimit:        p.MaxContractGasLimit + 1,
+			},
+			success: false,
+		},
+		{
+			desc:    "Invalid Contract",
+			req:     &types.QuerySimulateCadanceRequest{ContractAddress: invalidAddr.String()},
+			success: false,
+		},
+	} {
+		tc := tc
+		s.Run(tc.desc, func() {
+			resp, err := s.queryClient.SimulateCadance(s.Ctx, tc.req)
+
+			if tc.success {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.result, resp.Result, resp.Error)
+				s.Require().Equal(tc.gasLimit, resp.GasLimit)
+				s.Require().NotZero(resp.GasUsed)
+				s.Require().NotEmpty(resp.SudoMessage)
+				if tc.result == types.SimulationResultSuccess {
+					s.Require().Empty(resp.Error)
+					s.Require().NotEmpty(resp.Events)
+				} else {
+					s.Require().NotEmpty(resp.Error)
+				}
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+
+	// The simulations are not persisted
+	output, err := s.App.AppKeepers.WasmKeeper.QuerySmart(s.Ctx, sdk.MustAccAddressFromBech32(contract), []byte(`{"get_config":{}}`))
+	s.Require().NoError(err)
+	s.Require().JSONEq(`{"val":0}`, string(output))
+	contractInfo, err := s.App.AppKeepers.CadanceKeeper.GetCadanceContract(s.Ctx, registeredContract)
+	s.Require().NoError(err)
+	s.Require().Zero(contractInfo.LastGasUsed)
+	s.Require().Zero(contractInfo.ConsecutiveFailures)
+}
@@ -0,0 +1,326 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"time"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/bitsongofficial/go-bitsong/x/cadance/types"
+)
+
+// Store Keys for the scheduled contract calls, indexed by the height or the block time of their
+// next run
+var (
+	StoreKeySchedules           = []byte("schedules")
+	StoreKeyScheduleHeightIndex = []byte("schedule_height_index")
+	StoreKeyScheduleTimeIndex   = []byte("schedule_time_index")
+	StoreKeyNextScheduleID      = []byte("next_schedule_id")
+)
+
+// Get the store for the schedules.
+func (k Keeper) getScheduleStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), StoreKeySchedules)
+}
+
+// Get the id assigned to the next schedule.
+func (k Keeper) GetNextScheduleID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(StoreKeyNextScheduleID)
+	if bz == nil {
+		return 1
+	}
+
+	return binary.BigEndian.Uint64(bz)
+}
+
+// Set the id assigned to the next schedule.
+func (k Keeper) SetNextScheduleID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(StoreKeyNextScheduleID, sdk.Uint64ToBigEndian(id))
+}
+
+// Get a schedule by its id.
+func (k Keeper) GetSchedule(ctx sdk.Context, id uint64) (*types.Schedule, error) {
+	bz := k.getScheduleStore(ctx).Get(sdk.Uint64ToBigEndian(id))
+	if bz == nil {
+		return nil, types.ErrScheduleNotFound.Wrapf("%d", id)
+	}
+
+	var schedule types.Schedule
+	if err := k.cdc.Unmarshal(bz, &schedule); err != nil {
+		return nil, err
+	}
+
+	return &schedule, nil
+}
+
+// Set a schedule in the KV store and index it by its next run.
+func (k Keeper) SetSchedule(ctx sdk.Context, schedule types.Schedule) error {
+	bz, err := k.cdc.Marshal(&schedule)
+	if err != nil {
+		return err
+	}
+
+	k.getScheduleStore(ctx).Set(sdk.Uint64ToBigEndian(schedule.Id), bz)
+	k.setScheduleIndex(ctx, schedule)
+	return nil
+}
+
+// Remove a schedule and its index from the KV store.
+func (k Keeper) RemoveSchedule(ctx sdk.Context, schedule types.Schedule) {
+	k.deleteScheduleIndex(ctx, schedule)
+	k.getScheduleStore(ctx).Delete(sdk.Uint64ToBigEndian(schedule.Id))
+}
+
+// Get all the schedules.
+func (k Keeper) GetAllSchedules(ctx sdk.Context) ([]types.Schedule, error) {
+	iterator := storetypes.KVStorePrefixIterator(k.getScheduleStore(ctx), []byte(nil))
+	defer iterator.Close()
+
+	schedules := []types.Schedule{}
+	for ; iterator.Valid(); iterator.Next() {
+		var schedule types.Schedule
+		if err := k.cdc.Unmarshal(iterator.Value(), &schedule); err != nil {
+			return nil, err
+		}
+
+		schedules = append(schedules, schedule)
+	}
+
+	return schedules, nil
+}
+
+// Get the schedules with pagination.
+func (k Keeper) GetPaginatedSchedules(ctx sdk.Context, pag *query.PageRequest) (*types.QuerySchedulesResponse, error) {
+	results, pageRes, err := query.GenericFilteredPaginate(
+		k.cdc,
+		k.getScheduleStore(ctx),
+		pag,
+		func(_ []byte, value *types.Schedule) (*types.Schedule, error) {
+			return value, nil
+		},
+		func() *types.Schedule {
+			return &types.Schedule{}
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	schedules := make([]types.Schedule, 0, len(results))
+	for _, schedule := range results {
+		schedules = append(schedules, *schedule)
+	}
+
+	return &types.QuerySchedulesResponse{
+		Schedules:  schedules,
+		Pagination: pageRes,
+	}, nil
+}
+
+// Get the key of a schedule in the height index.
+func scheduleHeightIndexKey(height int64, id uint64) []byte {
+	return append(sdk.Uint64ToBigEndian(uint64(height)), sdk.Uint64ToBigEndian(id)...)
+}
+
+// Get the key of a schedule in the time index.
+func scheduleTimeIndexKey(t time.Time, id uint64) []byte {
+	return append(sdk.FormatTimeBytes(t), sdk.Uint64ToBigEndian(id)...)
+}
+
+// Index a schedule by the height or the block time of its next run.
+func (k Keeper) setScheduleIndex(ctx sdk.Context, schedule types.Schedule) {
+	store := ctx.KVStore(k.storeKey)
+	if nextRun := schedule.NextRun(); nextRun != nil {
+		prefix.NewStore(store, StoreKeyScheduleTimeIndex).Set(scheduleTimeIndexKey(*nextRun, schedule.Id), []byte{})
+		return
+	}
+
+	prefix.NewStore(store, StoreKeyScheduleHeightIndex).Set(scheduleHeightIndexKey(schedule.RunAtHeight, schedule.Id), []byte{})
+}
+
+// Remove the index of a schedule.
+func (k Keeper) deleteScheduleIndex(ctx sdk.Context, schedule types.Schedule) {
+	store := ctx.KVStore(k.storeKey)
+	if nextRun := schedule.NextRun(); nextRun != nil {
+		prefix.NewStore(store, StoreKeyScheduleTimeIndex).Delete(scheduleTimeIndexKey(*nextRun, schedule.Id))
+		return
+	}
+
+	prefix.NewStore(store, StoreKeyScheduleHeightIndex).Delete(scheduleHeightIndexKey(schedule.RunAtHeight, schedule.Id))
+}
+
+// Get the schedules due at the current block, up to the given limit when not zero. Only the due
+// entries of the indexes are iterated, in the order of their next run.
+func (k Keeper) GetDueSchedules(ctx sdk.Context, limit uint64) ([]types.Schedule, error) {
+	store := ctx.KVStore(k.storeKey)
+	ids := []uint64{}
+	collect := func(iterator storetypes.Iterator) {
+		defer iterator.Close()
+		for ; iterator.Valid() && (limit == 0 || uint64(len(ids)) < limit); iterator.Next() {
+			key := iterator.Key()
+			ids = append(ids, binary.BigEndian.Uint64(key[len(key)-8:]))
+		}
+	}
+
+	// Schedules due at or before the current height
+	heightEnd := sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight()) + 1)
+	collect(prefix.NewStore(store, StoreKeyScheduleHeightIndex).Iterator(nil, heightEnd))
+
+	// Schedules due at or before the current block time
+	timeEnd := storetypes.PrefixEndBytes(sdk.FormatTimeBytes(ctx.BlockTime()))
+	collect(prefix.NewStore(store, StoreKeyScheduleTimeIndex).Iterator(nil, timeEnd))
+
+	schedules := make([]types.Schedule, 0, len(ids))
+	for _, id := range ids {
+		schedule, err := k.GetSchedule(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		schedules = append(schedules, *schedule)
+	}
+
+	return schedules, nil
+}
+
+// Create a schedule for the creator, who pays the schedule fee to the community pool. Returns the
+// id assigned to the schedule.
+func (k Keeper) CreateSchedule(ctx sdk.Context, schedule types.Schedule) (uint64, error) {
+	p := k.GetParams(ctx)
+
+	// Ensure the schedule is valid
+	if err := schedule.CallType.Validate(); err != nil {
+		return 0, err
+	}
+	if err := types.ValidateScheduleMsg(schedule.Msg); err != nil {
+		return 0, err
+	}
+	if err := types.ValidateScheduleTrigger(schedule.RunAtHeight, schedule.RunAtTime, schedule.Cron); err != nil {
+		return 0, err
+	}
+	if err := types.ValidateGasLimit(schedule.GasLimit, p); err != nil {
+		return 0, err
+	}
+
+	// Ensure the contract exists, and that only its manager can schedule sudo calls
+	contractAddr, err := sdk.AccAddressFromBech32(schedule.ContractAddress)
+	if err != nil {
+		return 0, err
+	}
+	if !k.wasmKeeper.HasContractInfo(ctx, contractAddr) {
+		return 0, types.ErrInvalidCWContract
+	}
+	if schedule.CallType == types.ScheduleCallTypeSudo {
+		if ok, err := k.IsContractManager(ctx, schedule.Creator, schedule.ContractAddress); !ok {
+			return 0, err
+		}
+	}
+
+	// Ensure the first run is in the future
+	switch {
+	case schedule.IsCron():
+		cron, err := types.ParseCron(schedule.Cron)
+		if err != nil {
+			return 0, err
+		}
+		next := cron.Next(ctx.BlockTime())
+		if next.IsZero() {
+			return 0, types.ErrInvalidCron.Wrapf("%s never runs", schedule.Cron)
+		}
+		schedule.NextRunTime = &next
+	case schedule.RunAtTime != nil:
+		if !schedule.RunAtTime.After(ctx.BlockTime()) {
+			return 0, types.ErrInvalidSchedule.Wrapf("run at time %s must be after the current block time %s", schedule.RunAtTime, ctx.BlockTime())
+		}
+	default:
+		if schedule.RunAtHeight <= ctx.BlockHeight() {
+			return 0, types.ErrInvalidSchedule.Wrapf("run at height %d must be after the current height %d", schedule.RunAtHeight, ctx.BlockHeight())
+		}
+	}
+
+	// Collect the schedule fee
+	if p.ScheduleFee.IsPositive() {
+		creatorAddr, err := sdk.AccAddressFromBech32(schedule.Creator)
+		if err != nil {
+			return 0, err
+		}
+		if err := k.distrKeeper.FundCommunityPool(ctx, sdk.NewCoins(p.ScheduleFee), creatorAddr); err != nil {
+			return 0, err
+		}
+	}
+
+	// Assign the id
+	schedule.Id = k.GetNextScheduleID(ctx)
+	k.SetNextScheduleID(ctx, schedule.Id+1)
+
+	return schedule.Id, k.SetSchedule(ctx, schedule)
+}
+
+// Charge the gas used by the run of a schedule at the gas price of the params, from the creator of
+// the schedule to the fee collector.
+func (k Keeper) ChargeScheduleGas(ctx sdk.Context, schedule types.Schedule, gasUsed uint64) error {
+	fee := k.GetParams(ctx).GasFee(gasUsed)
+	if !fee.IsPositive() {
+		return nil
+	}
+
+	creatorAddr, err := sdk.AccAddressFromBech32(schedule.Creator)
+	if err != nil {
+		return err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, creatorAddr, authtypes.FeeCollectorName, sdk.NewCoins(fee)); err != nil {
+		return types.ErrInsufficientGasFunds.Wrapf("%s from %s: %s", fee, schedule.Creator, err)
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventScheduleGasCharged{
+		Id:              schedule.Id,
+		ContractAddress: schedule.ContractAddress,
+		Payer:           schedule.Creator,
+		GasUsed:         gasUsed,
+		Fee:             fee,
+	})
+}
+
+// Cancel a schedule by its creator. The schedule fee is not refunded.
+func (k Keeper) CancelSchedule(ctx sdk.Context, creator string, id uint64) error {
+	schedule, err := k.GetSchedule(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if schedule.Creator != creator {
+		return types.ErrNotScheduleCreator
+	}
+
+	k.RemoveSchedule(ctx, *schedule)
+	return nil
+}
+
+// Complete the run of a schedule at the current block. One-off schedules are removed, cron
+// schedules are moved to their next run after the current block time, or removed when they never
+// run again.
+func (k Keeper) CompleteScheduleRun(ctx sdk.Context, schedule types.Schedule) error {
+	if !schedule.IsCron() {
+		k.RemoveSchedule(ctx, schedule)
+		return nil
+	}
+
+	cron, err := types.ParseCron(schedule.Cron)
+	if err != nil {
+		return err
+	}
+
+	k.deleteScheduleIndex(ctx, schedule)
+	next := cron.Next(ctx.BlockTime())
+	if next.IsZero() {
+		k.getScheduleStore(ctx).Delete(sdk.Uint64ToBigEndian(schedule.Id))
+		return nil
+	}
+
+	schedule.NextRunTime = &next
+	return k.SetSchedule(ctx, schedule)
+}
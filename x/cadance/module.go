@@ -3,6 +3,7 @@ package cadance
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 
 	"github.com/gorilla/mux"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
@@ -27,7 +28,7 @@ const (
 	ModuleName = types.ModuleName
 
 	// ConsensusVersion defines the current x/cadance module consensus version.
-	ConsensusVersion = 1
+	ConsensusVersion = 2
 )
 
 var (
@@ -46,9 +47,7 @@ func (a AppModuleBasic) Name() string {
 }
 
 func (a AppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
-	return cdc.MustMarshalJSON(&types.GenesisState{
-		Params: types.DefaultParams(),
-	})
+	return cdc.MustMarshalJSON(DefaultGenesisState())
 }
 
 func (a AppModuleBasic) ValidateGenesis(marshaler codec.JSONCodec, _ client.TxEncodingConfig, message json.RawMessage) error {
@@ -57,8 +56,8 @@ func (a AppModuleBasic) ValidateGenesis(marshaler codec.JSONCodec, _ client.TxEn
 	if err != nil {
 		return err
 	}
-	if err := data.Params.Validate(); err != nil {
-		return errorsmod.Wrap(err, "params")
+	if err := ValidateGenesis(data); err != nil {
+		return errorsmod.Wrap(err, "cadance")
 	}
 	return nil
 }
@@ -135,15 +134,22 @@ func (a AppModule) QuerierRoute() string {
 func (a AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(a.keeper))
 	types.RegisterQueryServer(cfg.QueryServer(), keeper.NewQuerier(a.keeper))
+
+	m := keeper.NewMigrator(a.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", types.ModuleName, err))
+	}
 }
 
-// BeginBlock performs a no-op.
-func (AppModule) BeginBlock(_ context.Context) error {
+// BeginBlock executes the contracts registered for the beginning of the block.
+func (a AppModule) BeginBlock(ctx context.Context) error {
+	BeginBlocker(sdk.UnwrapSDKContext(ctx), a.keeper)
 	return nil
 }
 
-// EndBlock returns the end blocker for the fantoken module. It returns no validator updates.
-func (AppModule) EndBlock(_ context.Context) error {
+// EndBlock executes the contracts registered for the end of the block. It returns no validator updates.
+func (a AppModule) EndBlock(ctx context.Context) error {
+	EndBlocker(sdk.UnwrapSDKContext(ctx), a.keeper)
 	return nil
 }
 
@@ -0,0 +1,178 @@
+package types
+
+import (
+	"sort"
+	"strings"
+)
+
+// Validate ensures the execution phase is a known one
+func (p ExecutionPhase) Validate() error {
+	if _, ok := ExecutionPhase_name[int32(p)]; !ok {
+		return ErrInvalidExecutionPhase.Wrapf("%d", p)
+	}
+
+	return nil
+}
+
+// IsBeginBlock returns true if the contract is executed at the beginning of the block
+func (p ExecutionPhase) IsBeginBlock() bool {
+	return p == ExecutionPhaseBeginBlock || p == ExecutionPhaseBeginAndEndBlock
+}
+
+// IsEndBlock returns true if the contract is executed at the end of the block
+func (p ExecutionPhase) IsEndBlock() bool {
+	return p == ExecutionPhaseEndBlock || p == ExecutionPhaseBeginAndEndBlock
+}
+
+// ValidateStartHeight ensures the start height is not negative
+func ValidateStartHeight(height int64) error {
+	if height < 0 {
+		return ErrInvalidStartHeight.Wrapf("%d", height)
+	}
+
+	return nil
+}
+
+// IsDue returns true if the contract has to be executed at the given height, i.e. the start
+// height has been reached and the execution interval has elapsed since the start height
+func (c CadanceContract) IsDue(height int64) bool {
+	if height < c.StartHeight {
+		return false
+	}
+
+	if c.ExecutionInterval <= 1 {
+		return true
+	}
+
+	return uint64(height-c.StartHeight)%c.ExecutionInterval == 0
+}
+
+// Includes returns true if the contract is executed in the given phase of the block
+func (p ExecutionPhase) Includes(phase ExecutionPhase) bool {
+	switch phase {
+	case ExecutionPhaseBeginBlock:
+		return p.IsBeginBlock()
+	case ExecutionPhaseEndBlock:
+		return p.IsEndBlock()
+	default:
+		return p == phase
+	}
+}
+
+// ValidateGasLimit ensures the gas limit requested by a contract is within the maximum
+func ValidateGasLimit(gasLimit uint64, p Params) error {
+	if gasLimit > p.MaxContractGasLimit {
+		return ErrInvalidGasLimit.Wrapf("%d above the maximum %d", gasLimit, p.MaxContractGasLimit)
+	}
+
+	return nil
+}
+
+// ValidatePriority ensures the priority requested by a contract manager is within the maximum
+func ValidatePriority(priority uint32, p Params) error {
+	if priority > p.MaxPriority {
+		return ErrInvalidPriority.Wrapf("%d above the maximum %d", priority, p.MaxPriority)
+	}
+
+	return nil
+}
+
+// ExecutionOrder returns the contracts, sorted by address, in the order they are executed: by
+// decreasing priority and, among the contracts of the same priority, by address. The cursor is the
+// contract the previous execution stopped at, and only the contracts of its priority are executed
+// in round-robin order from it, so that the other priorities keep their order.
+func ExecutionOrder(contracts []CadanceContract, cursor string) []CadanceContract {
+	byPriority := make([]CadanceContract, len(contracts))
+	copy(byPriority, contracts)
+	sort.SliceStable(byPriority, func(i, j int) bool {
+		return byPriority[i].Priority > byPriority[j].Priority
+	})
+
+	// Rotate the group of contracts of the priority of the cursor to start from it
+	for start := 0; start < len(byPriority); {
+		end := start + 1
+		for end < len(byPriority) && byPriority[end].Priority == byPriority[start].Priority {
+			end++
+		}
+
+		group := byPriority[start:end]
+		first := sort.Search(len(group), func(i int) bool {
+			return group[i].ContractAddress >= cursor
+		})
+		if first < len(group) && group[first].ContractAddress == cursor {
+			rotated := append(append([]CadanceContract{}, group[first:]...), group[:first]...)
+			copy(group, rotated)
+			break
+		}
+		start = end
+	}
+
+	return byPriority
+}
+
+// EffectiveGasLimit returns the gas limit of the contract executions, which is the requested one or
+// the default one of the params when not set, bounded by the maximum of the params
+func (c CadanceContract) EffectiveGasLimit(p Params) uint64 {
+	return effectiveGasLimit(c.GasLimit, p)
+}
+
+// effectiveGasLimit returns the requested gas limit, or the default one of the params when not
+// set, bounded by the maximum of the params
+func effectiveGasLimit(gasLimit uint64, p Params) uint64 {
+	if gasLimit == 0 {
+		gasLimit = p.ContractGasLimit
+	}
+
+	if p.MaxContractGasLimit != 0 && gasLimit > p.MaxContractGasLimit {
+		return p.MaxContractGasLimit
+	}
+
+	return gasLimit
+}
+
+// ValidateFeePayer ensures the fee payer requested by a sender is either the sender itself, which
+// consents to pay by signing, or empty for the contract to pay its own execution gas
+func ValidateFeePayer(feePayer string, sender string) error {
+	if feePayer != "" && feePayer != sender {
+		return ErrInvalidFeePayer.Wrapf("%s must be empty or the sender %s", feePayer, sender)
+	}
+
+	return nil
+}
+
+// GasPayer returns the account paying the execution gas of the contract, which is its fee payer or
+// the contract itself when not set
+func (c CadanceContract) GasPayer() string {
+	if c.FeePayer != "" {
+		return c.FeePayer
+	}
+
+	return c.ContractAddress
+}
+
+// Unjail the contract, clearing its jail reason, its scheduled unjail and its consecutive failures.
+// The jail count is kept so that repeat offenders get longer cooldowns.
+func (c *CadanceContract) Unjail() {
+	c.IsJailed = false
+	c.JailedByAuthority = false
+	c.JailReason = ""
+	c.UnjailHeight = 0
+	c.ConsecutiveFailures = 0
+}
+
+// Check if the contract is jailed and its automatic unjail is due at the given height.
+func (c CadanceContract) IsUnjailDue(height int64) bool {
+	return c.IsJailed && c.UnjailHeight > 0 && height >= c.UnjailHeight
+}
+
+// MaxErrorLength is the maximum length of the execution errors and jail reasons kept in state
+const MaxErrorLength = 256
+
+// TruncateError truncates an execution error to the maximum length kept in state
+func TruncateError(err string) string {
+	if len(err) <= MaxErrorLength {
+		return err
+	}
+
+	return strings.ToValidUTF8(err[:MaxErrorLength], "")
+}
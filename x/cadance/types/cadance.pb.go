@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -22,6 +24,67 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ExecutionPhase defines the phases of the block in which a contract is executed.
+type ExecutionPhase int32
+
+const (
+	// The contract is executed at the end of the block.
+	ExecutionPhaseEndBlock ExecutionPhase = 0
+	// The contract is executed at the beginning of the block.
+	ExecutionPhaseBeginBlock ExecutionPhase = 1
+	// The contract is executed both at the beginning and at the end of the block.
+	ExecutionPhaseBeginAndEndBlock ExecutionPhase = 2
+)
+
+var ExecutionPhase_name = map[int32]string{
+	0: "EXECUTION_PHASE_END_BLOCK",
+	1: "EXECUTION_PHASE_BEGIN_BLOCK",
+	2: "EXECUTION_PHASE_BEGIN_AND_END_BLOCK",
+}
+
+var ExecutionPhase_value = map[string]int32{
+	"EXECUTION_PHASE_END_BLOCK":           0,
+	"EXECUTION_PHASE_BEGIN_BLOCK":         1,
+	"EXECUTION_PHASE_BEGIN_AND_END_BLOCK": 2,
+}
+
+func (x ExecutionPhase) String() string {
+	return proto.EnumName(ExecutionPhase_name, int32(x))
+}
+
+func (ExecutionPhase) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_0723a625e9a372d5, []int{0}
+}
+
+// SudoMessageVersion defines the schema of the sudo message sent to a contract.
+type SudoMessageVersion int32
+
+const (
+	// The static message of the phase, or the custom payload when set.
+	SudoMessageVersionV1 SudoMessageVersion = 0
+	// The message of the phase carrying the block context, the registration id
+	// and the custom payload.
+	SudoMessageVersionV2 SudoMessageVersion = 1
+)
+
+var SudoMessageVersion_name = map[int32]string{
+	0: "SUDO_MESSAGE_VERSION_V1",
+	1: "SUDO_MESSAGE_VERSION_V2",
+}
+
+var SudoMessageVersion_value = map[string]int32{
+	"SUDO_MESSAGE_VERSION_V1": 0,
+	"SUDO_MESSAGE_VERSION_V2": 1,
+}
+
+func (x SudoMessageVersion) String() string {
+	return proto.EnumName(SudoMessageVersion_name, int32(x))
+}
+
+func (SudoMessageVersion) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_0723a625e9a372d5, []int{1}
+}
+
 // This object is used to store the contract address and the
 // jail status of the contract.
 type CadanceContract struct {
@@ -29,6 +92,59 @@ type CadanceContract struct {
 	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
 	// The jail status of the contract.
 	IsJailed bool `protobuf:"varint,2,opt,name=is_jailed,json=isJailed,proto3" json:"is_jailed,omitempty"`
+	// The phases of the block in which the contract is executed.
+	Phase ExecutionPhase `protobuf:"varint,3,opt,name=phase,proto3,enum=bitsong.cadance.v1.ExecutionPhase" json:"phase,omitempty"`
+	// The number of blocks between two executions of the contract, the
+	// contract is executed every block when zero.
+	ExecutionInterval uint64 `protobuf:"varint,4,opt,name=execution_interval,json=executionInterval,proto3" json:"execution_interval,omitempty"`
+	// The height from which the contract is executed.
+	StartHeight int64 `protobuf:"varint,5,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// The height of the last successful execution of the contract.
+	LastExecutedHeight int64 `protobuf:"varint,6,opt,name=last_executed_height,json=lastExecutedHeight,proto3" json:"last_executed_height,omitempty"`
+	// The deposit held by the module while the contract is registered.
+	Deposit types.Coin `protobuf:"bytes,7,opt,name=deposit,proto3" json:"deposit"`
+	// The address which paid the deposit and to which it is refunded.
+	Depositor string `protobuf:"bytes,8,opt,name=depositor,proto3" json:"depositor,omitempty"`
+	// The gas limit of the contract executions, the contract_gas_limit param is
+	// used when zero.
+	GasLimit uint64 `protobuf:"varint,9,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// The height of the last failed execution of the contract.
+	LastFailureHeight int64 `protobuf:"varint,10,opt,name=last_failure_height,json=lastFailureHeight,proto3" json:"last_failure_height,omitempty"`
+	// The gas used by the last execution of the contract.
+	LastGasUsed uint64 `protobuf:"varint,11,opt,name=last_gas_used,json=lastGasUsed,proto3" json:"last_gas_used,omitempty"`
+	// The number of failed executions since the last successful one.
+	ConsecutiveFailures uint64 `protobuf:"varint,12,opt,name=consecutive_failures,json=consecutiveFailures,proto3" json:"consecutive_failures,omitempty"`
+	// The error of the last failed execution of the contract.
+	LastError string `protobuf:"bytes,13,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	// The reason the contract has been jailed for, empty when not jailed.
+	JailReason string `protobuf:"bytes,14,opt,name=jail_reason,json=jailReason,proto3" json:"jail_reason,omitempty"`
+	// The number of times the contract has been jailed for failed executions.
+	JailCount uint64 `protobuf:"varint,15,opt,name=jail_count,json=jailCount,proto3" json:"jail_count,omitempty"`
+	// The height at which the contract is unjailed automatically, zero when it
+	// is not scheduled.
+	UnjailHeight int64 `protobuf:"varint,16,opt,name=unjail_height,json=unjailHeight,proto3" json:"unjail_height,omitempty"`
+	// The id assigned to the registration of the contract.
+	RegistrationId uint64 `protobuf:"varint,17,opt,name=registration_id,json=registrationId,proto3" json:"registration_id,omitempty"`
+	// The custom JSON payload sent to the contract.
+	SudoPayload string `protobuf:"bytes,18,opt,name=sudo_payload,json=sudoPayload,proto3" json:"sudo_payload,omitempty"`
+	// The schema of the sudo message sent to the contract.
+	SudoMessageVersion SudoMessageVersion `protobuf:"varint,19,opt,name=sudo_message_version,json=sudoMessageVersion,proto3,enum=bitsong.cadance.v1.SudoMessageVersion" json:"sudo_message_version,omitempty"`
+	// The account paying the execution gas, the contract itself when empty.
+	FeePayer string `protobuf:"bytes,20,opt,name=fee_payer,json=feePayer,proto3" json:"fee_payer,omitempty"`
+	// Whether the contract has been jailed by governance, in which case it is
+	// only released by unregistering it.
+	JailedByAuthority bool `protobuf:"varint,21,opt,name=jailed_by_authority,json=jailedByAuthority,proto3" json:"jailed_by_authority,omitempty"`
+	// The code id of the contract when it was registered or last confirmed.
+	CodeId uint64 `protobuf:"varint,22,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
+	// The admin of the contract, or its creator when there is no admin, when
+	// it was registered or last confirmed.
+	Manager string `protobuf:"bytes,23,opt,name=manager,proto3" json:"manager,omitempty"`
+	// Whether the contract is paused until its manager confirms it, after its
+	// code or its manager changed.
+	PendingConfirmation bool `protobuf:"varint,24,opt,name=pending_confirmation,json=pendingConfirmation,proto3" json:"pending_confirmation,omitempty"`
+	// The priority of the contract, contracts of higher priority are executed
+	// first in every phase of the block.
+	Priority uint32 `protobuf:"varint,25,opt,name=priority,proto3" json:"priority,omitempty"`
 }
 
 func (m *CadanceContract) Reset()         { *m = CadanceContract{} }
@@ -78,27 +194,233 @@ func (m *CadanceContract) GetIsJailed() bool {
 	return false
 }
 
+func (m *CadanceContract) GetPhase() ExecutionPhase {
+	if m != nil {
+		return m.Phase
+	}
+	return ExecutionPhaseEndBlock
+}
+
+func (m *CadanceContract) GetExecutionInterval() uint64 {
+	if m != nil {
+		return m.ExecutionInterval
+	}
+	return 0
+}
+
+func (m *CadanceContract) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *CadanceContract) GetLastExecutedHeight() int64 {
+	if m != nil {
+		return m.LastExecutedHeight
+	}
+	return 0
+}
+
+func (m *CadanceContract) GetDeposit() types.Coin {
+	if m != nil {
+		return m.Deposit
+	}
+	return types.Coin{}
+}
+
+func (m *CadanceContract) GetDepositor() string {
+	if m != nil {
+		return m.Depositor
+	}
+	return ""
+}
+
+func (m *CadanceContract) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+func (m *CadanceContract) GetLastFailureHeight() int64 {
+	if m != nil {
+		return m.LastFailureHeight
+	}
+	return 0
+}
+
+func (m *CadanceContract) GetLastGasUsed() uint64 {
+	if m != nil {
+		return m.LastGasUsed
+	}
+	return 0
+}
+
+func (m *CadanceContract) GetConsecutiveFailures() uint64 {
+	if m != nil {
+		return m.ConsecutiveFailures
+	}
+	return 0
+}
+
+func (m *CadanceContract) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+func (m *CadanceContract) GetJailReason() string {
+	if m != nil {
+		return m.JailReason
+	}
+	return ""
+}
+
+func (m *CadanceContract) GetJailCount() uint64 {
+	if m != nil {
+		return m.JailCount
+	}
+	return 0
+}
+
+func (m *CadanceContract) GetUnjailHeight() int64 {
+	if m != nil {
+		return m.UnjailHeight
+	}
+	return 0
+}
+
+func (m *CadanceContract) GetRegistrationId() uint64 {
+	if m != nil {
+		return m.RegistrationId
+	}
+	return 0
+}
+
+func (m *CadanceContract) GetSudoPayload() string {
+	if m != nil {
+		return m.SudoPayload
+	}
+	return ""
+}
+
+func (m *CadanceContract) GetSudoMessageVersion() SudoMessageVersion {
+	if m != nil {
+		return m.SudoMessageVersion
+	}
+	return SudoMessageVersionV1
+}
+
+func (m *CadanceContract) GetFeePayer() string {
+	if m != nil {
+		return m.FeePayer
+	}
+	return ""
+}
+
+func (m *CadanceContract) GetJailedByAuthority() bool {
+	if m != nil {
+		return m.JailedByAuthority
+	}
+	return false
+}
+
+func (m *CadanceContract) GetCodeId() uint64 {
+	if m != nil {
+		return m.CodeId
+	}
+	return 0
+}
+
+func (m *CadanceContract) GetManager() string {
+	if m != nil {
+		return m.Manager
+	}
+	return ""
+}
+
+func (m *CadanceContract) GetPendingConfirmation() bool {
+	if m != nil {
+		return m.PendingConfirmation
+	}
+	return false
+}
+
+func (m *CadanceContract) GetPriority() uint32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("bitsong.cadance.v1.ExecutionPhase", ExecutionPhase_name, ExecutionPhase_value)
+	proto.RegisterEnum("bitsong.cadance.v1.SudoMessageVersion", SudoMessageVersion_name, SudoMessageVersion_value)
 	proto.RegisterType((*CadanceContract)(nil), "bitsong.cadance.v1.CadanceContract")
 }
 
 func init() { proto.RegisterFile("bitsong/cadance/v1/cadance.proto", fileDescriptor_0723a625e9a372d5) }
 
 var fileDescriptor_0723a625e9a372d5 = []byte{
-	// 199 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0xca, 0x2c, 0x29,
-	0xce, 0xcf, 0x4b, 0xd7, 0x4f, 0x4e, 0x4c, 0x49, 0xcc, 0x4b, 0x4e, 0xd5, 0x2f, 0x33, 0x84, 0x31,
-	0xf5, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0x84, 0xa0, 0x2a, 0xf4, 0x60, 0xc2, 0x65, 0x86, 0x4a,
-	0x91, 0x5c, 0xfc, 0xce, 0x10, 0x9e, 0x73, 0x7e, 0x5e, 0x49, 0x51, 0x62, 0x72, 0x89, 0x90, 0x26,
-	0x97, 0x40, 0x32, 0x94, 0x1d, 0x9f, 0x98, 0x92, 0x52, 0x94, 0x5a, 0x5c, 0x2c, 0xc1, 0xa8, 0xc0,
-	0xa8, 0xc1, 0x19, 0xc4, 0x0f, 0x13, 0x77, 0x84, 0x08, 0x0b, 0x49, 0x73, 0x71, 0x66, 0x16, 0xc7,
-	0x67, 0x25, 0x66, 0xe6, 0xa4, 0xa6, 0x48, 0x30, 0x29, 0x30, 0x6a, 0x70, 0x04, 0x71, 0x64, 0x16,
-	0x7b, 0x81, 0xf9, 0x4e, 0xfe, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91,
-	0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0x65,
-	0x9a, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x0f, 0x75, 0x53, 0x7e, 0x5a,
-	0x5a, 0x66, 0x72, 0x66, 0x62, 0x8e, 0x7e, 0x7a, 0xbe, 0x2e, 0xcc, 0x23, 0x15, 0x70, 0xaf, 0x94,
-	0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0xbd, 0x61, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0xf4,
-	0xf6, 0xe2, 0x17, 0xea, 0x00, 0x00, 0x00,
+	// 887 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x55, 0xcd, 0x6e, 0xdb, 0x36,
+	0x1c, 0xb7, 0xda, 0xb4, 0xb1, 0xe9, 0x7c, 0x38, 0x8c, 0xd7, 0x30, 0x6e, 0xe7, 0x6a, 0x29, 0xb0,
+	0x79, 0x05, 0x2a, 0xcf, 0x19, 0x0a, 0xac, 0x87, 0x1d, 0x6c, 0x47, 0x4b, 0xbd, 0xb6, 0x49, 0x20,
+	0x2f, 0x41, 0xb1, 0x8b, 0x40, 0x8b, 0xb4, 0xcc, 0xcd, 0x26, 0x0d, 0x92, 0x36, 0xea, 0x37, 0x28,
+	0x02, 0x0c, 0xd8, 0x0b, 0xe4, 0xb4, 0x97, 0xe9, 0xb1, 0xc7, 0x5d, 0x36, 0x0c, 0xc9, 0x8b, 0x0c,
+	0x24, 0xa5, 0x2c, 0x59, 0xd2, 0x1b, 0xf9, 0xfb, 0xf8, 0x7f, 0x52, 0x36, 0xf0, 0x07, 0x4c, 0x2b,
+	0xc1, 0xd3, 0x66, 0x82, 0x09, 0xe6, 0x09, 0x6d, 0xce, 0x5b, 0xf9, 0x31, 0x98, 0x4a, 0xa1, 0x05,
+	0x84, 0x99, 0x22, 0xc8, 0xe1, 0x79, 0xab, 0x56, 0x4d, 0x45, 0x2a, 0x2c, 0xdd, 0x34, 0x27, 0xa7,
+	0xac, 0xd5, 0x13, 0xa1, 0x26, 0x42, 0x35, 0x07, 0x58, 0x99, 0x38, 0x03, 0xaa, 0x71, 0xab, 0x99,
+	0x08, 0xc6, 0x1d, 0xbf, 0xf3, 0x5b, 0x11, 0xac, 0x77, 0x5d, 0x90, 0xae, 0xe0, 0x5a, 0xe2, 0x44,
+	0xc3, 0xaf, 0x41, 0x25, 0xc9, 0xce, 0x31, 0x26, 0x44, 0x52, 0xa5, 0x90, 0xe7, 0x7b, 0x8d, 0x52,
+	0xb4, 0x9e, 0xe3, 0x6d, 0x07, 0xc3, 0x87, 0xa0, 0xc4, 0x54, 0xfc, 0x0b, 0x66, 0x63, 0x4a, 0xd0,
+	0x1d, 0xdf, 0x6b, 0x14, 0xa3, 0x22, 0x53, 0x3f, 0xda, 0x3b, 0xfc, 0x0e, 0xdc, 0x9b, 0x8e, 0xb0,
+	0xa2, 0xe8, 0xae, 0xef, 0x35, 0xd6, 0x76, 0x77, 0x82, 0x9b, 0x55, 0x07, 0xe1, 0x3b, 0x9a, 0xcc,
+	0x34, 0x13, 0xfc, 0xc8, 0x28, 0x23, 0x67, 0x80, 0xcf, 0x00, 0xa4, 0x39, 0x11, 0x33, 0xae, 0xa9,
+	0x9c, 0xe3, 0x31, 0x5a, 0xf2, 0xbd, 0xc6, 0x52, 0xb4, 0x71, 0xc9, 0xf4, 0x32, 0x02, 0x7e, 0x01,
+	0x56, 0x94, 0xc6, 0x52, 0xc7, 0x23, 0xca, 0xd2, 0x91, 0x46, 0xf7, 0x7c, 0xaf, 0x71, 0x37, 0x2a,
+	0x5b, 0xec, 0xa5, 0x85, 0xe0, 0x37, 0xa0, 0x3a, 0xc6, 0x4a, 0xc7, 0xce, 0x4c, 0x49, 0x2e, 0xbd,
+	0x6f, 0xa5, 0xd0, 0x70, 0x61, 0x46, 0x65, 0x8e, 0x17, 0x60, 0x99, 0xd0, 0xa9, 0x50, 0x4c, 0xa3,
+	0x65, 0xdf, 0x6b, 0x94, 0x77, 0xb7, 0x03, 0x37, 0xcb, 0xc0, 0xcc, 0x32, 0xc8, 0x66, 0x19, 0x74,
+	0x05, 0xe3, 0x9d, 0xa5, 0x0f, 0x7f, 0x3f, 0x2e, 0x44, 0xb9, 0x1e, 0x3e, 0x02, 0xa5, 0xec, 0x28,
+	0x24, 0x2a, 0xda, 0xc9, 0xfd, 0x07, 0x98, 0x99, 0xa5, 0x58, 0xc5, 0x63, 0x36, 0x61, 0x1a, 0x95,
+	0x6c, 0x4f, 0xc5, 0x14, 0xab, 0xd7, 0xe6, 0x0e, 0x03, 0xb0, 0x69, 0xeb, 0x1c, 0x62, 0x36, 0x9e,
+	0x49, 0x9a, 0x97, 0x09, 0x6c, 0x99, 0x1b, 0x86, 0xfa, 0xc1, 0x31, 0x59, 0x95, 0x3b, 0x60, 0xd5,
+	0xea, 0x4d, 0xc4, 0x99, 0xa2, 0x04, 0x95, 0x6d, 0xc0, 0xb2, 0x01, 0xf7, 0xb1, 0x3a, 0x56, 0x94,
+	0xc0, 0x16, 0xa8, 0x26, 0x82, 0x2b, 0x3b, 0xb5, 0x39, 0xcd, 0x43, 0x2b, 0xb4, 0x62, 0xa5, 0x9b,
+	0x57, 0xb8, 0x2c, 0xb6, 0x82, 0x9f, 0x03, 0xe0, 0xc6, 0x25, 0xa5, 0x90, 0x68, 0xd5, 0xb5, 0x60,
+	0x87, 0x64, 0x00, 0xf8, 0x18, 0x94, 0xcd, 0xce, 0x63, 0x49, 0xb1, 0x12, 0x1c, 0xad, 0x59, 0x1e,
+	0x18, 0x28, 0xb2, 0x88, 0xf1, 0x5b, 0x41, 0x22, 0x66, 0x5c, 0xa3, 0x75, 0x9b, 0xa8, 0x64, 0x90,
+	0xae, 0x01, 0xe0, 0x13, 0xb0, 0x3a, 0xe3, 0x56, 0x90, 0xf5, 0x57, 0xb1, 0xfd, 0xad, 0x38, 0x30,
+	0x6b, 0xed, 0x2b, 0xb0, 0x2e, 0x69, 0xca, 0x94, 0x96, 0xd8, 0xbd, 0x03, 0x82, 0x36, 0x6c, 0xa0,
+	0xb5, 0xab, 0x70, 0x8f, 0xd8, 0xf5, 0xcf, 0x88, 0x88, 0xa7, 0x78, 0x31, 0x16, 0x98, 0x20, 0x68,
+	0xcb, 0x29, 0x1b, 0xec, 0xc8, 0x41, 0xf0, 0x2d, 0xa8, 0x5a, 0xc9, 0x84, 0x2a, 0x85, 0x53, 0x1a,
+	0xcf, 0xa9, 0x54, 0x4c, 0x70, 0xb4, 0x69, 0x5f, 0xe6, 0x97, 0xb7, 0xbd, 0xcc, 0xfe, 0x8c, 0x88,
+	0x37, 0x4e, 0x7e, 0xe2, 0xd4, 0x11, 0x54, 0x37, 0x30, 0xb3, 0xcd, 0x21, 0xa5, 0x26, 0x37, 0x95,
+	0xa8, 0x6a, 0x33, 0x17, 0x87, 0x94, 0x1e, 0x99, 0xbb, 0xd9, 0xa6, 0xfb, 0x36, 0xe2, 0xc1, 0x22,
+	0xc6, 0x33, 0x3d, 0x12, 0x92, 0xe9, 0x05, 0xfa, 0xcc, 0x7e, 0x28, 0x1b, 0x8e, 0xea, 0x2c, 0xda,
+	0x39, 0x01, 0xb7, 0xc0, 0x72, 0x22, 0x08, 0x35, 0xad, 0x3e, 0xb0, 0xad, 0xde, 0x37, 0xd7, 0x1e,
+	0x81, 0x08, 0x2c, 0x4f, 0x30, 0xc7, 0x29, 0x95, 0x68, 0xcb, 0xe6, 0xc8, 0xaf, 0x66, 0xb9, 0x53,
+	0xca, 0x09, 0xe3, 0x69, 0x9c, 0x08, 0x3e, 0x64, 0x72, 0x62, 0xc7, 0x82, 0x90, 0xcd, 0xb1, 0x99,
+	0x71, 0xdd, 0x2b, 0x14, 0xac, 0x81, 0xe2, 0x54, 0x32, 0x57, 0xca, 0xb6, 0xef, 0x35, 0x56, 0xa3,
+	0xcb, 0xfb, 0xd3, 0xbf, 0x3c, 0xb0, 0x76, 0xfd, 0x9b, 0x84, 0x2f, 0xc0, 0x76, 0xf8, 0x36, 0xec,
+	0x1e, 0xff, 0xd4, 0x3b, 0x3c, 0x88, 0x8f, 0x5e, 0xb6, 0xfb, 0x61, 0x1c, 0x1e, 0xec, 0xc5, 0x9d,
+	0xd7, 0x87, 0xdd, 0x57, 0x95, 0x42, 0xad, 0x76, 0x7a, 0xe6, 0x3f, 0xb8, 0x6e, 0x09, 0x39, 0xe9,
+	0x8c, 0x45, 0xf2, 0x2b, 0xfc, 0x1e, 0x3c, 0xfc, 0xbf, 0xb5, 0x13, 0xee, 0xf7, 0x0e, 0x32, 0xb3,
+	0x57, 0x7b, 0x74, 0x7a, 0xe6, 0xa3, 0xeb, 0xe6, 0x0e, 0x4d, 0x19, 0x77, 0xf6, 0x57, 0xe0, 0xc9,
+	0xed, 0xf6, 0xf6, 0xc1, 0xde, 0x95, 0x1a, 0xee, 0xd4, 0x76, 0x4e, 0xcf, 0xfc, 0xfa, 0x2d, 0x61,
+	0xda, 0x9c, 0xe4, 0xb5, 0xd4, 0x96, 0xde, 0xff, 0x51, 0x2f, 0x3c, 0x7d, 0xef, 0x01, 0x78, 0x73,
+	0xb3, 0xf0, 0x39, 0xd8, 0xea, 0x1f, 0xef, 0x1d, 0xc6, 0x6f, 0xc2, 0x7e, 0xbf, 0xbd, 0x1f, 0xc6,
+	0x27, 0x61, 0xd4, 0x37, 0x49, 0x4f, 0x5a, 0x95, 0x42, 0x0d, 0x9d, 0x9e, 0xf9, 0xd5, 0x9b, 0xa6,
+	0x93, 0xd6, 0xa7, 0x6d, 0xbb, 0x15, 0xef, 0x93, 0xb6, 0x5d, 0x57, 0x4a, 0xe7, 0xf0, 0xc3, 0x79,
+	0xdd, 0xfb, 0x78, 0x5e, 0xf7, 0xfe, 0x39, 0xaf, 0x7b, 0xbf, 0x5f, 0xd4, 0x0b, 0x1f, 0x2f, 0xea,
+	0x85, 0x3f, 0x2f, 0xea, 0x85, 0x9f, 0x9f, 0xa7, 0x4c, 0x8f, 0x66, 0x83, 0x20, 0x11, 0x93, 0x66,
+	0xf6, 0x32, 0xc5, 0x70, 0xc8, 0x12, 0x86, 0xc7, 0xcd, 0x54, 0x3c, 0xcb, 0xff, 0x1e, 0xde, 0x5d,
+	0xfe, 0x41, 0xe8, 0xc5, 0x94, 0xaa, 0xc1, 0x7d, 0xfb, 0x93, 0xfe, 0xed, 0xbf, 0x03, 0x00, 0xe3,
+	0x0a, 0x0b, 0x81, 0x40, 0x06, 0x00, 0x00,
 }
 
 func (m *CadanceContract) Marshal() (dAtA []byte, err error) {
@@ -121,6 +443,168 @@ func (m *CadanceContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Priority != 0 {
+		i = encodeVarintCadance(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
+	}
+	if m.PendingConfirmation {
+		i--
+		if m.PendingConfirmation {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if len(m.Manager) > 0 {
+		i -= len(m.Manager)
+		copy(dAtA[i:], m.Manager)
+		i = encodeVarintCadance(dAtA, i, uint64(len(m.Manager)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	if m.CodeId != 0 {
+		i = encodeVarintCadance(dAtA, i, uint64(m.CodeId))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.JailedByAuthority {
+		i--
+		if m.JailedByAuthority {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if len(m.FeePayer) > 0 {
+		i -= len(m.FeePayer)
+		copy(dAtA[i:], m.FeePayer)
+		i = encodeVarintCadance(dAtA, i, uint64(len(m.FeePayer)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if m.SudoMessageVersion != 0 {
+		i = encodeVarintCadance(dAtA, i, uint64(m.SudoMessageVersion))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if len(m.SudoPayload) > 0 {
+		i -= len(m.SudoPayload)
+		copy(dAtA[i:], m.SudoPayload)
+		i = encodeVarintCadance(dAtA, i, uint64(len(m.SudoPayload)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.RegistrationId != 0 {
+		i = encodeVarintCadance(dAtA, i, uint64(m.RegistrationId))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.UnjailHeight != 0 {
+		i = encodeVarintCadance(dAtA, i, uint64(m.UnjailHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.JailCount != 0 {
+		i = encodeVarintCadance(dAtA, i, uint64(m.JailCount))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.JailReason) > 0 {
+		i -= len(m.JailReason)
+		copy(dAtA[i:], m.JailReason)
+		i = encodeVarintCadance(dAtA, i, uint64(len(m.JailReason)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.LastError) > 0 {
+		i -= len(m.LastError)
+		copy(dAtA[i:], m.LastError)
+		i = encodeVarintCadance(dAtA, i, uint64(len(m.LastError)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.ConsecutiveFailures != 0 {
+		i = encodeVarintCadance(dAtA, i, uint64(m.ConsecutiveFailures))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.LastGasUsed != 0 {
+		i = encodeVarintCadance(dAtA, i, uint64(m.LastGasUsed))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.LastFailureHeight != 0 {
+		i = encodeVarintCadance(dAtA, i, uint64(m.LastFailureHeight))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintCadance(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintCadance(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0x42
+	}
+	{
+		size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCadance(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.LastExecutedHeight != 0 {
+		i = encodeVarintCadance(dAtA, i, uint64(m.LastExecutedHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintCadance(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ExecutionInterval != 0 {
+		i = encodeVarintCadance(dAtA, i, uint64(m.ExecutionInterval))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Phase != 0 {
+		i = encodeVarintCadance(dAtA, i, uint64(m.Phase))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.IsJailed {
 		i--
 		if m.IsJailed {
@@ -165,6 +649,80 @@ func (m *CadanceContract) Size() (n int) {
 	if m.IsJailed {
 		n += 2
 	}
+	if m.Phase != 0 {
+		n += 1 + sovCadance(uint64(m.Phase))
+	}
+	if m.ExecutionInterval != 0 {
+		n += 1 + sovCadance(uint64(m.ExecutionInterval))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovCadance(uint64(m.StartHeight))
+	}
+	if m.LastExecutedHeight != 0 {
+		n += 1 + sovCadance(uint64(m.LastExecutedHeight))
+	}
+	l = m.Deposit.Size()
+	n += 1 + l + sovCadance(uint64(l))
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovCadance(uint64(l))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovCadance(uint64(m.GasLimit))
+	}
+	if m.LastFailureHeight != 0 {
+		n += 1 + sovCadance(uint64(m.LastFailureHeight))
+	}
+	if m.LastGasUsed != 0 {
+		n += 1 + sovCadance(uint64(m.LastGasUsed))
+	}
+	if m.ConsecutiveFailures != 0 {
+		n += 1 + sovCadance(uint64(m.ConsecutiveFailures))
+	}
+	l = len(m.LastError)
+	if l > 0 {
+		n += 1 + l + sovCadance(uint64(l))
+	}
+	l = len(m.JailReason)
+	if l > 0 {
+		n += 1 + l + sovCadance(uint64(l))
+	}
+	if m.JailCount != 0 {
+		n += 1 + sovCadance(uint64(m.JailCount))
+	}
+	if m.UnjailHeight != 0 {
+		n += 2 + sovCadance(uint64(m.UnjailHeight))
+	}
+	if m.RegistrationId != 0 {
+		n += 2 + sovCadance(uint64(m.RegistrationId))
+	}
+	l = len(m.SudoPayload)
+	if l > 0 {
+		n += 2 + l + sovCadance(uint64(l))
+	}
+	if m.SudoMessageVersion != 0 {
+		n += 2 + sovCadance(uint64(m.SudoMessageVersion))
+	}
+	l = len(m.FeePayer)
+	if l > 0 {
+		n += 2 + l + sovCadance(uint64(l))
+	}
+	if m.JailedByAuthority {
+		n += 3
+	}
+	if m.CodeId != 0 {
+		n += 2 + sovCadance(uint64(m.CodeId))
+	}
+	l = len(m.Manager)
+	if l > 0 {
+		n += 2 + l + sovCadance(uint64(l))
+	}
+	if m.PendingConfirmation {
+		n += 3
+	}
+	if m.Priority != 0 {
+		n += 2 + sovCadance(uint64(m.Priority))
+	}
 	return n
 }
 
@@ -255,6 +813,537 @@ func (m *CadanceContract) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.IsJailed = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			m.Phase = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCadance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Phase |= ExecutionPhase(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionInterval", wireType)
+			}
+			m.ExecutionInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCadance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutionInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCadance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastExecutedHeight", wireType)
+			}
+			m.LastExecutedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCadance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastExecutedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCadance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCadance
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCadance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCadance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCadance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCadance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCadance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastFailureHeight", wireType)
+			}
+			m.LastFailureHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCadance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastFailureHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastGasUsed", wireType)
+			}
+			m.LastGasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCadance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastGasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveFailures", wireType)
+			}
+			m.ConsecutiveFailures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCadance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsecutiveFailures |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCadance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCadance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCadance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCadance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCadance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCadance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JailReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailCount", wireType)
+			}
+			m.JailCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCadance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnjailHeight", wireType)
+			}
+			m.UnjailHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCadance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnjailHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationId", wireType)
+			}
+			m.RegistrationId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCadance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RegistrationId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SudoPayload", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCadance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCadance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCadance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SudoPayload = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SudoMessageVersion", wireType)
+			}
+			m.SudoMessageVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCadance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SudoMessageVersion |= SudoMessageVersion(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCadance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCadance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCadance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeePayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedByAuthority", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCadance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.JailedByAuthority = bool(v != 0)
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeId", wireType)
+			}
+			m.CodeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCadance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Manager", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCadance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCadance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCadance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Manager = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingConfirmation", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCadance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PendingConfirmation = bool(v != 0)
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCadance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCadance(dAtA[iNdEx:])
@@ -0,0 +1,89 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bitsongofficial/go-bitsong/x/cadance/types"
+)
+
+func TestCadanceContractIsDue(t *testing.T) {
+	testCases := []struct {
+		name     string
+		contract types.CadanceContract
+		height   int64
+		due      bool
+	}{
+		{"Every Block", types.CadanceContract{}, 7, true},
+		{"Interval Of One", types.CadanceContract{ExecutionInterval: 1}, 7, true},
+		{"Interval Elapsed", types.CadanceContract{ExecutionInterval: 10}, 20, true},
+		{"Interval Not Elapsed", types.CadanceContract{ExecutionInterval: 10}, 25, false},
+		{"Start Height Not Reached", types.CadanceContract{StartHeight: 30}, 29, false},
+		{"Start Height Reached", types.CadanceContract{StartHeight: 30, ExecutionInterval: 10}, 30, true},
+		{"Interval From Start Height", types.CadanceContract{StartHeight: 35, ExecutionInterval: 10}, 45, true},
+		{"Interval Not Elapsed From Start Height", types.CadanceContract{StartHeight: 35, ExecutionInterval: 10}, 40, false},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.due, tc.contract.IsDue(tc.height), tc.name)
+	}
+}
+
+func TestCadanceContractEffectiveGasLimit(t *testing.T) {
+	params := types.DefaultParams()
+	params.ContractGasLimit = 100_000
+	params.MaxContractGasLimit = 500_000
+
+	require.Equal(t, uint64(100_000), types.CadanceContract{}.EffectiveGasLimit(params))
+	require.Equal(t, uint64(300_000), types.CadanceContract{GasLimit: 300_000}.EffectiveGasLimit(params))
+	require.Equal(t, uint64(50_000), types.CadanceContract{GasLimit: 50_000}.EffectiveGasLimit(params))
+
+	// The maximum lowered by governance bounds the requested gas limit
+	require.Equal(t, uint64(500_000), types.CadanceContract{GasLimit: 800_000}.EffectiveGasLimit(params))
+}
+
+func TestExecutionOrder(t *testing.T) {
+	// Contracts sorted by address, as they are stored
+	contracts := []types.CadanceContract{
+		{ContractAddress: "a", Priority: 0},
+		{ContractAddress: "b", Priority: 5},
+		{ContractAddress: "c", Priority: 0},
+		{ContractAddress: "d", Priority: 5},
+		{ContractAddress: "e", Priority: 1},
+		{ContractAddress: "f", Priority: 0},
+	}
+
+	testCases := []struct {
+		name     string
+		cursor   string
+		expected []string
+	}{
+		{"No Cursor", "", []string{"b", "d", "e", "a", "c", "f"}},
+		{"Cursor Rotates Its Priority Only", "c", []string{"b", "d", "e", "c", "f", "a"}},
+		{"Cursor In Highest Priority", "d", []string{"d", "b", "e", "a", "c", "f"}},
+		{"Cursor Alone In Its Priority", "e", []string{"b", "d", "e", "a", "c", "f"}},
+		{"Unknown Cursor", "bb", []string{"b", "d", "e", "a", "c", "f"}},
+	}
+
+	for _, tc := range testCases {
+		ordered := types.ExecutionOrder(contracts, tc.cursor)
+		addresses := make([]string, 0, len(ordered))
+		for _, contract := range ordered {
+			addresses = append(addresses, contract.ContractAddress)
+		}
+		require.Equal(t, tc.expected, addresses, tc.name)
+	}
+
+	// The contracts are not reordered in place
+	require.Equal(t, "a", contracts[0].ContractAddress)
+}
+
+func TestValidatePriority(t *testing.T) {
+	params := types.DefaultParams()
+	params.MaxPriority = 10
+
+	require.NoError(t, types.ValidatePriority(0, params))
+	require.NoError(t, types.ValidatePriority(10, params))
+	require.ErrorIs(t, types.ValidatePriority(11, params), types.ErrInvalidPriority)
+}
@@ -24,8 +24,15 @@ func init() {
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgRegisterCadanceContract{}, "cadance/MsgRegisterCadanceContract", nil)
 	cdc.RegisterConcrete(&MsgUnregisterCadanceContract{}, "cadance/MsgUnregisterCadanceContract", nil)
+	cdc.RegisterConcrete(&MsgUpdateCadanceContract{}, "cadance/MsgUpdateCadanceContract", nil)
 	cdc.RegisterConcrete(&MsgUnjailCadanceContract{}, "cadance/MsgUnjailCadanceContract", nil)
+	cdc.RegisterConcrete(&MsgConfirmCadanceContract{}, "cadance/MsgConfirmCadanceContract", nil)
+	cdc.RegisterConcrete(&MsgCreateSchedule{}, "cadance/MsgCreateSchedule", nil)
+	cdc.RegisterConcrete(&MsgCancelSchedule{}, "cadance/MsgCancelSchedule", nil)
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "cadance/MsgUpdateParams", nil)
+	cdc.RegisterConcrete(&MsgForceRegisterCadanceContract{}, "cadance/MsgForceRegisterCadanceContract", nil)
+	cdc.RegisterConcrete(&MsgForceJailCadanceContract{}, "cadance/MsgForceJailCadanceContract", nil)
+	cdc.RegisterConcrete(&MsgForceUnregisterCadanceContract{}, "cadance/MsgForceUnregisterCadanceContract", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -33,8 +40,15 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgRegisterCadanceContract{},
 		&MsgUnregisterCadanceContract{},
+		&MsgUpdateCadanceContract{},
 		&MsgUnjailCadanceContract{},
+		&MsgConfirmCadanceContract{},
+		&MsgCreateSchedule{},
+		&MsgCancelSchedule{},
 		&MsgUpdateParams{},
+		&MsgForceRegisterCadanceContract{},
+		&MsgForceJailCadanceContract{},
+		&MsgForceUnregisterCadanceContract{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
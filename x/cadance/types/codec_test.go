@@ -23,11 +23,18 @@ func (suite *CodecTestSuite) TestRegisterInterfaces() {
 	RegisterInterfaces(registry)
 
 	impls := registry.ListImplementations(sdk.MsgInterfaceProtoName)
-	suite.Require().Equal(4, len(impls))
+	suite.Require().Equal(11, len(impls))
 	suite.Require().ElementsMatch([]string{
 		"/bitsong.cadance.v1.MsgUpdateParams",
 		"/bitsong.cadance.v1.MsgRegisterCadanceContract",
 		"/bitsong.cadance.v1.MsgUnregisterCadanceContract",
+		"/bitsong.cadance.v1.MsgUpdateCadanceContract",
 		"/bitsong.cadance.v1.MsgUnjailCadanceContract",
+		"/bitsong.cadance.v1.MsgConfirmCadanceContract",
+		"/bitsong.cadance.v1.MsgCreateSchedule",
+		"/bitsong.cadance.v1.MsgCancelSchedule",
+		"/bitsong.cadance.v1.MsgForceRegisterCadanceContract",
+		"/bitsong.cadance.v1.MsgForceJailCadanceContract",
+		"/bitsong.cadance.v1.MsgForceUnregisterCadanceContract",
 	}, impls)
 }
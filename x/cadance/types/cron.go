@@ -0,0 +1,166 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxCronSearchYears is the number of years searched for the next time of a cron expression
+const maxCronSearchYears = 5
+
+// CronSchedule is a parsed cron expression of five fields, minute, hour, day of the month, month
+// and day of the week, evaluated in UTC. Each field is a bitset of the matching values.
+type CronSchedule struct {
+	minute, hour, dom, month, dow uint64
+	// A day of the month or day of the week field given as "*" matches every day
+	domStar, dowStar bool
+}
+
+// cronField defines the bounds of a field of a cron expression
+type cronField struct {
+	name     string
+	min, max uint
+}
+
+var cronFields = []cronField{
+	{"minute", 0, 59},
+	{"hour", 0, 23},
+	{"day of month", 1, 31},
+	{"month", 1, 12},
+	{"day of week", 0, 6},
+}
+
+// ParseCron parses a cron expression made of five space separated fields: minute, hour, day of
+// the month, month and day of the week. Each field is a comma separated list of "*", values,
+// ranges "a-b" and steps "*/n" or "a-b/n".
+func ParseCron(expr string) (CronSchedule, error) {
+	fields := strings.Fields(expr)
+	if len(fields) != len(cronFields) {
+		return CronSchedule{}, ErrInvalidCron.Wrapf("expected %d fields, got %d", len(cronFields), len(fields))
+	}
+
+	bits := make([]uint64, len(cronFields))
+	for i, field := range fields {
+		b, err := parseCronField(field, cronFields[i])
+		if err != nil {
+			return CronSchedule{}, ErrInvalidCron.Wrapf("%s: %s", cronFields[i].name, err)
+		}
+		bits[i] = b
+	}
+
+	return CronSchedule{
+		minute:  bits[0],
+		hour:    bits[1],
+		dom:     bits[2],
+		month:   bits[3],
+		dow:     bits[4],
+		domStar: fields[2] == "*",
+		dowStar: fields[4] == "*",
+	}, nil
+}
+
+// parseCronField parses a field of a cron expression into the bitset of its values
+func parseCronField(field string, bounds cronField) (uint64, error) {
+	bits := uint64(0)
+	for _, part := range strings.Split(field, ",") {
+		rangeAndStep := strings.Split(part, "/")
+		if len(rangeAndStep) > 2 {
+			return 0, fmt.Errorf("invalid step in %q", part)
+		}
+
+		// Parse the range
+		start, end := bounds.min, bounds.max
+		if rangeAndStep[0] != "*" {
+			lowAndHigh := strings.Split(rangeAndStep[0], "-")
+			if len(lowAndHigh) > 2 {
+				return 0, fmt.Errorf("invalid range in %q", part)
+			}
+
+			var err error
+			if start, err = parseCronValue(lowAndHigh[0], bounds); err != nil {
+				return 0, err
+			}
+			end = start
+			if len(lowAndHigh) == 2 {
+				if end, err = parseCronValue(lowAndHigh[1], bounds); err != nil {
+					return 0, err
+				}
+			}
+		}
+		if start > end {
+			return 0, fmt.Errorf("invalid range in %q", part)
+		}
+
+		// Parse the step
+		step := uint(1)
+		if len(rangeAndStep) == 2 {
+			s, err := strconv.ParseUint(rangeAndStep[1], 10, 8)
+			if err != nil || s == 0 {
+				return 0, fmt.Errorf("invalid step in %q", part)
+			}
+			step = uint(s)
+
+			// A single value with a step runs from the value to the maximum
+			if rangeAndStep[0] != "*" && !strings.Contains(rangeAndStep[0], "-") {
+				end = bounds.max
+			}
+		}
+
+		for v := start; v <= end; v += step {
+			bits |= 1 << v
+		}
+	}
+
+	return bits, nil
+}
+
+// parseCronValue parses a single value of a cron field within its bounds
+func parseCronValue(value string, bounds cronField) (uint, error) {
+	v, err := strconv.ParseUint(value, 10, 8)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q", value)
+	}
+	if uint(v) < bounds.min || uint(v) > bounds.max {
+		return 0, fmt.Errorf("value %d out of range [%d, %d]", v, bounds.min, bounds.max)
+	}
+
+	return uint(v), nil
+}
+
+// Next returns the first time strictly after the given time matching the cron expression, or
+// the zero time when there is none within the next years.
+func (s CronSchedule) Next(t time.Time) time.Time {
+	t = t.UTC().Truncate(time.Minute).Add(time.Minute)
+	yearLimit := t.Year() + maxCronSearchYears
+
+	for t.Year() <= yearLimit {
+		switch {
+		case s.month&(1<<uint(t.Month())) == 0:
+			t = time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC).AddDate(0, 1, 0)
+		case !s.dayMatches(t):
+			t = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC).AddDate(0, 0, 1)
+		case s.hour&(1<<uint(t.Hour())) == 0:
+			t = t.Truncate(time.Hour).Add(time.Hour)
+		case s.minute&(1<<uint(t.Minute())) == 0:
+			t = t.Add(time.Minute)
+		default:
+			return t
+		}
+	}
+
+	return time.Time{}
+}
+
+// dayMatches returns true if the day of the given time matches the day of the month and the day
+// of the week fields. When both fields are restricted, matching either of them is enough.
+func (s CronSchedule) dayMatches(t time.Time) bool {
+	domMatch := s.dom&(1<<uint(t.Day())) != 0
+	dowMatch := s.dow&(1<<uint(t.Weekday())) != 0
+	if s.domStar || s.dowStar {
+		return domMatch && dowMatch
+	}
+
+	return domMatch || dowMatch
+}
@@ -0,0 +1,68 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bitsongofficial/go-bitsong/x/cadance/types"
+)
+
+func TestParseCron(t *testing.T) {
+	testCases := []struct {
+		name    string
+		expr    string
+		success bool
+	}{
+		{"Success - Every Minute", "* * * * *", true},
+		{"Success - Daily", "0 0 * * *", true},
+		{"Success - Lists, Ranges And Steps", "0,30 9-17/2 1-15 */3 1-5", true},
+		{"Fail - Missing Fields", "* * *", false},
+		{"Fail - Too Many Fields", "* * * * * *", false},
+		{"Fail - Value Out Of Range", "60 * * * *", false},
+		{"Fail - Day Of Month Zero", "* * 0 * *", false},
+		{"Fail - Reversed Range", "5-1 * * * *", false},
+		{"Fail - Zero Step", "*/0 * * * *", false},
+		{"Fail - Invalid Value", "a * * * *", false},
+	}
+
+	for _, tc := range testCases {
+		_, err := types.ParseCron(tc.expr)
+
+		if tc.success {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestCronScheduleNext(t *testing.T) {
+	date := func(year int, month time.Month, day, hour, minute int) time.Time {
+		return time.Date(year, month, day, hour, minute, 0, 0, time.UTC)
+	}
+
+	testCases := []struct {
+		name     string
+		expr     string
+		from     time.Time
+		expected time.Time
+	}{
+		{"Every Minute", "* * * * *", date(2024, 1, 1, 10, 30).Add(time.Second), date(2024, 1, 1, 10, 31)},
+		{"Strictly After", "30 10 * * *", date(2024, 1, 1, 10, 30), date(2024, 1, 2, 10, 30)},
+		{"Daily At Midnight", "0 0 * * *", date(2024, 1, 1, 10, 30), date(2024, 1, 2, 0, 0)},
+		{"Every Quarter Hour", "*/15 * * * *", date(2024, 1, 1, 10, 7), date(2024, 1, 1, 10, 15)},
+		{"Week Days", "30 9 * * 1-5", date(2024, 1, 6, 12, 0), date(2024, 1, 8, 9, 30)},
+		{"End Of Year", "0 0 1 1 *", date(2024, 12, 31, 23, 59), date(2025, 1, 1, 0, 0)},
+		{"Leap Day", "0 0 29 2 *", date(2024, 3, 1, 0, 0), date(2028, 2, 29, 0, 0)},
+		{"Day Of Month Or Day Of Week", "0 0 15 * 0", date(2024, 1, 8, 0, 0), date(2024, 1, 14, 0, 0)},
+		{"Never", "0 0 30 2 *", date(2024, 1, 1, 0, 0), time.Time{}},
+	}
+
+	for _, tc := range testCases {
+		cron, err := types.ParseCron(tc.expr)
+		require.NoError(t, err, tc.name)
+		require.Equal(t, tc.expected, cron.Next(tc.from), tc.name)
+	}
+}
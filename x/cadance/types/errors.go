@@ -8,4 +8,18 @@ var (
 	ErrContractJailed        = errorsmod.Register(ModuleName, 1, "contract is jailed")
 	ErrContractNotJailed     = errorsmod.Register(ModuleName, 2, "contract is not jailed")
 	ErrContractAlreadyJailed = errorsmod.Register(ModuleName, 3, "contract is already jailed")
+	ErrInvalidExecutionPhase = errorsmod.Register(ModuleName, 4, "invalid execution phase")
+	ErrInvalidStartHeight    = errorsmod.Register(ModuleName, 5, "invalid start height")
+	ErrMaxContractsReached   = errorsmod.Register(ModuleName, 6, "maximum number of registered contracts reached")
+	ErrInvalidGasLimit       = errorsmod.Register(ModuleName, 7, "invalid gas limit")
+	ErrInvalidSudoPayload    = errorsmod.Register(ModuleName, 8, "invalid sudo payload")
+	ErrInvalidSudoVersion    = errorsmod.Register(ModuleName, 9, "invalid sudo message version")
+	ErrInvalidCron           = errorsmod.Register(ModuleName, 10, "invalid cron expression")
+	ErrInvalidSchedule       = errorsmod.Register(ModuleName, 11, "invalid schedule")
+	ErrScheduleNotFound      = errorsmod.Register(ModuleName, 12, "schedule not found")
+	ErrNotScheduleCreator    = errorsmod.Register(ModuleName, 13, "sender is not the schedule creator")
+	ErrInvalidFeePayer       = errorsmod.Register(ModuleName, 14, "invalid fee payer")
+	ErrInsufficientGasFunds  = errorsmod.Register(ModuleName, 15, "insufficient funds to pay the execution gas")
+	ErrJailedByAuthority     = errorsmod.Register(ModuleName, 16, "contract is jailed by governance")
+	ErrInvalidPriority       = errorsmod.Register(ModuleName, 17, "invalid priority")
 )
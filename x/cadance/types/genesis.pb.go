@@ -4,8 +4,10 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -24,10 +26,48 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// PenaltyDestination defines where the jail penalty taken from the deposit of a contract goes.
+type PenaltyDestination int32
+
+const (
+	// The penalty is burned.
+	PenaltyDestinationBurn PenaltyDestination = 0
+	// The penalty is sent to the community pool.
+	PenaltyDestinationCommunityPool PenaltyDestination = 1
+)
+
+var PenaltyDestination_name = map[int32]string{
+	0: "PENALTY_DESTINATION_BURN",
+	1: "PENALTY_DESTINATION_COMMUNITY_POOL",
+}
+
+var PenaltyDestination_value = map[string]int32{
+	"PENALTY_DESTINATION_BURN":           0,
+	"PENALTY_DESTINATION_COMMUNITY_POOL": 1,
+}
+
+func (x PenaltyDestination) String() string {
+	return proto.EnumName(PenaltyDestination_name, int32(x))
+}
+
+func (PenaltyDestination) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b848209c12354efe, []int{0}
+}
+
 // GenesisState - initial state of module
 type GenesisState struct {
 	// Params of this module
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	// The registered contracts, with their execution settings and jail status.
+	Contracts []CadanceContract `protobuf:"bytes,2,rep,name=contracts,proto3" json:"contracts"`
+	// The id assigned to the next registration.
+	NextRegistrationId uint64 `protobuf:"varint,3,opt,name=next_registration_id,json=nextRegistrationId,proto3" json:"next_registration_id,omitempty"`
+	// The scheduled contract calls.
+	Schedules []Schedule `protobuf:"bytes,4,rep,name=schedules,proto3" json:"schedules"`
+	// The id assigned to the next schedule.
+	NextScheduleId uint64 `protobuf:"varint,5,opt,name=next_schedule_id,json=nextScheduleId,proto3" json:"next_schedule_id,omitempty"`
+	// The progress of the contract executions of every phase.
+	ExecutionStates []ExecutionState `protobuf:"bytes,6,rep,name=execution_states,json=executionStates,proto3" json:"execution_states"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -70,17 +110,155 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetContracts() []CadanceContract {
+	if m != nil {
+		return m.Contracts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextRegistrationId() uint64 {
+	if m != nil {
+		return m.NextRegistrationId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetSchedules() []Schedule {
+	if m != nil {
+		return m.Schedules
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextScheduleId() uint64 {
+	if m != nil {
+		return m.NextScheduleId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetExecutionStates() []ExecutionState {
+	if m != nil {
+		return m.ExecutionStates
+	}
+	return nil
+}
+
+// ExecutionState defines the progress of the contract executions of a phase, whose executions which
+// do not fit the block gas limit are deferred to the next block.
+type ExecutionState struct {
+	// The phase of the block.
+	Phase ExecutionPhase `protobuf:"varint,1,opt,name=phase,proto3,enum=bitsong.cadance.v1.ExecutionPhase" json:"phase,omitempty"`
+	// The contract from which the contracts of its priority are executed in the next execution of
+	// the phase, in address order when empty.
+	RoundRobinCursor string `protobuf:"bytes,2,opt,name=round_robin_cursor,json=roundRobinCursor,proto3" json:"round_robin_cursor,omitempty"`
+	// The contracts whose execution in the phase has been deferred.
+	PendingContracts []string `protobuf:"bytes,3,rep,name=pending_contracts,json=pendingContracts,proto3" json:"pending_contracts,omitempty"`
+}
+
+func (m *ExecutionState) Reset()         { *m = ExecutionState{} }
+func (m *ExecutionState) String() string { return proto.CompactTextString(m) }
+func (*ExecutionState) ProtoMessage()    {}
+func (*ExecutionState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b848209c12354efe, []int{1}
+}
+func (m *ExecutionState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecutionState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecutionState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExecutionState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecutionState.Merge(m, src)
+}
+func (m *ExecutionState) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecutionState) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecutionState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecutionState proto.InternalMessageInfo
+
+func (m *ExecutionState) GetPhase() ExecutionPhase {
+	if m != nil {
+		return m.Phase
+	}
+	return ExecutionPhaseEndBlock
+}
+
+func (m *ExecutionState) GetRoundRobinCursor() string {
+	if m != nil {
+		return m.RoundRobinCursor
+	}
+	return ""
+}
+
+func (m *ExecutionState) GetPendingContracts() []string {
+	if m != nil {
+		return m.PendingContracts
+	}
+	return nil
+}
+
 // Params defines the set of module parameters.
 type Params struct {
 	// contract_gas_limit defines the maximum amount of gas that can be used by a contract.
 	ContractGasLimit uint64 `protobuf:"varint,1,opt,name=contract_gas_limit,json=contractGasLimit,proto3" json:"contract_gas_limit,omitempty" yaml:"contract_gas_limit"`
+	// registration_deposit defines the deposit held by the module while a contract is registered.
+	RegistrationDeposit types.Coin `protobuf:"bytes,2,opt,name=registration_deposit,json=registrationDeposit,proto3" json:"registration_deposit" yaml:"registration_deposit"`
+	// jail_penalty defines the fraction of the deposit taken when a contract is jailed.
+	JailPenalty cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=jail_penalty,json=jailPenalty,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"jail_penalty" yaml:"jail_penalty"`
+	// jail_penalty_destination defines where the jail penalty goes.
+	JailPenaltyDestination PenaltyDestination `protobuf:"varint,4,opt,name=jail_penalty_destination,json=jailPenaltyDestination,proto3,enum=bitsong.cadance.v1.PenaltyDestination" json:"jail_penalty_destination,omitempty" yaml:"jail_penalty_destination"`
+	// max_contracts defines the maximum number of registered contracts, unlimited when zero.
+	MaxContracts uint64 `protobuf:"varint,5,opt,name=max_contracts,json=maxContracts,proto3" json:"max_contracts,omitempty" yaml:"max_contracts"`
+	// max_contract_gas_limit defines the maximum gas limit a contract can request.
+	MaxContractGasLimit uint64 `protobuf:"varint,6,opt,name=max_contract_gas_limit,json=maxContractGasLimit,proto3" json:"max_contract_gas_limit,omitempty" yaml:"max_contract_gas_limit"`
+	// block_gas_limit defines the maximum amount of gas that can be used by all the
+	// contracts and the schedules in a block, unlimited when zero.
+	BlockGasLimit uint64 `protobuf:"varint,7,opt,name=block_gas_limit,json=blockGasLimit,proto3" json:"block_gas_limit,omitempty" yaml:"block_gas_limit"`
+	// failure_threshold defines the number of consecutive failed executions after which a
+	// contract is jailed.
+	FailureThreshold uint64 `protobuf:"varint,8,opt,name=failure_threshold,json=failureThreshold,proto3" json:"failure_threshold,omitempty" yaml:"failure_threshold"`
+	// unjail_cooldown defines the number of blocks after which a jailed contract is unjailed
+	// automatically, disabled when zero. The cooldown doubles every time the contract is jailed
+	// again.
+	UnjailCooldown uint64 `protobuf:"varint,9,opt,name=unjail_cooldown,json=unjailCooldown,proto3" json:"unjail_cooldown,omitempty" yaml:"unjail_cooldown"`
+	// max_unjail_cooldown defines the maximum number of blocks the cooldown can grow to.
+	MaxUnjailCooldown uint64 `protobuf:"varint,10,opt,name=max_unjail_cooldown,json=maxUnjailCooldown,proto3" json:"max_unjail_cooldown,omitempty" yaml:"max_unjail_cooldown"`
+	// schedule_fee defines the fee paid to the community pool to create a schedule.
+	ScheduleFee types.Coin `protobuf:"bytes,11,opt,name=schedule_fee,json=scheduleFee,proto3" json:"schedule_fee" yaml:"schedule_fee"`
+	// max_schedules_per_block defines the maximum number of due schedules run in a
+	// block, the others are run in the next blocks. Unlimited when zero.
+	MaxSchedulesPerBlock uint64 `protobuf:"varint,12,opt,name=max_schedules_per_block,json=maxSchedulesPerBlock,proto3" json:"max_schedules_per_block,omitempty" yaml:"max_schedules_per_block"`
+	// gas_price defines the price of the gas used by the contract executions and the
+	// schedules, paid to the fee collector by the contract or its fee payer, or by the
+	// schedule creator. Disabled when zero.
+	GasPrice types.DecCoin `protobuf:"bytes,13,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price" yaml:"gas_price"`
+	// pause_on_lifecycle_change defines whether a contract whose code is migrated
+	// or whose admin changes is paused until its new manager confirms it.
+	PauseOnLifecycleChange bool `protobuf:"varint,14,opt,name=pause_on_lifecycle_change,json=pauseOnLifecycleChange,proto3" json:"pause_on_lifecycle_change,omitempty" yaml:"pause_on_lifecycle_change"`
+	// max_priority defines the highest priority a contract can be registered
+	// with by its manager, governance can register contracts with any priority.
+	MaxPriority uint32 `protobuf:"varint,15,opt,name=max_priority,json=maxPriority,proto3" json:"max_priority,omitempty" yaml:"max_priority"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b848209c12354efe, []int{1}
+	return fileDescriptor_b848209c12354efe, []int{2}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -116,35 +294,179 @@ func (m *Params) GetContractGasLimit() uint64 {
 	return 0
 }
 
+func (m *Params) GetRegistrationDeposit() types.Coin {
+	if m != nil {
+		return m.RegistrationDeposit
+	}
+	return types.Coin{}
+}
+
+func (m *Params) GetJailPenaltyDestination() PenaltyDestination {
+	if m != nil {
+		return m.JailPenaltyDestination
+	}
+	return PenaltyDestinationBurn
+}
+
+func (m *Params) GetMaxContracts() uint64 {
+	if m != nil {
+		return m.MaxContracts
+	}
+	return 0
+}
+
+func (m *Params) GetMaxContractGasLimit() uint64 {
+	if m != nil {
+		return m.MaxContractGasLimit
+	}
+	return 0
+}
+
+func (m *Params) GetBlockGasLimit() uint64 {
+	if m != nil {
+		return m.BlockGasLimit
+	}
+	return 0
+}
+
+func (m *Params) GetFailureThreshold() uint64 {
+	if m != nil {
+		return m.FailureThreshold
+	}
+	return 0
+}
+
+func (m *Params) GetUnjailCooldown() uint64 {
+	if m != nil {
+		return m.UnjailCooldown
+	}
+	return 0
+}
+
+func (m *Params) GetMaxUnjailCooldown() uint64 {
+	if m != nil {
+		return m.MaxUnjailCooldown
+	}
+	return 0
+}
+
+func (m *Params) GetScheduleFee() types.Coin {
+	if m != nil {
+		return m.ScheduleFee
+	}
+	return types.Coin{}
+}
+
+func (m *Params) GetMaxSchedulesPerBlock() uint64 {
+	if m != nil {
+		return m.MaxSchedulesPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetGasPrice() types.DecCoin {
+	if m != nil {
+		return m.GasPrice
+	}
+	return types.DecCoin{}
+}
+
+func (m *Params) GetPauseOnLifecycleChange() bool {
+	if m != nil {
+		return m.PauseOnLifecycleChange
+	}
+	return false
+}
+
+func (m *Params) GetMaxPriority() uint32 {
+	if m != nil {
+		return m.MaxPriority
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("bitsong.cadance.v1.PenaltyDestination", PenaltyDestination_name, PenaltyDestination_value)
 	proto.RegisterType((*GenesisState)(nil), "bitsong.cadance.v1.GenesisState")
+	proto.RegisterType((*ExecutionState)(nil), "bitsong.cadance.v1.ExecutionState")
 	proto.RegisterType((*Params)(nil), "bitsong.cadance.v1.Params")
 }
 
 func init() { proto.RegisterFile("bitsong/cadance/v1/genesis.proto", fileDescriptor_b848209c12354efe) }
 
 var fileDescriptor_b848209c12354efe = []byte{
-	// 307 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0xbf, 0x4a, 0x33, 0x41,
-	0x14, 0xc5, 0x77, 0xe1, 0x23, 0xc5, 0x7e, 0x16, 0x61, 0xb1, 0x88, 0x41, 0x66, 0xc3, 0x56, 0x16,
-	0x3a, 0x43, 0x14, 0x1b, 0xc1, 0x26, 0x4d, 0x9a, 0x80, 0x12, 0x3b, 0x11, 0xc2, 0xdd, 0x71, 0x32,
-	0x0e, 0x66, 0xf6, 0x2e, 0x99, 0x9b, 0x60, 0xde, 0xc2, 0xc7, 0x4a, 0x99, 0xd2, 0x6a, 0x91, 0xa4,
-	0x4b, 0xe9, 0x13, 0x48, 0xf6, 0x8f, 0x16, 0x6b, 0x37, 0x9c, 0xf3, 0x9b, 0x73, 0x66, 0x4e, 0xd0,
-	0x4b, 0x0c, 0x39, 0x4c, 0xb5, 0x90, 0xf0, 0x0c, 0xa9, 0x54, 0x62, 0xd9, 0x17, 0x5a, 0xa5, 0xca,
-	0x19, 0xc7, 0xb3, 0x39, 0x12, 0x86, 0x61, 0x45, 0xf0, 0x8a, 0xe0, 0xcb, 0x7e, 0xf7, 0x58, 0xa3,
-	0xc6, 0xc2, 0x16, 0x87, 0x53, 0x49, 0x76, 0x99, 0x44, 0x67, 0xd1, 0x89, 0x04, 0xdc, 0x21, 0x27,
-	0x51, 0x04, 0x7d, 0x21, 0xd1, 0xa4, 0x95, 0xff, 0x57, 0x57, 0x1d, 0x5a, 0x10, 0xf1, 0x53, 0x70,
-	0x34, 0x2c, 0xcb, 0x1f, 0x08, 0x48, 0x85, 0xa3, 0xa0, 0x95, 0xc1, 0x1c, 0xac, 0xeb, 0xf8, 0x3d,
-	0xff, 0xec, 0xff, 0x65, 0x97, 0x37, 0x1f, 0xc3, 0xef, 0x0b, 0x62, 0xd0, 0x59, 0xe7, 0x91, 0xb7,
-	0xcf, 0xa3, 0x76, 0x79, 0xe3, 0x1c, 0xad, 0x21, 0x65, 0x33, 0x5a, 0x8d, 0xab, 0x8c, 0x78, 0x11,
-	0xb4, 0x4a, 0x36, 0x7c, 0x0d, 0x42, 0x89, 0x29, 0xcd, 0x41, 0xd2, 0x44, 0x83, 0x9b, 0xcc, 0x8c,
-	0x35, 0x54, 0x74, 0xfc, 0x1b, 0xdc, 0xee, 0xf3, 0xe8, 0xb4, 0xe9, 0xfe, 0xe6, 0x7d, 0xe5, 0xd1,
-	0xc9, 0x0a, 0xec, 0xec, 0x26, 0x6e, 0x52, 0xf1, 0xb8, 0x5d, 0x8b, 0x43, 0x70, 0xa3, 0x83, 0x34,
-	0xb8, 0x5b, 0x6f, 0x99, 0xbf, 0xd9, 0x32, 0xff, 0x73, 0xcb, 0xfc, 0xf7, 0x1d, 0xf3, 0x36, 0x3b,
-	0xe6, 0x7d, 0xec, 0x98, 0xf7, 0x78, 0xad, 0x0d, 0xbd, 0x2c, 0x12, 0x2e, 0xd1, 0x8a, 0xea, 0x63,
-	0x38, 0x9d, 0x1a, 0x69, 0x60, 0x26, 0x34, 0x5e, 0xd4, 0x73, 0xbd, 0xfd, 0x0c, 0x46, 0xab, 0x4c,
-	0xb9, 0xa4, 0x55, 0x8c, 0x75, 0xf5, 0x1d, 0x00, 0x00, 0xff, 0xff, 0x08, 0xee, 0x11, 0xe4, 0xbc,
-	0x01, 0x00, 0x00,
+	// 1132 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
+	0x17, 0x15, 0x63, 0xc5, 0x9f, 0x35, 0xf2, 0x8f, 0x3c, 0x36, 0x1c, 0x5a, 0xf6, 0x27, 0x2a, 0x74,
+	0x51, 0x08, 0x6d, 0x22, 0xd5, 0x2e, 0x0a, 0x04, 0x01, 0x02, 0x34, 0x94, 0x5c, 0x57, 0xa8, 0x22,
+	0x09, 0xb4, 0x5d, 0xc0, 0xdd, 0x10, 0x23, 0x72, 0x4c, 0x4d, 0x4d, 0x72, 0x54, 0x0e, 0x95, 0x4a,
+	0x0f, 0x50, 0xa0, 0xc8, 0xaa, 0xcb, 0x6e, 0xb2, 0x28, 0x8a, 0xbe, 0x41, 0x1f, 0xa0, 0xcb, 0x2c,
+	0x83, 0xae, 0x8a, 0x2e, 0x88, 0xc2, 0xde, 0x65, 0xc9, 0x27, 0x28, 0x38, 0x24, 0x25, 0xda, 0x62,
+	0x91, 0x9d, 0x78, 0xce, 0xb9, 0xe7, 0x5c, 0xce, 0xdc, 0x4b, 0x08, 0x54, 0x07, 0xc4, 0x63, 0xd4,
+	0x31, 0x1b, 0x3a, 0x32, 0x90, 0xa3, 0xe3, 0xc6, 0xcb, 0xc3, 0x86, 0x89, 0x1d, 0xcc, 0x08, 0xab,
+	0x8f, 0x5c, 0xea, 0x51, 0x08, 0x63, 0x45, 0x3d, 0x56, 0xd4, 0x5f, 0x1e, 0x96, 0xb7, 0x4d, 0x6a,
+	0x52, 0x4e, 0x37, 0xc2, 0x5f, 0x91, 0xb2, 0xbc, 0xab, 0x53, 0x66, 0x53, 0xa6, 0x45, 0x44, 0xf4,
+	0x10, 0x53, 0x95, 0xe8, 0xa9, 0x31, 0x40, 0x2c, 0x8c, 0x18, 0x60, 0x0f, 0x1d, 0x36, 0x74, 0x4a,
+	0x9c, 0x98, 0xcf, 0x6a, 0x23, 0xc9, 0x8b, 0x14, 0x0f, 0x33, 0x14, 0x4c, 0x1f, 0x62, 0x63, 0x6c,
+	0xc5, 0x12, 0xf9, 0xe7, 0x25, 0xb0, 0x7a, 0x12, 0xf5, 0x7e, 0xea, 0x21, 0x0f, 0xc3, 0x0e, 0x58,
+	0x1e, 0x21, 0x17, 0xd9, 0x4c, 0x14, 0xaa, 0x42, 0xad, 0x78, 0x54, 0xae, 0x2f, 0xbe, 0x4b, 0xbd,
+	0xcf, 0x15, 0x8a, 0xf8, 0xc6, 0x97, 0x72, 0xef, 0x7c, 0xa9, 0x14, 0x55, 0x3c, 0xa2, 0x36, 0xf1,
+	0xb0, 0x3d, 0xf2, 0xa6, 0x6a, 0xec, 0x01, 0x4f, 0x40, 0x41, 0xa7, 0x8e, 0xe7, 0x22, 0xdd, 0x63,
+	0xe2, 0xbd, 0xea, 0x52, 0xad, 0x78, 0x74, 0x90, 0x65, 0xd8, 0x8c, 0x7e, 0x36, 0x63, 0xad, 0x92,
+	0x0f, 0x9d, 0xd5, 0x79, 0x2d, 0xfc, 0x04, 0x6c, 0x3b, 0x78, 0xe2, 0x69, 0x2e, 0x36, 0x09, 0xf3,
+	0x5c, 0xe4, 0x11, 0xea, 0x68, 0xc4, 0x10, 0x97, 0xaa, 0x42, 0x2d, 0xaf, 0xc2, 0x90, 0x53, 0x53,
+	0x54, 0xdb, 0x80, 0x9f, 0x83, 0x42, 0xf2, 0xae, 0x4c, 0xcc, 0xf3, 0xe8, 0xfd, 0xac, 0xe8, 0xd3,
+	0x58, 0x94, 0x64, 0xce, 0x8a, 0x60, 0x0d, 0x94, 0x78, 0x66, 0x82, 0x84, 0x79, 0xf7, 0x79, 0xde,
+	0x7a, 0x88, 0x27, 0x85, 0x6d, 0x03, 0x9e, 0x82, 0x12, 0x9e, 0x60, 0x7d, 0xcc, 0xbb, 0x62, 0xe1,
+	0x39, 0x32, 0x71, 0x99, 0x47, 0xca, 0x59, 0x91, 0xc7, 0x89, 0x96, 0x1f, 0x79, 0x1c, 0xbc, 0x81,
+	0x6f, 0xa1, 0x4c, 0xfe, 0x4d, 0x00, 0xeb, 0xb7, 0x95, 0xf0, 0x09, 0xb8, 0x3f, 0x1a, 0x22, 0x86,
+	0xf9, 0xdd, 0xac, 0xbf, 0xc7, 0xbc, 0x1f, 0x2a, 0xd5, 0xa8, 0x00, 0x3e, 0x02, 0xd0, 0xa5, 0x63,
+	0xc7, 0xd0, 0x5c, 0x3a, 0x20, 0x8e, 0xa6, 0x8f, 0x5d, 0x46, 0x5d, 0xf1, 0x5e, 0x55, 0xa8, 0x15,
+	0xd4, 0x12, 0x67, 0xd4, 0x90, 0x68, 0x72, 0x1c, 0x7e, 0x0c, 0x36, 0x47, 0xd8, 0x31, 0x88, 0x63,
+	0x6a, 0xf3, 0xeb, 0x5b, 0xaa, 0x2e, 0x85, 0xe2, 0x98, 0x48, 0xae, 0x8a, 0xc9, 0x7f, 0x00, 0xb0,
+	0x1c, 0x0d, 0x04, 0xbc, 0x02, 0x30, 0xd1, 0x6b, 0x26, 0x62, 0x9a, 0x45, 0x6c, 0xe2, 0xf1, 0x66,
+	0xf3, 0xca, 0xb3, 0x77, 0xbe, 0xb4, 0xbf, 0xc8, 0xce, 0x87, 0x26, 0xf0, 0xa5, 0xdd, 0x29, 0xb2,
+	0xad, 0xa7, 0xf2, 0xa2, 0x4a, 0x56, 0x4b, 0x09, 0x78, 0x82, 0x58, 0x27, 0x84, 0xe0, 0x77, 0x60,
+	0xfb, 0xd6, 0x34, 0x18, 0x78, 0x44, 0x19, 0xf1, 0xf8, 0x4b, 0x15, 0x8f, 0x76, 0xeb, 0xf1, 0x32,
+	0x85, 0xeb, 0x53, 0x8f, 0xd7, 0xa7, 0xde, 0xa4, 0xc4, 0x51, 0x0e, 0xc2, 0xf3, 0x0e, 0x7c, 0x69,
+	0x2f, 0x4a, 0xcb, 0x32, 0x91, 0xd5, 0xad, 0x34, 0xdc, 0x8a, 0x50, 0x78, 0x05, 0x56, 0xbf, 0x45,
+	0xc4, 0xd2, 0x46, 0xd8, 0x41, 0x96, 0x37, 0xe5, 0xd3, 0x57, 0x50, 0xbe, 0x0c, 0xfd, 0xfe, 0xf6,
+	0xa5, 0xbd, 0x28, 0x91, 0x19, 0x57, 0x75, 0x42, 0x1b, 0x36, 0xf2, 0x86, 0xf5, 0x0e, 0x36, 0x91,
+	0x3e, 0x6d, 0x61, 0x3d, 0xf0, 0xa5, 0xad, 0x28, 0x2e, 0x6d, 0x20, 0xff, 0xf9, 0xfb, 0x63, 0x10,
+	0xf7, 0xd9, 0xc2, 0xba, 0x5a, 0x0c, 0xc9, 0x7e, 0xc4, 0xc1, 0x1f, 0x04, 0x20, 0xa6, 0xc5, 0x9a,
+	0x81, 0x99, 0x47, 0x1c, 0xde, 0x90, 0x98, 0xe7, 0x03, 0xf0, 0x61, 0xe6, 0x72, 0x46, 0xf2, 0xd6,
+	0x5c, 0xad, 0x1c, 0x04, 0xbe, 0x24, 0x2d, 0xc6, 0xa7, 0x1d, 0x65, 0x75, 0x27, 0x15, 0x9e, 0x2a,
+	0x86, 0xcf, 0xc0, 0x9a, 0x8d, 0x26, 0xa9, 0x41, 0xe0, 0x3b, 0xa0, 0x88, 0x81, 0x2f, 0x6d, 0x47,
+	0x9e, 0xb7, 0x68, 0x59, 0x5d, 0xb5, 0xd1, 0x64, 0x36, 0x1e, 0xf0, 0x6b, 0xb0, 0x93, 0xe6, 0x53,
+	0x73, 0xb1, 0xcc, 0x7d, 0x1e, 0x06, 0xbe, 0xf4, 0xff, 0x45, 0x9f, 0xf4, 0xdd, 0x6f, 0xa5, 0x0c,
+	0x67, 0xd7, 0xaf, 0x80, 0x8d, 0x81, 0x45, 0xf5, 0xab, 0x94, 0xe1, 0xff, 0xb8, 0x61, 0x39, 0xf0,
+	0xa5, 0x9d, 0xc8, 0xf0, 0x8e, 0x40, 0x56, 0xd7, 0x38, 0x32, 0xf3, 0x68, 0x83, 0xcd, 0x4b, 0x44,
+	0xac, 0xb1, 0x8b, 0x35, 0x6f, 0xe8, 0x62, 0x36, 0xa4, 0x96, 0x21, 0xae, 0x70, 0x97, 0xfd, 0xc0,
+	0x97, 0xc4, 0xc8, 0x65, 0x41, 0x22, 0xab, 0xa5, 0x18, 0x3b, 0x4b, 0x20, 0xd8, 0x04, 0x1b, 0x63,
+	0x87, 0x1f, 0xae, 0x4e, 0xa9, 0x65, 0xd0, 0xef, 0x1d, 0xb1, 0x70, 0xb7, 0x9d, 0x3b, 0x02, 0x59,
+	0x5d, 0x8f, 0x90, 0x66, 0x0c, 0xc0, 0x2e, 0x08, 0x5f, 0x55, 0xbb, 0x6b, 0x04, 0xb8, 0x51, 0x25,
+	0xf0, 0xa5, 0xf2, 0xfc, 0xa0, 0x16, 0xcc, 0x36, 0x6d, 0x34, 0x39, 0xbf, 0xed, 0x77, 0x01, 0x56,
+	0x67, 0x1f, 0xaf, 0x4b, 0x8c, 0xc5, 0xe2, 0xfb, 0x56, 0x63, 0x2f, 0x5e, 0x8d, 0x78, 0x56, 0xd3,
+	0xc5, 0xb2, 0x5a, 0x4c, 0x1e, 0xbf, 0xc0, 0x18, 0x5e, 0x80, 0x07, 0x61, 0x17, 0x09, 0xc4, 0xb4,
+	0x11, 0x76, 0x35, 0x7e, 0xba, 0xe2, 0x2a, 0x6f, 0x57, 0x0e, 0x7c, 0xa9, 0x32, 0x6f, 0x37, 0x43,
+	0x28, 0xab, 0xdb, 0x36, 0x9a, 0x24, 0x9f, 0x51, 0xd6, 0xc7, 0xae, 0x12, 0xc2, 0xf0, 0x14, 0x14,
+	0xc2, 0x2b, 0x1b, 0xb9, 0x44, 0xc7, 0xe2, 0x1a, 0x6f, 0x79, 0x3f, 0xb3, 0xe5, 0x16, 0xd6, 0x79,
+	0xd7, 0x62, 0xdc, 0x75, 0x29, 0x8a, 0x9b, 0x15, 0xcb, 0xea, 0x8a, 0x89, 0x58, 0x3f, 0xfc, 0x09,
+	0x35, 0xb0, 0x3b, 0x42, 0x63, 0x86, 0x35, 0xea, 0x68, 0x16, 0xb9, 0xc4, 0xfa, 0x54, 0xb7, 0xb0,
+	0xa6, 0x0f, 0x91, 0x63, 0x62, 0x71, 0xbd, 0x2a, 0xd4, 0x56, 0x94, 0x0f, 0x02, 0x5f, 0xaa, 0x46,
+	0x16, 0xff, 0x29, 0x95, 0xd5, 0x1d, 0xce, 0xf5, 0x9c, 0x4e, 0xc2, 0x34, 0x39, 0x01, 0x9f, 0x82,
+	0x70, 0xee, 0xc3, 0x60, 0xea, 0x12, 0x6f, 0x2a, 0x6e, 0x54, 0x85, 0xda, 0x9a, 0xf2, 0x60, 0x7e,
+	0x98, 0x69, 0x56, 0x56, 0x8b, 0x36, 0x9a, 0xf4, 0xe3, 0xa7, 0x8f, 0x7e, 0x11, 0x00, 0xcc, 0xd8,
+	0xbc, 0x27, 0x40, 0xec, 0x1f, 0x77, 0x9f, 0x77, 0xce, 0x2e, 0xb4, 0xd6, 0xf1, 0xe9, 0x59, 0xbb,
+	0xfb, 0xfc, 0xac, 0xdd, 0xeb, 0x6a, 0xca, 0xb9, 0xda, 0x2d, 0xe5, 0xca, 0xe5, 0x57, 0xaf, 0xab,
+	0x3b, 0x19, 0xcb, 0x3e, 0x76, 0x1d, 0xf8, 0x15, 0x90, 0xb3, 0x2a, 0x9b, 0xbd, 0x17, 0x2f, 0xce,
+	0xbb, 0xed, 0xb3, 0x0b, 0xad, 0xdf, 0xeb, 0x75, 0x4a, 0x42, 0xf9, 0xe0, 0xd5, 0xeb, 0xaa, 0xb4,
+	0xe8, 0xd1, 0xa4, 0xb6, 0x3d, 0x76, 0x88, 0x37, 0xed, 0x53, 0x6a, 0x95, 0xf3, 0x3f, 0xfe, 0x5a,
+	0xc9, 0x29, 0xbd, 0x37, 0xd7, 0x15, 0xe1, 0xed, 0x75, 0x45, 0xf8, 0xe7, 0xba, 0x22, 0xfc, 0x74,
+	0x53, 0xc9, 0xbd, 0xbd, 0xa9, 0xe4, 0xfe, 0xba, 0xa9, 0xe4, 0xbe, 0xf9, 0xcc, 0x24, 0xde, 0x70,
+	0x3c, 0xa8, 0xeb, 0xd4, 0x6e, 0xc4, 0xdf, 0x23, 0x7a, 0x79, 0x49, 0x74, 0x82, 0xac, 0x86, 0x49,
+	0x1f, 0xc7, 0x50, 0x63, 0x32, 0xfb, 0x1b, 0xe2, 0x4d, 0x47, 0x98, 0x0d, 0x96, 0xf9, 0x3f, 0x90,
+	0x4f, 0xff, 0x1d, 0x00, 0xa8, 0x08, 0x65, 0xfd, 0x4f, 0x09, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -167,6 +489,58 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ExecutionStates) > 0 {
+		for iNdEx := len(m.ExecutionStates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExecutionStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.NextScheduleId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextScheduleId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Schedules) > 0 {
+		for iNdEx := len(m.Schedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Schedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.NextRegistrationId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextRegistrationId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Contracts) > 0 {
+		for iNdEx := len(m.Contracts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Contracts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -180,6 +554,50 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ExecutionState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecutionState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecutionState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PendingContracts) > 0 {
+		for iNdEx := len(m.PendingContracts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PendingContracts[iNdEx])
+			copy(dAtA[i:], m.PendingContracts[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.PendingContracts[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.RoundRobinCursor) > 0 {
+		i -= len(m.RoundRobinCursor)
+		copy(dAtA[i:], m.RoundRobinCursor)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.RoundRobinCursor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Phase != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Phase))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -200,6 +618,101 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxPriority != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxPriority))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.PauseOnLifecycleChange {
+		i--
+		if m.PauseOnLifecycleChange {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
+	{
+		size, err := m.GasPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	if m.MaxSchedulesPerBlock != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxSchedulesPerBlock))
+		i--
+		dAtA[i] = 0x60
+	}
+	{
+		size, err := m.ScheduleFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if m.MaxUnjailCooldown != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxUnjailCooldown))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.UnjailCooldown != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.UnjailCooldown))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.FailureThreshold != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.FailureThreshold))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.BlockGasLimit != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockGasLimit))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxContractGasLimit != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxContractGasLimit))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxContracts != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxContracts))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.JailPenaltyDestination != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.JailPenaltyDestination))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.JailPenalty.Size()
+		i -= size
+		if _, err := m.JailPenalty.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.RegistrationDeposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	if m.ContractGasLimit != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.ContractGasLimit))
 		i--
@@ -227,34 +740,118 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Contracts) > 0 {
+		for _, e := range m.Contracts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextRegistrationId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextRegistrationId))
+	}
+	if len(m.Schedules) > 0 {
+		for _, e := range m.Schedules {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextScheduleId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextScheduleId))
+	}
+	if len(m.ExecutionStates) > 0 {
+		for _, e := range m.ExecutionStates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *Params) Size() (n int) {
+func (m *ExecutionState) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.ContractGasLimit != 0 {
-		n += 1 + sovGenesis(uint64(m.ContractGasLimit))
+	if m.Phase != 0 {
+		n += 1 + sovGenesis(uint64(m.Phase))
+	}
+	l = len(m.RoundRobinCursor)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.PendingContracts) > 0 {
+		for _, s := range m.PendingContracts {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
 	}
 	return n
 }
 
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ContractGasLimit != 0 {
+		n += 1 + sovGenesis(uint64(m.ContractGasLimit))
+	}
+	l = m.RegistrationDeposit.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.JailPenalty.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.JailPenaltyDestination != 0 {
+		n += 1 + sovGenesis(uint64(m.JailPenaltyDestination))
+	}
+	if m.MaxContracts != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxContracts))
+	}
+	if m.MaxContractGasLimit != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxContractGasLimit))
+	}
+	if m.BlockGasLimit != 0 {
+		n += 1 + sovGenesis(uint64(m.BlockGasLimit))
+	}
+	if m.FailureThreshold != 0 {
+		n += 1 + sovGenesis(uint64(m.FailureThreshold))
+	}
+	if m.UnjailCooldown != 0 {
+		n += 1 + sovGenesis(uint64(m.UnjailCooldown))
+	}
+	if m.MaxUnjailCooldown != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxUnjailCooldown))
+	}
+	l = m.ScheduleFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.MaxSchedulesPerBlock != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxSchedulesPerBlock))
+	}
+	l = m.GasPrice.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.PauseOnLifecycleChange {
+		n += 2
+	}
+	if m.MaxPriority != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxPriority))
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
 				return ErrIntOverflowGenesis
 			}
@@ -310,6 +907,146 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contracts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contracts = append(m.Contracts, CadanceContract{})
+			if err := m.Contracts[len(m.Contracts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRegistrationId", wireType)
+			}
+			m.NextRegistrationId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextRegistrationId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schedules = append(m.Schedules, Schedule{})
+			if err := m.Schedules[len(m.Schedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextScheduleId", wireType)
+			}
+			m.NextScheduleId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextScheduleId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionStates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecutionStates = append(m.ExecutionStates, ExecutionState{})
+			if err := m.ExecutionStates[len(m.ExecutionStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -331,7 +1068,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *ExecutionState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -354,17 +1091,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExecutionState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExecutionState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractGasLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
-			m.ContractGasLimit = 0
+			m.Phase = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -374,7 +1111,464 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ContractGasLimit |= uint64(b&0x7F) << shift
+				m.Phase |= ExecutionPhase(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoundRobinCursor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoundRobinCursor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingContracts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingContracts = append(m.PendingContracts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractGasLimit", wireType)
+			}
+			m.ContractGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RegistrationDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailPenalty", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.JailPenalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailPenaltyDestination", wireType)
+			}
+			m.JailPenaltyDestination = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailPenaltyDestination |= PenaltyDestination(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxContracts", wireType)
+			}
+			m.MaxContracts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxContracts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxContractGasLimit", wireType)
+			}
+			m.MaxContractGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxContractGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockGasLimit", wireType)
+			}
+			m.BlockGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureThreshold", wireType)
+			}
+			m.FailureThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailureThreshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnjailCooldown", wireType)
+			}
+			m.UnjailCooldown = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnjailCooldown |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUnjailCooldown", wireType)
+			}
+			m.MaxUnjailCooldown = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxUnjailCooldown |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ScheduleFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSchedulesPerBlock", wireType)
+			}
+			m.MaxSchedulesPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSchedulesPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseOnLifecycleChange", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PauseOnLifecycleChange = bool(v != 0)
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriority", wireType)
+			}
+			m.MaxPriority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPriority |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
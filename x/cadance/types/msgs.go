@@ -7,23 +7,38 @@ import (
 )
 
 const (
-	// Sudo Message called on the contracts
-	EndBlockSudoMessage = `{"cadance_end_block":{}}`
+	// Sudo Messages called on the contracts
+	BeginBlockSudoMessage = `{"cadance_begin_block":{}}`
+	EndBlockSudoMessage   = `{"cadance_end_block":{}}`
 )
 
 // == MsgUpdateParams ==
 const (
 	TypeMsgRegisterCadanceContract   = "register_cadance_contract"
 	TypeMsgUnregisterCadanceContract = "unregister_cadance_contract"
+	TypeMsgUpdateCadanceContract     = "update_cadance_contract"
 	TypeMsgUnjailCadanceContract     = "unjail_cadance_contract"
+	TypeMsgConfirmCadanceContract    = "confirm_cadance_contract"
+	TypeMsgCreateSchedule            = "create_schedule"
+	TypeMsgCancelSchedule            = "cancel_schedule"
 	TypeMsgUpdateParams              = "update_cadance_params"
+	TypeMsgForceRegisterContract     = "force_register_cadance_contract"
+	TypeMsgForceJailContract         = "force_jail_cadance_contract"
+	TypeMsgForceUnregisterContract   = "force_unregister_cadance_contract"
 )
 
 var (
 	_ sdk.Msg = &MsgRegisterCadanceContract{}
 	_ sdk.Msg = &MsgUnregisterCadanceContract{}
+	_ sdk.Msg = &MsgUpdateCadanceContract{}
 	_ sdk.Msg = &MsgUnjailCadanceContract{}
+	_ sdk.Msg = &MsgConfirmCadanceContract{}
+	_ sdk.Msg = &MsgCreateSchedule{}
+	_ sdk.Msg = &MsgCancelSchedule{}
 	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgForceRegisterCadanceContract{}
+	_ sdk.Msg = &MsgForceJailCadanceContract{}
+	_ sdk.Msg = &MsgForceUnregisterCadanceContract{}
 )
 
 // Route returns the name of the module
@@ -34,7 +49,23 @@ func (msg MsgRegisterCadanceContract) Type() string { return TypeMsgRegisterCada
 
 // ValidateBasic runs stateless checks on the message
 func (msg MsgRegisterCadanceContract) ValidateBasic() error {
-	return validateAddresses(msg.SenderAddress, msg.ContractAddress)
+	if err := validateAddresses(msg.SenderAddress, msg.ContractAddress); err != nil {
+		return err
+	}
+
+	if err := msg.Phase.Validate(); err != nil {
+		return err
+	}
+
+	if err := ValidateSudoMessage(msg.SudoMessageVersion, msg.SudoPayload); err != nil {
+		return err
+	}
+
+	if err := ValidateFeePayer(msg.FeePayer, msg.SenderAddress); err != nil {
+		return err
+	}
+
+	return ValidateStartHeight(msg.StartHeight)
 }
 
 // GetSignBytes encodes the message for signing
@@ -70,6 +101,40 @@ func (msg MsgUnregisterCadanceContract) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{from}
 }
 
+// Route returns the name of the module
+func (msg MsgUpdateCadanceContract) Route() string { return RouterKey }
+
+// Type returns the the action
+func (msg MsgUpdateCadanceContract) Type() string { return TypeMsgUpdateCadanceContract }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgUpdateCadanceContract) ValidateBasic() error {
+	if err := validateAddresses(msg.SenderAddress, msg.ContractAddress); err != nil {
+		return err
+	}
+
+	if err := ValidateSudoMessage(msg.SudoMessageVersion, msg.SudoPayload); err != nil {
+		return err
+	}
+
+	if err := ValidateFeePayer(msg.FeePayer, msg.SenderAddress); err != nil {
+		return err
+	}
+
+	return ValidateStartHeight(msg.StartHeight)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgUpdateCadanceContract) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgUpdateCadanceContract) GetSigners() []sdk.AccAddress {
+	from, _ := sdk.AccAddressFromBech32(msg.SenderAddress)
+	return []sdk.AccAddress{from}
+}
+
 // Route returns the name of the module
 func (msg MsgUnjailCadanceContract) Route() string { return RouterKey }
 
@@ -92,14 +157,92 @@ func (msg MsgUnjailCadanceContract) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{from}
 }
 
+// Route returns the name of the module
+func (msg MsgConfirmCadanceContract) Route() string { return RouterKey }
+
+// Type returns the the action
+func (msg MsgConfirmCadanceContract) Type() string { return TypeMsgConfirmCadanceContract }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgConfirmCadanceContract) ValidateBasic() error {
+	return validateAddresses(msg.SenderAddress, msg.ContractAddress)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgConfirmCadanceContract) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgConfirmCadanceContract) GetSigners() []sdk.AccAddress {
+	from, _ := sdk.AccAddressFromBech32(msg.SenderAddress)
+	return []sdk.AccAddress{from}
+}
+
+// Route returns the name of the module
+func (msg MsgCreateSchedule) Route() string { return RouterKey }
+
+// Type returns the the action
+func (msg MsgCreateSchedule) Type() string { return TypeMsgCreateSchedule }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgCreateSchedule) ValidateBasic() error {
+	if err := validateAddresses(msg.Creator, msg.ContractAddress); err != nil {
+		return err
+	}
+
+	if err := msg.CallType.Validate(); err != nil {
+		return err
+	}
+
+	if err := ValidateScheduleMsg(msg.Msg); err != nil {
+		return err
+	}
+
+	return ValidateScheduleTrigger(msg.RunAtHeight, msg.RunAtTime, msg.Cron)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgCreateSchedule) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgCreateSchedule) GetSigners() []sdk.AccAddress {
+	from, _ := sdk.AccAddressFromBech32(msg.Creator)
+	return []sdk.AccAddress{from}
+}
+
+// Route returns the name of the module
+func (msg MsgCancelSchedule) Route() string { return RouterKey }
+
+// Type returns the the action
+func (msg MsgCancelSchedule) Type() string { return TypeMsgCancelSchedule }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgCancelSchedule) ValidateBasic() error {
+	return validateAddresses(msg.Creator)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgCancelSchedule) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgCancelSchedule) GetSigners() []sdk.AccAddress {
+	from, _ := sdk.AccAddressFromBech32(msg.Creator)
+	return []sdk.AccAddress{from}
+}
+
 // NewMsgUpdateParams creates new instance of MsgUpdateParams
 func NewMsgUpdateParams(
 	sender sdk.Address,
-	contractGasLimit uint64,
+	params Params,
 ) *MsgUpdateParams {
 	return &MsgUpdateParams{
 		Authority: sender.String(),
-		Params:    NewParams(contractGasLimit),
+		Params:    params,
 	}
 }
 
@@ -129,6 +272,96 @@ func (msg *MsgUpdateParams) ValidateBasic() error {
 	return msg.Params.Validate()
 }
 
+// Route returns the name of the module
+func (msg MsgForceRegisterCadanceContract) Route() string { return RouterKey }
+
+// Type returns the the action
+func (msg MsgForceRegisterCadanceContract) Type() string { return TypeMsgForceRegisterContract }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgForceRegisterCadanceContract) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgForceRegisterCadanceContract message.
+func (msg *MsgForceRegisterCadanceContract) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg *MsgForceRegisterCadanceContract) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errors.Wrap(err, "invalid authority address")
+	}
+
+	if err := validateAddresses(msg.ContractAddress); err != nil {
+		return err
+	}
+
+	if err := msg.Phase.Validate(); err != nil {
+		return err
+	}
+
+	if err := ValidateSudoMessage(msg.SudoMessageVersion, msg.SudoPayload); err != nil {
+		return err
+	}
+
+	return ValidateStartHeight(msg.StartHeight)
+}
+
+// Route returns the name of the module
+func (msg MsgForceJailCadanceContract) Route() string { return RouterKey }
+
+// Type returns the the action
+func (msg MsgForceJailCadanceContract) Type() string { return TypeMsgForceJailContract }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgForceJailCadanceContract) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgForceJailCadanceContract message.
+func (msg *MsgForceJailCadanceContract) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg *MsgForceJailCadanceContract) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errors.Wrap(err, "invalid authority address")
+	}
+
+	return validateAddresses(msg.ContractAddress)
+}
+
+// Route returns the name of the module
+func (msg MsgForceUnregisterCadanceContract) Route() string { return RouterKey }
+
+// Type returns the the action
+func (msg MsgForceUnregisterCadanceContract) Type() string { return TypeMsgForceUnregisterContract }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgForceUnregisterCadanceContract) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgForceUnregisterCadanceContract message.
+func (msg *MsgForceUnregisterCadanceContract) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg *MsgForceUnregisterCadanceContract) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errors.Wrap(err, "invalid authority address")
+	}
+
+	return validateAddresses(msg.ContractAddress)
+}
+
 // ValidateAddresses validates the provided addresses
 func validateAddresses(addresses ...string) error {
 	for _, address := range addresses {
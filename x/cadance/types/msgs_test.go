@@ -22,18 +22,17 @@ func (suite *MsgsTestSuite) SetupTest() {
 }
 
 func (suite *MsgsTestSuite) TestMsgUpdateParams() {
-	var limit uint64 = 100_000
+	params := DefaultParams()
+	params.ContractGasLimit = 100_000
 
 	p := MsgUpdateParams{
 		Authority: suite.govModule,
-		Params: Params{
-			ContractGasLimit: limit,
-		},
+		Params:    params,
 	}
 
 	acc, _ := sdk.AccAddressFromBech32(p.Authority)
 
-	msg := NewMsgUpdateParams(acc, limit)
+	msg := NewMsgUpdateParams(acc, params)
 
 	suite.Require().Equal(RouterKey, msg.Route())
 	suite.Require().Equal(TypeMsgUpdateParams, msg.Type())
@@ -2,23 +2,70 @@ package types
 
 import (
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
-// DefaultParams returns default parameters
+// DefaultParams returns default parameters. The gas price is zero so that the contracts registered
+// before the gas was billed, which hold no funds to pay it, are not all jailed at the first block.
+// Until governance sets a price, the scheduled calls are paid up front with the schedule fee, and
+// bounded by the maximum number of schedules run in a block and by the block gas limit.
 func DefaultParams() Params {
 	return Params{
-		ContractGasLimit: 100_000,
+		ContractGasLimit:       100_000,
+		RegistrationDeposit:    sdk.NewCoin(sdk.DefaultBondDenom, math.ZeroInt()),
+		JailPenalty:            math.LegacyZeroDec(),
+		JailPenaltyDestination: PenaltyDestinationBurn,
+		MaxContracts:           0,
+		MaxContractGasLimit:    1_000_000,
+		BlockGasLimit:          10_000_000,
+		FailureThreshold:       1,
+		UnjailCooldown:         0,
+		MaxUnjailCooldown:      0,
+		ScheduleFee:            sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(1_000_000)),
+		MaxSchedulesPerBlock:   10,
+		GasPrice:               sdk.NewDecCoin(sdk.DefaultBondDenom, math.ZeroInt()),
+		PauseOnLifecycleChange: false,
+		MaxPriority:            10,
 	}
 }
 
 // NewParams creates a new Params object
 func NewParams(
 	contractGasLimit uint64,
+	registrationDeposit sdk.Coin,
+	jailPenalty math.LegacyDec,
+	jailPenaltyDestination PenaltyDestination,
+	maxContracts uint64,
+	maxContractGasLimit uint64,
+	blockGasLimit uint64,
+	failureThreshold uint64,
+	unjailCooldown uint64,
+	maxUnjailCooldown uint64,
+	scheduleFee sdk.Coin,
+	maxSchedulesPerBlock uint64,
+	gasPrice sdk.DecCoin,
+	pauseOnLifecycleChange bool,
+	maxPriority uint32,
 ) Params {
 	return Params{
-		ContractGasLimit: contractGasLimit,
+		ContractGasLimit:       contractGasLimit,
+		RegistrationDeposit:    registrationDeposit,
+		JailPenalty:            jailPenalty,
+		JailPenaltyDestination: jailPenaltyDestination,
+		MaxContracts:           maxContracts,
+		MaxContractGasLimit:    maxContractGasLimit,
+		BlockGasLimit:          blockGasLimit,
+		FailureThreshold:       failureThreshold,
+		UnjailCooldown:         unjailCooldown,
+		MaxUnjailCooldown:      maxUnjailCooldown,
+		ScheduleFee:            scheduleFee,
+		MaxSchedulesPerBlock:   maxSchedulesPerBlock,
+		GasPrice:               gasPrice,
+		PauseOnLifecycleChange: pauseOnLifecycleChange,
+		MaxPriority:            maxPriority,
 	}
 }
 
@@ -32,5 +79,89 @@ func (p Params) Validate() error {
 		)
 	}
 
+	if err := p.RegistrationDeposit.Validate(); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid registration deposit: %s", err)
+	}
+
+	if p.JailPenalty.IsNil() || p.JailPenalty.IsNegative() || p.JailPenalty.GT(math.LegacyOneDec()) {
+		return errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"invalid jail penalty: %s. Must be between 0 and 1", p.JailPenalty,
+		)
+	}
+
+	if _, ok := PenaltyDestination_name[int32(p.JailPenaltyDestination)]; !ok {
+		return errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"invalid jail penalty destination: %d", p.JailPenaltyDestination,
+		)
+	}
+
+	if p.MaxContractGasLimit < p.ContractGasLimit {
+		return errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"invalid max contract gas limit: %d. Must be above the contract gas limit %d", p.MaxContractGasLimit, p.ContractGasLimit,
+		)
+	}
+
+	if p.BlockGasLimit != 0 && p.BlockGasLimit < p.MaxContractGasLimit {
+		return errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"invalid block gas limit: %d. Must be zero or above the max contract gas limit %d", p.BlockGasLimit, p.MaxContractGasLimit,
+		)
+	}
+
+	if p.FailureThreshold == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "invalid failure threshold: 0. Must be above 0")
+	}
+
+	if p.UnjailCooldown != 0 && p.MaxUnjailCooldown < p.UnjailCooldown {
+		return errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"invalid max unjail cooldown: %d. Must be above the unjail cooldown %d", p.MaxUnjailCooldown, p.UnjailCooldown,
+		)
+	}
+
+	if err := p.ScheduleFee.Validate(); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid schedule fee: %s", err)
+	}
+
+	if p.GasPrice.Amount.IsNil() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "invalid gas price: nil amount")
+	}
+	if err := p.GasPrice.Validate(); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid gas price: %s", err)
+	}
+
 	return nil
 }
+
+// Get the number of blocks a contract jailed the given number of times stays jailed before it is
+// unjailed automatically. The cooldown doubles for every jail after the first one, up to the max
+// unjail cooldown, and is zero when the automatic unjail is disabled.
+func (p Params) JailCooldown(jailCount uint64) uint64 {
+	if p.UnjailCooldown == 0 {
+		return 0
+	}
+
+	cooldown := min(p.UnjailCooldown, p.MaxUnjailCooldown)
+	for i := uint64(1); i < jailCount; i++ {
+		if cooldown > p.MaxUnjailCooldown/2 {
+			return p.MaxUnjailCooldown
+		}
+		cooldown *= 2
+	}
+
+	return cooldown
+}
+
+// Get the fee paid for the given amount of gas used by a contract execution, rounded up. The fee
+// is zero when the gas price is zero.
+func (p Params) GasFee(gasUsed uint64) sdk.Coin {
+	if p.GasPrice.Amount.IsNil() || !p.GasPrice.IsPositive() {
+		return sdk.NewCoin(p.GasPrice.Denom, math.ZeroInt())
+	}
+
+	amount := p.GasPrice.Amount.MulInt(math.NewIntFromUint64(gasUsed)).Ceil().TruncateInt()
+	return sdk.NewCoin(p.GasPrice.Denom, amount)
+}
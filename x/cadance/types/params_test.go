@@ -5,10 +5,18 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/bitsongofficial/go-bitsong/x/cadance/types"
 )
 
 func TestParamsValidate(t *testing.T) {
+	deposit := sdk.NewCoin("ubtsg", math.NewInt(1_000_000))
+	penalty := math.LegacyNewDecWithPrec(5, 1)
+	gasPrice := sdk.NewDecCoinFromDec("ubtsg", math.LegacyNewDecWithPrec(25, 3))
+
 	testCases := []struct {
 		name    string
 		params  types.Params
@@ -21,32 +29,97 @@ func TestParamsValidate(t *testing.T) {
 		},
 		{
 			"Success - Meets min Gas",
-			types.NewParams(100_000),
+			types.NewParams(100_000, deposit, penalty, types.PenaltyDestinationBurn, 0, 1_000_000, 0, 1, 0, 0, deposit, 0, gasPrice, false, 10),
 			true,
 		},
 		{
 			"Success - Meets min Gas",
-			types.NewParams(500_000),
+			types.NewParams(500_000, deposit, penalty, types.PenaltyDestinationBurn, 0, 1_000_000, 0, 1, 0, 0, deposit, 0, gasPrice, false, 10),
 			true,
 		},
+		{
+			"Success - Full Penalty To Community Pool",
+			types.NewParams(100_000, deposit, math.LegacyOneDec(), types.PenaltyDestinationCommunityPool, 100, 1_000_000, 10_000_000, 1, 0, 0, deposit, 0, gasPrice, false, 10),
+			true,
+		},
+		{
+			"Success - Failure Threshold And Unjail Cooldown",
+			types.NewParams(100_000, deposit, penalty, types.PenaltyDestinationBurn, 0, 1_000_000, 0, 3, 100, 1_000, deposit, 0, gasPrice, false, 10),
+			true,
+		},
+		{
+			"Fail - Invalid Schedule Fee",
+			types.NewParams(100_000, deposit, penalty, types.PenaltyDestinationBurn, 0, 1_000_000, 0, 1, 0, 0, sdk.Coin{Denom: "stake", Amount: math.NewInt(-1)}, 0, gasPrice, false, 10),
+			false,
+		},
+		{
+			"Fail - Zero Failure Threshold",
+			types.NewParams(100_000, deposit, penalty, types.PenaltyDestinationBurn, 0, 1_000_000, 0, 0, 0, 0, deposit, 0, gasPrice, false, 10),
+			false,
+		},
+		{
+			"Fail - Max Unjail Cooldown Below Unjail Cooldown",
+			types.NewParams(100_000, deposit, penalty, types.PenaltyDestinationBurn, 0, 1_000_000, 0, 1, 100, 50, deposit, 0, gasPrice, false, 10),
+			false,
+		},
+		{
+			"Fail - Invalid Deposit Denom",
+			types.NewParams(100_000, sdk.Coin{Denom: "1", Amount: math.OneInt()}, penalty, types.PenaltyDestinationBurn, 0, 1_000_000, 0, 1, 0, 0, deposit, 0, gasPrice, false, 10),
+			false,
+		},
+		{
+			"Fail - Penalty Above One",
+			types.NewParams(100_000, deposit, math.LegacyNewDec(2), types.PenaltyDestinationBurn, 0, 1_000_000, 0, 1, 0, 0, deposit, 0, gasPrice, false, 10),
+			false,
+		},
+		{
+			"Fail - Negative Penalty",
+			types.NewParams(100_000, deposit, math.LegacyNewDec(-1), types.PenaltyDestinationBurn, 0, 1_000_000, 0, 1, 0, 0, deposit, 0, gasPrice, false, 10),
+			false,
+		},
+		{
+			"Fail - Invalid Penalty Destination",
+			types.NewParams(100_000, deposit, penalty, types.PenaltyDestination(2), 0, 1_000_000, 0, 1, 0, 0, deposit, 0, gasPrice, false, 10),
+			false,
+		},
+		{
+			"Fail - Max Contract Gas Limit Below Contract Gas Limit",
+			types.NewParams(500_000, deposit, penalty, types.PenaltyDestinationBurn, 0, 400_000, 0, 1, 0, 0, deposit, 0, gasPrice, false, 10),
+			false,
+		},
+		{
+			"Fail - Block Gas Limit Below Max Contract Gas Limit",
+			types.NewParams(100_000, deposit, penalty, types.PenaltyDestinationBurn, 0, 1_000_000, 500_000, 1, 0, 0, deposit, 0, gasPrice, false, 10),
+			false,
+		},
+		{
+			"Fail - Negative Gas Price",
+			types.NewParams(100_000, deposit, penalty, types.PenaltyDestinationBurn, 0, 1_000_000, 0, 1, 0, 0, deposit, 0, sdk.DecCoin{Denom: "ubtsg", Amount: math.LegacyNewDec(-1)}, false, 10),
+			false,
+		},
+		{
+			"Fail - Nil Gas Price",
+			types.NewParams(100_000, deposit, penalty, types.PenaltyDestinationBurn, 0, 1_000_000, 0, 1, 0, 0, deposit, 0, sdk.DecCoin{Denom: "ubtsg"}, false, 10),
+			false,
+		},
 		{
 			"Fail - Not Enough Gas",
-			types.NewParams(1),
+			types.NewParams(1, deposit, penalty, types.PenaltyDestinationBurn, 0, 1_000_000, 0, 1, 0, 0, deposit, 0, gasPrice, false, 10),
 			false,
 		},
 		{
 			"Fail - Not Enough Gas",
-			types.NewParams(100),
+			types.NewParams(100, deposit, penalty, types.PenaltyDestinationBurn, 0, 1_000_000, 0, 1, 0, 0, deposit, 0, gasPrice, false, 10),
 			false,
 		},
 		{
 			"Fail - Not Enough Gas",
-			types.NewParams(1_000),
+			types.NewParams(1_000, deposit, penalty, types.PenaltyDestinationBurn, 0, 1_000_000, 0, 1, 0, 0, deposit, 0, gasPrice, false, 10),
 			false,
 		},
 		{
 			"Fail - Not Enough Gas",
-			types.NewParams(10_000),
+			types.NewParams(10_000, deposit, penalty, types.PenaltyDestinationBurn, 0, 1_000_000, 0, 1, 0, 0, deposit, 0, gasPrice, false, 10),
 			false,
 		},
 	}
@@ -61,3 +134,38 @@ func TestParamsValidate(t *testing.T) {
 		}
 	}
 }
+
+func TestParamsJailCooldown(t *testing.T) {
+	params := types.DefaultParams()
+
+	// Disabled automatic unjail
+	require.Equal(t, uint64(0), params.JailCooldown(1))
+	require.Equal(t, uint64(0), params.JailCooldown(5))
+
+	// The cooldown doubles for every jail, up to the max unjail cooldown
+	params.UnjailCooldown = 100
+	params.MaxUnjailCooldown = 1_000
+	require.Equal(t, uint64(100), params.JailCooldown(0))
+	require.Equal(t, uint64(100), params.JailCooldown(1))
+	require.Equal(t, uint64(200), params.JailCooldown(2))
+	require.Equal(t, uint64(800), params.JailCooldown(4))
+	require.Equal(t, uint64(1_000), params.JailCooldown(5))
+	require.Equal(t, uint64(1_000), params.JailCooldown(1_000))
+
+	// The cooldown does not overflow
+	params.MaxUnjailCooldown = ^uint64(0)
+	require.Equal(t, ^uint64(0), params.JailCooldown(100))
+}
+
+func TestParamsGasFee(t *testing.T) {
+	params := types.DefaultParams()
+
+	// Disabled billing
+	require.True(t, params.GasFee(100_000).IsZero())
+
+	// The fee is rounded up
+	params.GasPrice = sdk.NewDecCoinFromDec("ubtsg", math.LegacyNewDecWithPrec(25, 3))
+	require.Equal(t, sdk.NewInt64Coin("ubtsg", 2_500), params.GasFee(100_000))
+	require.Equal(t, sdk.NewInt64Coin("ubtsg", 1), params.GasFee(1))
+	require.True(t, params.GasFee(0).IsZero())
+}
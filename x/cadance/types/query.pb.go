@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	types "github.com/cometbft/cometbft/abci/types"
 	_ "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -31,6 +32,42 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// SimulationResult defines the outcome of a simulated contract execution.
+type SimulationResult int32
+
+const (
+	// The execution succeeded.
+	SimulationResultSuccess SimulationResult = 0
+	// The execution returned an error.
+	SimulationResultError SimulationResult = 1
+	// The execution ran out of gas.
+	SimulationResultOutOfGas SimulationResult = 2
+	// The execution panicked.
+	SimulationResultPanic SimulationResult = 3
+)
+
+var SimulationResult_name = map[int32]string{
+	0: "SIMULATION_RESULT_SUCCESS",
+	1: "SIMULATION_RESULT_ERROR",
+	2: "SIMULATION_RESULT_OUT_OF_GAS",
+	3: "SIMULATION_RESULT_PANIC",
+}
+
+var SimulationResult_value = map[string]int32{
+	"SIMULATION_RESULT_SUCCESS":    0,
+	"SIMULATION_RESULT_ERROR":      1,
+	"SIMULATION_RESULT_OUT_OF_GAS": 2,
+	"SIMULATION_RESULT_PANIC":      3,
+}
+
+func (x SimulationResult) String() string {
+	return proto.EnumName(SimulationResult_name, int32(x))
+}
+
+func (SimulationResult) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9e04f5f91761cd4d, []int{0}
+}
+
 // QueryCadanceContracts is the request type to get all contracts.
 type QueryCadanceContracts struct {
 	// pagination defines an optional pagination for the request.
@@ -224,6 +261,378 @@ func (m *QueryCadanceContractResponse) GetCadanceContract() CadanceContract {
 	return CadanceContract{}
 }
 
+// QuerySchedulesRequest is the request type to get all schedules.
+type QuerySchedulesRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySchedulesRequest) Reset()         { *m = QuerySchedulesRequest{} }
+func (m *QuerySchedulesRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySchedulesRequest) ProtoMessage()    {}
+func (*QuerySchedulesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e04f5f91761cd4d, []int{4}
+}
+func (m *QuerySchedulesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySchedulesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySchedulesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySchedulesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySchedulesRequest.Merge(m, src)
+}
+func (m *QuerySchedulesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySchedulesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySchedulesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySchedulesRequest proto.InternalMessageInfo
+
+func (m *QuerySchedulesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QuerySchedulesResponse is the response type for the Query/Schedules RPC method.
+type QuerySchedulesResponse struct {
+	// schedules are the scheduled contract calls.
+	Schedules []Schedule `protobuf:"bytes,1,rep,name=schedules,proto3" json:"schedules"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySchedulesResponse) Reset()         { *m = QuerySchedulesResponse{} }
+func (m *QuerySchedulesResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySchedulesResponse) ProtoMessage()    {}
+func (*QuerySchedulesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e04f5f91761cd4d, []int{5}
+}
+func (m *QuerySchedulesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySchedulesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySchedulesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySchedulesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySchedulesResponse.Merge(m, src)
+}
+func (m *QuerySchedulesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySchedulesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySchedulesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySchedulesResponse proto.InternalMessageInfo
+
+func (m *QuerySchedulesResponse) GetSchedules() []Schedule {
+	if m != nil {
+		return m.Schedules
+	}
+	return nil
+}
+
+func (m *QuerySchedulesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryScheduleRequest is the request type to get a single schedule.
+type QueryScheduleRequest struct {
+	// id is the id of the schedule to query.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryScheduleRequest) Reset()         { *m = QueryScheduleRequest{} }
+func (m *QueryScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduleRequest) ProtoMessage()    {}
+func (*QueryScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e04f5f91761cd4d, []int{6}
+}
+func (m *QueryScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduleRequest.Merge(m, src)
+}
+func (m *QueryScheduleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScheduleRequest proto.InternalMessageInfo
+
+func (m *QueryScheduleRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// QueryScheduleResponse is the response type for the Query/Schedule RPC method.
+type QueryScheduleResponse struct {
+	// schedule is the scheduled contract call.
+	Schedule Schedule `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule"`
+}
+
+func (m *QueryScheduleResponse) Reset()         { *m = QueryScheduleResponse{} }
+func (m *QueryScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduleResponse) ProtoMessage()    {}
+func (*QueryScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e04f5f91761cd4d, []int{7}
+}
+func (m *QueryScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduleResponse.Merge(m, src)
+}
+func (m *QueryScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScheduleResponse proto.InternalMessageInfo
+
+func (m *QueryScheduleResponse) GetSchedule() Schedule {
+	if m != nil {
+		return m.Schedule
+	}
+	return Schedule{}
+}
+
+// QuerySimulateCadanceRequest is the request type to simulate the execution of
+// a contract. A registered contract is simulated with its registered settings,
+// any other contract with the settings of the request.
+type QuerySimulateCadanceRequest struct {
+	// contract_address is the address of the contract to simulate.
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// phase is the phase of the block whose sudo message is sent, the end block
+	// message is sent for the begin and end block phase.
+	Phase ExecutionPhase `protobuf:"varint,2,opt,name=phase,proto3,enum=bitsong.cadance.v1.ExecutionPhase" json:"phase,omitempty"`
+	// gas_limit is the gas limit of the execution, the gas limit of the contract
+	// is used when zero.
+	GasLimit uint64 `protobuf:"varint,3,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// sudo_payload is the custom payload of an unregistered contract.
+	SudoPayload string `protobuf:"bytes,4,opt,name=sudo_payload,json=sudoPayload,proto3" json:"sudo_payload,omitempty"`
+	// sudo_message_version is the sudo message version of an unregistered
+	// contract.
+	SudoMessageVersion SudoMessageVersion `protobuf:"varint,5,opt,name=sudo_message_version,json=sudoMessageVersion,proto3,enum=bitsong.cadance.v1.SudoMessageVersion" json:"sudo_message_version,omitempty"`
+}
+
+func (m *QuerySimulateCadanceRequest) Reset()         { *m = QuerySimulateCadanceRequest{} }
+func (m *QuerySimulateCadanceRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateCadanceRequest) ProtoMessage()    {}
+func (*QuerySimulateCadanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e04f5f91761cd4d, []int{8}
+}
+func (m *QuerySimulateCadanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateCadanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateCadanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimulateCadanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateCadanceRequest.Merge(m, src)
+}
+func (m *QuerySimulateCadanceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateCadanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateCadanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateCadanceRequest proto.InternalMessageInfo
+
+func (m *QuerySimulateCadanceRequest) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *QuerySimulateCadanceRequest) GetPhase() ExecutionPhase {
+	if m != nil {
+		return m.Phase
+	}
+	return ExecutionPhaseEndBlock
+}
+
+func (m *QuerySimulateCadanceRequest) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+func (m *QuerySimulateCadanceRequest) GetSudoPayload() string {
+	if m != nil {
+		return m.SudoPayload
+	}
+	return ""
+}
+
+func (m *QuerySimulateCadanceRequest) GetSudoMessageVersion() SudoMessageVersion {
+	if m != nil {
+		return m.SudoMessageVersion
+	}
+	return SudoMessageVersionV1
+}
+
+// QuerySimulateCadanceResponse is the response type for the
+// Query/SimulateCadance RPC method.
+type QuerySimulateCadanceResponse struct {
+	// result is the outcome of the execution.
+	Result SimulationResult `protobuf:"varint,1,opt,name=result,proto3,enum=bitsong.cadance.v1.SimulationResult" json:"result,omitempty"`
+	// gas_used is the gas used by the execution.
+	GasUsed uint64 `protobuf:"varint,2,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// gas_limit is the gas limit of the execution.
+	GasLimit uint64 `protobuf:"varint,3,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// error is the error of a failed execution.
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	// sudo_message is the sudo message sent to the contract.
+	SudoMessage string `protobuf:"bytes,5,opt,name=sudo_message,json=sudoMessage,proto3" json:"sudo_message,omitempty"`
+	// events are the events emitted by the execution.
+	Events []types.Event `protobuf:"bytes,6,rep,name=events,proto3" json:"events"`
+}
+
+func (m *QuerySimulateCadanceResponse) Reset()         { *m = QuerySimulateCadanceResponse{} }
+func (m *QuerySimulateCadanceResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateCadanceResponse) ProtoMessage()    {}
+func (*QuerySimulateCadanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e04f5f91761cd4d, []int{9}
+}
+func (m *QuerySimulateCadanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateCadanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateCadanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimulateCadanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateCadanceResponse.Merge(m, src)
+}
+func (m *QuerySimulateCadanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateCadanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateCadanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateCadanceResponse proto.InternalMessageInfo
+
+func (m *QuerySimulateCadanceResponse) GetResult() SimulationResult {
+	if m != nil {
+		return m.Result
+	}
+	return SimulationResultSuccess
+}
+
+func (m *QuerySimulateCadanceResponse) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *QuerySimulateCadanceResponse) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+func (m *QuerySimulateCadanceResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *QuerySimulateCadanceResponse) GetSudoMessage() string {
+	if m != nil {
+		return m.SudoMessage
+	}
+	return ""
+}
+
+func (m *QuerySimulateCadanceResponse) GetEvents() []types.Event {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
 // QueryParams is the request type to get all module params.
 type QueryParamsRequest struct {
 }
@@ -232,7 +641,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9e04f5f91761cd4d, []int{4}
+	return fileDescriptor_9e04f5f91761cd4d, []int{10}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -270,7 +679,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9e04f5f91761cd4d, []int{5}
+	return fileDescriptor_9e04f5f91761cd4d, []int{11}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -307,10 +716,17 @@ func (m *QueryParamsResponse) GetParams() *Params {
 }
 
 func init() {
+	proto.RegisterEnum("bitsong.cadance.v1.SimulationResult", SimulationResult_name, SimulationResult_value)
 	proto.RegisterType((*QueryCadanceContracts)(nil), "bitsong.cadance.v1.QueryCadanceContracts")
 	proto.RegisterType((*QueryCadanceContractsResponse)(nil), "bitsong.cadance.v1.QueryCadanceContractsResponse")
 	proto.RegisterType((*QueryCadanceContract)(nil), "bitsong.cadance.v1.QueryCadanceContract")
 	proto.RegisterType((*QueryCadanceContractResponse)(nil), "bitsong.cadance.v1.QueryCadanceContractResponse")
+	proto.RegisterType((*QuerySchedulesRequest)(nil), "bitsong.cadance.v1.QuerySchedulesRequest")
+	proto.RegisterType((*QuerySchedulesResponse)(nil), "bitsong.cadance.v1.QuerySchedulesResponse")
+	proto.RegisterType((*QueryScheduleRequest)(nil), "bitsong.cadance.v1.QueryScheduleRequest")
+	proto.RegisterType((*QueryScheduleResponse)(nil), "bitsong.cadance.v1.QueryScheduleResponse")
+	proto.RegisterType((*QuerySimulateCadanceRequest)(nil), "bitsong.cadance.v1.QuerySimulateCadanceRequest")
+	proto.RegisterType((*QuerySimulateCadanceResponse)(nil), "bitsong.cadance.v1.QuerySimulateCadanceResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "bitsong.cadance.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "bitsong.cadance.v1.QueryParamsResponse")
 }
@@ -318,42 +734,76 @@ func init() {
 func init() { proto.RegisterFile("bitsong/cadance/v1/query.proto", fileDescriptor_9e04f5f91761cd4d) }
 
 var fileDescriptor_9e04f5f91761cd4d = []byte{
-	// 559 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xcf, 0x6a, 0x13, 0x41,
-	0x1c, 0xc7, 0xb3, 0xad, 0x06, 0x9c, 0x22, 0x89, 0x63, 0x84, 0xb2, 0xa6, 0x9b, 0xb0, 0x62, 0x9b,
-	0x0a, 0xce, 0x34, 0x11, 0x41, 0xbc, 0x35, 0x05, 0x3d, 0xb6, 0x2e, 0xe2, 0xc1, 0x4b, 0x98, 0xdd,
-	0x4c, 0xc6, 0x85, 0x64, 0x67, 0xbb, 0x33, 0x09, 0x06, 0xe9, 0xc5, 0x27, 0x10, 0x3c, 0xf8, 0x08,
-	0xbe, 0x86, 0xc7, 0xe2, 0xa9, 0xe0, 0xc5, 0x53, 0x90, 0xc4, 0x93, 0x47, 0x9f, 0x40, 0x32, 0x33,
-	0x1b, 0x9b, 0xed, 0xda, 0xc6, 0xdb, 0xe4, 0xf7, 0xf7, 0xf3, 0xfd, 0xce, 0x64, 0x81, 0xe3, 0x87,
-	0x52, 0xf0, 0x88, 0xe1, 0x80, 0x74, 0x49, 0x14, 0x50, 0x3c, 0x6a, 0xe2, 0xe3, 0x21, 0x4d, 0xc6,
-	0x28, 0x4e, 0xb8, 0xe4, 0x10, 0x9a, 0x3c, 0x32, 0x79, 0x34, 0x6a, 0xda, 0x0f, 0x02, 0x2e, 0x06,
-	0x5c, 0x60, 0x9f, 0x08, 0xaa, 0x8b, 0xf1, 0xa8, 0xe9, 0x53, 0x49, 0x9a, 0x38, 0x26, 0x2c, 0x8c,
-	0x88, 0x0c, 0x79, 0xa4, 0xfb, 0xed, 0x0a, 0xe3, 0x8c, 0xab, 0x23, 0x9e, 0x9f, 0x4c, 0xb4, 0xca,
-	0x38, 0x67, 0x7d, 0x8a, 0x49, 0x1c, 0x62, 0x12, 0x45, 0x5c, 0xaa, 0x16, 0x61, 0xb2, 0xce, 0xf9,
-	0xf9, 0xe9, 0xe4, 0x80, 0x87, 0xe9, 0xcc, 0x7a, 0x0e, 0x33, 0xa3, 0x11, 0x15, 0xa1, 0xb8, 0xa4,
-	0x22, 0x15, 0xa0, 0x2a, 0xdc, 0x0e, 0xb8, 0xf3, 0x62, 0x4e, 0x7e, 0xa0, 0xa3, 0x07, 0x3c, 0x92,
-	0x09, 0x09, 0xa4, 0x80, 0xcf, 0x00, 0xf8, 0x2b, 0x62, 0xd3, 0xaa, 0x5b, 0x8d, 0x8d, 0xd6, 0x36,
-	0xd2, 0x44, 0x68, 0x4e, 0x84, 0xb4, 0x3d, 0x86, 0x0b, 0x1d, 0x11, 0x46, 0x3d, 0x7a, 0x3c, 0xa4,
-	0x42, 0x7a, 0xe7, 0x3a, 0xdd, 0x2f, 0x16, 0xd8, 0xca, 0xdd, 0xe0, 0x51, 0x11, 0xf3, 0x48, 0x50,
-	0xf8, 0x0a, 0xdc, 0x32, 0x4c, 0x9d, 0x20, 0x4d, 0x6e, 0x5a, 0xf5, 0xf5, 0xc6, 0x46, 0xeb, 0x1e,
-	0xba, 0x68, 0x3b, 0xca, 0x0c, 0x6a, 0x5f, 0x3b, 0x9d, 0xd4, 0x0a, 0x5e, 0x39, 0xc8, 0x2a, 0x78,
-	0xbe, 0xa4, 0x60, 0x4d, 0x29, 0xd8, 0xb9, 0x52, 0x81, 0x86, 0x5a, 0x92, 0xb0, 0x0f, 0x2a, 0x79,
-	0x0a, 0xe0, 0x2e, 0x28, 0xa7, 0xc0, 0x1d, 0xd2, 0xed, 0x26, 0x54, 0x08, 0x65, 0xd4, 0x0d, 0xaf,
-	0x94, 0xc6, 0xf7, 0x75, 0xd8, 0x95, 0xa0, 0x9a, 0x37, 0x62, 0xe1, 0xc1, 0x4b, 0x50, 0xce, 0x7a,
-	0x60, 0x3c, 0xff, 0x0f, 0x0b, 0x4a, 0x19, 0x0b, 0xdc, 0x0a, 0x80, 0x6a, 0xeb, 0x11, 0x49, 0xc8,
-	0x40, 0x98, 0xdb, 0x71, 0x7b, 0xe0, 0xf6, 0x52, 0xd4, 0x20, 0x1c, 0x82, 0x62, 0xac, 0x22, 0x66,
-	0xb1, 0x9d, 0xb7, 0x58, 0xf7, 0xb4, 0xef, 0xfe, 0x9a, 0xd4, 0x4c, 0xf5, 0xef, 0x49, 0xed, 0xe6,
-	0x98, 0x0c, 0xfa, 0x4f, 0x5d, 0xfd, 0xdb, 0xf5, 0x4c, 0xa2, 0xf5, 0x75, 0x1d, 0x5c, 0x57, 0x8b,
-	0xe0, 0x27, 0x0b, 0x94, 0x2f, 0x3c, 0xb0, 0xdd, 0xbc, 0xf9, 0xb9, 0x2f, 0xc5, 0x6e, 0xae, 0x5c,
-	0x9a, 0xaa, 0x71, 0xef, 0xbf, 0xff, 0xf6, 0xf3, 0xe3, 0x5a, 0x0d, 0x6e, 0xe1, 0xbc, 0xbf, 0xc0,
-	0x02, 0xe2, 0xb3, 0x05, 0x4a, 0xd9, 0x6b, 0x6d, 0xac, 0xba, 0xcd, 0xde, 0x5b, 0xb5, 0x72, 0x81,
-	0xf5, 0x44, 0x61, 0xb5, 0xe0, 0xde, 0xa5, 0x58, 0xf8, 0x5d, 0xf6, 0x5d, 0x9d, 0xc0, 0x13, 0x50,
-	0xd4, 0xe6, 0xc3, 0xed, 0x7f, 0x6e, 0x5d, 0xba, 0x67, 0x7b, 0xe7, 0xca, 0x3a, 0x03, 0xe5, 0x2a,
-	0xa8, 0x2a, 0xb4, 0xf3, 0xa0, 0xf4, 0x65, 0xb6, 0x0f, 0x4f, 0xa7, 0x8e, 0x75, 0x36, 0x75, 0xac,
-	0x1f, 0x53, 0xc7, 0xfa, 0x30, 0x73, 0x0a, 0x67, 0x33, 0xa7, 0xf0, 0x7d, 0xe6, 0x14, 0x5e, 0x3f,
-	0x66, 0xa1, 0x7c, 0x33, 0xf4, 0x51, 0xc0, 0x07, 0x69, 0x3f, 0xef, 0xf5, 0xc2, 0x20, 0x24, 0x7d,
-	0xcc, 0xf8, 0xc3, 0x74, 0xe4, 0xdb, 0xc5, 0x50, 0x39, 0x8e, 0xa9, 0xf0, 0x8b, 0xea, 0xfb, 0xf3,
-	0xe8, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xba, 0xba, 0xe9, 0xd8, 0x79, 0x05, 0x00, 0x00,
+	// 1095 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
+	0x18, 0xf5, 0x3a, 0x89, 0x9b, 0x4c, 0x20, 0x31, 0x43, 0xda, 0x3a, 0x1b, 0xd7, 0x71, 0x97, 0x92,
+	0x26, 0x91, 0xd8, 0x8d, 0xcd, 0xaf, 0xaa, 0x42, 0x15, 0x89, 0xe5, 0x46, 0x91, 0xd2, 0xda, 0xac,
+	0xe3, 0x82, 0xb8, 0xac, 0xc6, 0xbb, 0x93, 0xcd, 0x4a, 0xf6, 0x8e, 0xbb, 0xb3, 0x6b, 0x35, 0xaa,
+	0x72, 0x01, 0x0e, 0x10, 0x2e, 0x48, 0x20, 0x71, 0x21, 0xa7, 0x1e, 0x38, 0xf1, 0x3f, 0x70, 0xec,
+	0xb1, 0x12, 0x17, 0x4e, 0x11, 0x4a, 0x38, 0x71, 0xe4, 0x2f, 0x40, 0x3b, 0x33, 0x6b, 0x37, 0xeb,
+	0x8d, 0x6b, 0xa4, 0xde, 0xec, 0x99, 0xf7, 0xe6, 0x7b, 0xef, 0x7d, 0xe3, 0xf9, 0x0c, 0x0a, 0x2d,
+	0xc7, 0xa7, 0xc4, 0xb5, 0x35, 0x13, 0x59, 0xc8, 0x35, 0xb1, 0xd6, 0x2b, 0x69, 0x8f, 0x03, 0xec,
+	0x1d, 0xaa, 0x5d, 0x8f, 0xf8, 0x04, 0x42, 0xb1, 0xaf, 0x8a, 0x7d, 0xb5, 0x57, 0x92, 0xd7, 0x4d,
+	0x42, 0x3b, 0x84, 0x6a, 0x2d, 0x44, 0x31, 0x07, 0x6b, 0xbd, 0x52, 0x0b, 0xfb, 0xa8, 0xa4, 0x75,
+	0x91, 0xed, 0xb8, 0xc8, 0x77, 0x88, 0xcb, 0xf9, 0xf2, 0x82, 0x4d, 0x6c, 0xc2, 0x3e, 0x6a, 0xe1,
+	0x27, 0xb1, 0x9a, 0xb7, 0x09, 0xb1, 0xdb, 0x58, 0x43, 0x5d, 0x47, 0x43, 0xae, 0x4b, 0x7c, 0x46,
+	0xa1, 0x62, 0xb7, 0xf0, 0xf2, 0xf9, 0xd1, 0xc9, 0x26, 0x71, 0xa2, 0x33, 0x8b, 0x09, 0x9a, 0x6d,
+	0xec, 0x62, 0xea, 0xd0, 0x11, 0x88, 0xc8, 0x00, 0x47, 0xdc, 0x4c, 0x40, 0x50, 0xf3, 0x00, 0x5b,
+	0x41, 0x3b, 0x82, 0x2c, 0xf9, 0xd8, 0xb5, 0xb0, 0xd7, 0x71, 0x5c, 0x5f, 0x43, 0x2d, 0xd3, 0xd1,
+	0xfc, 0xc3, 0x2e, 0x16, 0x15, 0x14, 0x03, 0x5c, 0xfd, 0x2c, 0x74, 0x5e, 0xe1, 0xf4, 0x0a, 0x71,
+	0x7d, 0x0f, 0x99, 0x3e, 0x85, 0xf7, 0x01, 0x18, 0x84, 0x90, 0x93, 0x8a, 0xd2, 0xea, 0x6c, 0x79,
+	0x45, 0xe5, 0x8e, 0xd4, 0xd0, 0x91, 0xca, 0xe3, 0x15, 0xbe, 0xd4, 0x3a, 0xb2, 0xb1, 0x8e, 0x1f,
+	0x07, 0x98, 0xfa, 0xfa, 0x4b, 0x4c, 0xe5, 0x77, 0x09, 0xdc, 0x48, 0xac, 0xa0, 0x63, 0xda, 0x25,
+	0x2e, 0xc5, 0xf0, 0x11, 0x78, 0x4b, 0x88, 0x37, 0xcc, 0x68, 0x33, 0x27, 0x15, 0x27, 0x56, 0x67,
+	0xcb, 0xef, 0xa8, 0xc3, 0x6d, 0x53, 0x63, 0x07, 0x6d, 0x4d, 0x3e, 0x3f, 0x5d, 0x4e, 0xe9, 0x59,
+	0x33, 0xee, 0x60, 0xfb, 0x82, 0x83, 0x34, 0x73, 0x70, 0xfb, 0x95, 0x0e, 0xb8, 0xa8, 0x0b, 0x16,
+	0x36, 0xc1, 0x42, 0x92, 0x03, 0xb8, 0x06, 0xb2, 0x91, 0x60, 0x03, 0x59, 0x96, 0x87, 0x29, 0x65,
+	0x41, 0xcd, 0xe8, 0xf3, 0xd1, 0xfa, 0x26, 0x5f, 0x56, 0x7c, 0x90, 0x4f, 0x3a, 0xa2, 0x9f, 0xc1,
+	0x1e, 0xc8, 0xc6, 0x33, 0x10, 0x99, 0xff, 0x8f, 0x08, 0xe6, 0x63, 0x11, 0xf4, 0x9b, 0xdb, 0x10,
+	0x17, 0x82, 0x8a, 0x06, 0xbd, 0xb6, 0xe6, 0x3e, 0x93, 0xc0, 0xb5, 0x78, 0x05, 0xe1, 0xe8, 0x53,
+	0x30, 0x13, 0xdd, 0xc3, 0xa8, 0x9b, 0xf9, 0x24, 0x2b, 0x11, 0x53, 0x78, 0x18, 0x90, 0x5e, 0x5f,
+	0xff, 0x56, 0x44, 0xff, 0xa2, 0x52, 0x51, 0x0a, 0x73, 0x20, 0xed, 0x58, 0xcc, 0xfd, 0xa4, 0x9e,
+	0x76, 0x2c, 0xe5, 0xf3, 0x58, 0x5c, 0x7d, 0x2f, 0xf7, 0xc0, 0x74, 0x24, 0x4b, 0x84, 0x35, 0x8e,
+	0x95, 0x3e, 0x47, 0xf9, 0x25, 0x0d, 0x96, 0xf8, 0xc9, 0x4e, 0x27, 0x68, 0x23, 0x1f, 0x8b, 0xfe,
+	0x45, 0x42, 0xc6, 0xbf, 0x48, 0xf0, 0x0e, 0x98, 0xea, 0x1e, 0x20, 0x8a, 0x59, 0x1e, 0x73, 0x65,
+	0x25, 0x49, 0x47, 0xf5, 0x09, 0x36, 0x83, 0xd0, 0x79, 0x3d, 0x44, 0xea, 0x9c, 0x00, 0x97, 0xc0,
+	0x8c, 0x8d, 0xa8, 0xd1, 0x76, 0x3a, 0x8e, 0x9f, 0x9b, 0x60, 0xa6, 0xa7, 0x6d, 0x44, 0x77, 0xc3,
+	0xef, 0xf0, 0x26, 0x78, 0x83, 0x06, 0x16, 0x31, 0xba, 0xe8, 0xb0, 0x4d, 0x90, 0x95, 0x9b, 0x64,
+	0xd5, 0x67, 0xc3, 0xb5, 0x3a, 0x5f, 0x82, 0x5f, 0x80, 0x05, 0x06, 0xe9, 0x60, 0x4a, 0x91, 0x8d,
+	0x8d, 0x1e, 0xf6, 0x68, 0xd8, 0x98, 0x29, 0x26, 0x64, 0x25, 0x31, 0x90, 0xc0, 0x22, 0x0f, 0x38,
+	0xfc, 0x11, 0x47, 0xeb, 0x90, 0x0e, 0xad, 0x29, 0x5f, 0xa7, 0x41, 0x3e, 0x39, 0x1e, 0x91, 0xff,
+	0x27, 0x20, 0xe3, 0x61, 0x1a, 0xb4, 0xf9, 0x6f, 0x62, 0xae, 0x7c, 0x2b, 0xb1, 0x18, 0x27, 0x87,
+	0x45, 0x18, 0x56, 0x17, 0x1c, 0xb8, 0x08, 0x42, 0x9f, 0x46, 0x40, 0xb1, 0xc5, 0x52, 0x9b, 0xd4,
+	0xaf, 0xd8, 0x88, 0x36, 0x29, 0xb6, 0x46, 0x67, 0xb2, 0x00, 0xa6, 0xb0, 0xe7, 0x11, 0x4f, 0x84,
+	0xc1, 0xbf, 0xf4, 0x93, 0x12, 0x31, 0xe4, 0xa6, 0x06, 0x49, 0x09, 0x5b, 0xf0, 0x03, 0x90, 0xc1,
+	0x3d, 0xec, 0xfa, 0x34, 0x97, 0x61, 0xf7, 0xfe, 0x9a, 0x3a, 0x78, 0x81, 0xd5, 0xf0, 0x05, 0x56,
+	0xab, 0xe1, 0xb6, 0xb8, 0x26, 0x02, 0xab, 0x2c, 0x00, 0xc8, 0x42, 0xa8, 0x23, 0x0f, 0x75, 0xa2,
+	0x5f, 0xaa, 0xb2, 0x0f, 0xde, 0xbe, 0xb0, 0x2a, 0x12, 0xa9, 0x81, 0x4c, 0x97, 0xad, 0x88, 0xfb,
+	0x28, 0x27, 0x25, 0xc2, 0x39, 0x5b, 0x4b, 0xff, 0x9c, 0x2e, 0x0b, 0xf4, 0xbf, 0xa7, 0xcb, 0x6f,
+	0x1e, 0xa2, 0x4e, 0xfb, 0xae, 0xc2, 0xbf, 0x2b, 0xba, 0xd8, 0x58, 0xff, 0x26, 0x0d, 0xb2, 0xf1,
+	0x04, 0xe1, 0x5d, 0xb0, 0xd8, 0xd8, 0x79, 0xd0, 0xdc, 0xdd, 0xdc, 0xdb, 0xa9, 0x3d, 0x34, 0xf4,
+	0x6a, 0xa3, 0xb9, 0xbb, 0x67, 0x34, 0x9a, 0x95, 0x4a, 0xb5, 0xd1, 0xc8, 0xa6, 0xe4, 0xa5, 0xe3,
+	0x93, 0xe2, 0xf5, 0x38, 0xa9, 0x11, 0x98, 0x66, 0x78, 0x51, 0x3f, 0x02, 0xd7, 0x87, 0xb9, 0x55,
+	0x5d, 0xaf, 0xe9, 0x59, 0x49, 0x5e, 0x3c, 0x3e, 0x29, 0x5e, 0x8d, 0x33, 0xab, 0x2c, 0xdf, 0x7b,
+	0x20, 0x3f, 0xcc, 0xab, 0x35, 0xf7, 0x8c, 0xda, 0x7d, 0x63, 0x7b, 0xb3, 0x91, 0x4d, 0xcb, 0xf9,
+	0xe3, 0x93, 0x62, 0x2e, 0x4e, 0xae, 0x05, 0x7e, 0x6d, 0x7f, 0x1b, 0x5d, 0x52, 0xb7, 0xbe, 0xf9,
+	0x70, 0xa7, 0x92, 0x9d, 0x48, 0xae, 0x5b, 0x47, 0xae, 0x63, 0xca, 0x93, 0xdf, 0x3e, 0x2b, 0xa4,
+	0xca, 0x3f, 0x5d, 0x01, 0x53, 0x2c, 0x6f, 0xf8, 0xb3, 0x04, 0xb2, 0x43, 0x43, 0x71, 0x2d, 0x29,
+	0xe6, 0xc4, 0xe9, 0x26, 0x97, 0xc6, 0x86, 0x46, 0x4d, 0x55, 0xde, 0xfd, 0xea, 0x8f, 0xbf, 0x7f,
+	0x4c, 0x2f, 0xc3, 0x1b, 0x5a, 0xd2, 0xd8, 0xef, 0x8b, 0xf8, 0x55, 0x02, 0xf3, 0xf1, 0x51, 0xb4,
+	0x3a, 0x6e, 0x35, 0x79, 0x63, 0x5c, 0x64, 0x5f, 0xd6, 0x1d, 0x26, 0xab, 0x0c, 0x37, 0x46, 0xca,
+	0xd2, 0x9e, 0xc6, 0x9f, 0xb0, 0x23, 0xf8, 0x9d, 0x04, 0x66, 0xfa, 0x93, 0x61, 0x44, 0x78, 0xf1,
+	0xf9, 0x24, 0xaf, 0x8f, 0x03, 0x1d, 0x27, 0xb5, 0xc1, 0x34, 0xf9, 0x5e, 0x02, 0xd3, 0x11, 0x79,
+	0x44, 0x5c, 0xb1, 0x19, 0x21, 0xaf, 0x8d, 0x81, 0x14, 0x42, 0xd6, 0x99, 0x90, 0x5b, 0x50, 0x19,
+	0x29, 0x44, 0x7b, 0xea, 0x58, 0x47, 0xf0, 0x37, 0x09, 0xcc, 0xc7, 0x5e, 0x3b, 0xa8, 0x5d, 0x5e,
+	0x2a, 0x71, 0x6c, 0xc8, 0x1b, 0xe3, 0x13, 0x84, 0xc4, 0x8f, 0x99, 0xc4, 0x12, 0xd4, 0x12, 0x25,
+	0x0a, 0x52, 0x52, 0x27, 0x8f, 0x40, 0x86, 0xbf, 0x26, 0x70, 0xe5, 0xd2, 0xa2, 0x17, 0x1e, 0x2e,
+	0xf9, 0xf6, 0x2b, 0x71, 0x42, 0x93, 0xc2, 0x34, 0xe5, 0xa1, 0x9c, 0xa4, 0x89, 0xbf, 0x4e, 0x5b,
+	0xb5, 0xe7, 0x67, 0x05, 0xe9, 0xc5, 0x59, 0x41, 0xfa, 0xeb, 0xac, 0x20, 0xfd, 0x70, 0x5e, 0x48,
+	0xbd, 0x38, 0x2f, 0xa4, 0xfe, 0x3c, 0x2f, 0xa4, 0xbe, 0xfc, 0xd0, 0x76, 0xfc, 0x83, 0xa0, 0xa5,
+	0x9a, 0xa4, 0x13, 0xf1, 0xc9, 0xfe, 0xbe, 0x63, 0x3a, 0xa8, 0xad, 0xd9, 0xe4, 0xbd, 0xe8, 0xc8,
+	0x27, 0xfd, 0x43, 0xd9, 0x9f, 0xdf, 0x56, 0x86, 0xfd, 0xfb, 0x7d, 0xff, 0xbf, 0x01, 0x00, 0xd9,
+	0x2c, 0x16, 0x08, 0x37, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -372,6 +822,12 @@ type QueryClient interface {
 	CadanceContracts(ctx context.Context, in *QueryCadanceContracts, opts ...grpc.CallOption) (*QueryCadanceContractsResponse, error)
 	// CadanceContract
 	CadanceContract(ctx context.Context, in *QueryCadanceContract, opts ...grpc.CallOption) (*QueryCadanceContractResponse, error)
+	// Schedules
+	Schedules(ctx context.Context, in *QuerySchedulesRequest, opts ...grpc.CallOption) (*QuerySchedulesResponse, error)
+	// Schedule
+	Schedule(ctx context.Context, in *QueryScheduleRequest, opts ...grpc.CallOption) (*QueryScheduleResponse, error)
+	// SimulateCadance
+	SimulateCadance(ctx context.Context, in *QuerySimulateCadanceRequest, opts ...grpc.CallOption) (*QuerySimulateCadanceResponse, error)
 	// Params
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
@@ -402,6 +858,33 @@ func (c *queryClient) CadanceContract(ctx context.Context, in *QueryCadanceContr
 	return out, nil
 }
 
+func (c *queryClient) Schedules(ctx context.Context, in *QuerySchedulesRequest, opts ...grpc.CallOption) (*QuerySchedulesResponse, error) {
+	out := new(QuerySchedulesResponse)
+	err := c.cc.Invoke(ctx, "/bitsong.cadance.v1.Query/Schedules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Schedule(ctx context.Context, in *QueryScheduleRequest, opts ...grpc.CallOption) (*QueryScheduleResponse, error) {
+	out := new(QueryScheduleResponse)
+	err := c.cc.Invoke(ctx, "/bitsong.cadance.v1.Query/Schedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulateCadance(ctx context.Context, in *QuerySimulateCadanceRequest, opts ...grpc.CallOption) (*QuerySimulateCadanceResponse, error) {
+	out := new(QuerySimulateCadanceResponse)
+	err := c.cc.Invoke(ctx, "/bitsong.cadance.v1.Query/SimulateCadance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	out := new(QueryParamsResponse)
 	err := c.cc.Invoke(ctx, "/bitsong.cadance.v1.Query/Params", in, out, opts...)
@@ -417,6 +900,12 @@ type QueryServer interface {
 	CadanceContracts(context.Context, *QueryCadanceContracts) (*QueryCadanceContractsResponse, error)
 	// CadanceContract
 	CadanceContract(context.Context, *QueryCadanceContract) (*QueryCadanceContractResponse, error)
+	// Schedules
+	Schedules(context.Context, *QuerySchedulesRequest) (*QuerySchedulesResponse, error)
+	// Schedule
+	Schedule(context.Context, *QueryScheduleRequest) (*QueryScheduleResponse, error)
+	// SimulateCadance
+	SimulateCadance(context.Context, *QuerySimulateCadanceRequest) (*QuerySimulateCadanceResponse, error)
 	// Params
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
@@ -431,6 +920,15 @@ func (*UnimplementedQueryServer) CadanceContracts(ctx context.Context, req *Quer
 func (*UnimplementedQueryServer) CadanceContract(ctx context.Context, req *QueryCadanceContract) (*QueryCadanceContractResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CadanceContract not implemented")
 }
+func (*UnimplementedQueryServer) Schedules(ctx context.Context, req *QuerySchedulesRequest) (*QuerySchedulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Schedules not implemented")
+}
+func (*UnimplementedQueryServer) Schedule(ctx context.Context, req *QueryScheduleRequest) (*QueryScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Schedule not implemented")
+}
+func (*UnimplementedQueryServer) SimulateCadance(ctx context.Context, req *QuerySimulateCadanceRequest) (*QuerySimulateCadanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateCadance not implemented")
+}
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -475,42 +973,108 @@ func _Query_CadanceContract_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
+func _Query_Schedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySchedulesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
+		return srv.(QueryServer).Schedules(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/bitsong.cadance.v1.Query/Params",
+		FullMethod: "/bitsong.cadance.v1.Query/Schedules",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+		return srv.(QueryServer).Schedules(ctx, req.(*QuerySchedulesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "bitsong.cadance.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CadanceContracts",
-			Handler:    _Query_CadanceContracts_Handler,
-		},
-		{
-			MethodName: "CadanceContract",
-			Handler:    _Query_CadanceContract_Handler,
-		},
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-	},
+func _Query_Schedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Schedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitsong.cadance.v1.Query/Schedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Schedule(ctx, req.(*QueryScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateCadance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateCadanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateCadance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitsong.cadance.v1.Query/SimulateCadance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateCadance(ctx, req.(*QuerySimulateCadanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitsong.cadance.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "bitsong.cadance.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CadanceContracts",
+			Handler:    _Query_CadanceContracts_Handler,
+		},
+		{
+			MethodName: "CadanceContract",
+			Handler:    _Query_CadanceContract_Handler,
+		},
+		{
+			MethodName: "Schedules",
+			Handler:    _Query_Schedules_Handler,
+		},
+		{
+			MethodName: "Schedule",
+			Handler:    _Query_Schedule_Handler,
+		},
+		{
+			MethodName: "SimulateCadance",
+			Handler:    _Query_SimulateCadance_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "bitsong/cadance/v1/query.proto",
 }
@@ -662,7 +1226,7 @@ func (m *QueryCadanceContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QuerySchedulesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -672,20 +1236,32 @@ func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QuerySchedulesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QuerySchedulesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QuerySchedulesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -695,19 +1271,19 @@ func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QuerySchedulesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QuerySchedulesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Params != nil {
+	if m.Pagination != nil {
 		{
-			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -715,107 +1291,471 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
+	}
+	if len(m.Schedules) > 0 {
+		for iNdEx := len(m.Schedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Schedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryCadanceContracts) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryCadanceContractsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.CadanceContracts) > 0 {
-		for _, e := range m.CadanceContracts {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryCadanceContract) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySimulateCadanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateCadanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateCadanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.SudoMessageVersion != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SudoMessageVersion))
+		i--
+		dAtA[i] = 0x28
 	}
-	return n
+	if len(m.SudoPayload) > 0 {
+		i -= len(m.SudoPayload)
+		copy(dAtA[i:], m.SudoPayload)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SudoPayload)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Phase != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Phase))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryCadanceContractResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySimulateCadanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.CadanceContract.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QuerySimulateCadanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QuerySimulateCadanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Params != nil {
-		l = m.Params.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Events) > 0 {
+		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Events[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *QueryCadanceContracts) Unmarshal(dAtA []byte) error {
+	if len(m.SudoMessage) > 0 {
+		i -= len(m.SudoMessage)
+		copy(dAtA[i:], m.SudoMessage)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SudoMessage)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Result != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Result))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryCadanceContracts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCadanceContractsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CadanceContracts) > 0 {
+		for _, e := range m.CadanceContracts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCadanceContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCadanceContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CadanceContract.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySchedulesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySchedulesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Schedules) > 0 {
+		for _, e := range m.Schedules {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Schedule.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySimulateCadanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Phase != 0 {
+		n += 1 + sovQuery(uint64(m.Phase))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovQuery(uint64(m.GasLimit))
+	}
+	l = len(m.SudoPayload)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.SudoMessageVersion != 0 {
+		n += 1 + sovQuery(uint64(m.SudoMessageVersion))
+	}
+	return n
+}
+
+func (m *QuerySimulateCadanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result != 0 {
+		n += 1 + sovQuery(uint64(m.Result))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovQuery(uint64(m.GasUsed))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovQuery(uint64(m.GasLimit))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.SudoMessage)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryCadanceContracts) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -874,7 +1814,498 @@ func (m *QueryCadanceContracts) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCadanceContractsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCadanceContractsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCadanceContractsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CadanceContracts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CadanceContracts = append(m.CadanceContracts, CadanceContract{})
+			if err := m.CadanceContracts[len(m.CadanceContracts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCadanceContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCadanceContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCadanceContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCadanceContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCadanceContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCadanceContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CadanceContract", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CadanceContract.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySchedulesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySchedulesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySchedulesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySchedulesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySchedulesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySchedulesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schedules = append(m.Schedules, Schedule{})
+			if err := m.Schedules[len(m.Schedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -901,7 +2332,7 @@ func (m *QueryCadanceContracts) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCadanceContractsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryScheduleRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -924,17 +2355,17 @@ func (m *QueryCadanceContractsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCadanceContractsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryScheduleRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCadanceContractsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CadanceContracts", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -944,29 +2375,64 @@ func (m *QueryCadanceContractsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CadanceContracts = append(m.CadanceContracts, CadanceContract{})
-			if err := m.CadanceContracts[len(m.CadanceContracts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			iNdEx = postIndex
-		case 2:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -993,10 +2459,7 @@ func (m *QueryCadanceContractsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1021,7 +2484,7 @@ func (m *QueryCadanceContractsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCadanceContract) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulateCadanceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1044,10 +2507,10 @@ func (m *QueryCadanceContract) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCadanceContract: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulateCadanceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCadanceContract: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulateCadanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1082,6 +2545,95 @@ func (m *QueryCadanceContract) Unmarshal(dAtA []byte) error {
 			}
 			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			m.Phase = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Phase |= ExecutionPhase(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SudoPayload", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SudoPayload = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SudoMessageVersion", wireType)
+			}
+			m.SudoMessageVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SudoMessageVersion |= SudoMessageVersion(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1103,7 +2655,7 @@ func (m *QueryCadanceContract) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCadanceContractResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulateCadanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1126,15 +2678,136 @@ func (m *QueryCadanceContractResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCadanceContractResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulateCadanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCadanceContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulateCadanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			m.Result = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Result |= SimulationResult(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CadanceContract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SudoMessage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SudoMessage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1161,7 +2834,8 @@ func (m *QueryCadanceContractResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CadanceContract.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Events = append(m.Events, types.Event{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
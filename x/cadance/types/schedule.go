@@ -0,0 +1,72 @@
+package types
+
+import (
+	"time"
+)
+
+// Validate ensures the schedule call type is a known one
+func (t ScheduleCallType) Validate() error {
+	if _, ok := ScheduleCallType_name[int32(t)]; !ok {
+		return ErrInvalidSchedule.Wrapf("invalid call type %d", t)
+	}
+
+	return nil
+}
+
+// ValidateScheduleMsg ensures the message of a schedule is a JSON object within the maximum size
+func ValidateScheduleMsg(msg string) error {
+	if err := validateJSONObject(msg); err != nil {
+		return ErrInvalidSchedule.Wrap(err.Error())
+	}
+
+	return nil
+}
+
+// ValidateScheduleTrigger ensures exactly one of the height, the time and the cron expression of a
+// schedule is set, and that the cron expression is valid
+func ValidateScheduleTrigger(runAtHeight int64, runAtTime *time.Time, cron string) error {
+	if runAtHeight < 0 {
+		return ErrInvalidSchedule.Wrapf("negative run at height %d", runAtHeight)
+	}
+
+	triggers := 0
+	if runAtHeight > 0 {
+		triggers++
+	}
+	if runAtTime != nil {
+		triggers++
+	}
+	if cron != "" {
+		triggers++
+		if _, err := ParseCron(cron); err != nil {
+			return err
+		}
+	}
+
+	if triggers != 1 {
+		return ErrInvalidSchedule.Wrap("exactly one of the run at height, the run at time and the cron expression must be set")
+	}
+
+	return nil
+}
+
+// IsCron returns true if the schedule runs repeatedly according to a cron expression
+func (s Schedule) IsCron() bool {
+	return s.Cron != ""
+}
+
+// NextRun returns the block time from which the next call of a time or cron schedule is run, nil
+// for height schedules
+func (s Schedule) NextRun() *time.Time {
+	if s.IsCron() {
+		return s.NextRunTime
+	}
+
+	return s.RunAtTime
+}
+
+// EffectiveGasLimit returns the gas limit of the call, which is the requested one or the default
+// one of the params when not set, bounded by the maximum of the params
+func (s Schedule) EffectiveGasLimit(p Params) uint64 {
+	return effectiveGasLimit(s.GasLimit, p)
+}
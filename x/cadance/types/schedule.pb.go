@@ -0,0 +1,794 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: bitsong/cadance/v1/schedule.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "github.com/gogo/protobuf/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ScheduleCallType defines how the message of a schedule is sent to the contract.
+type ScheduleCallType int32
+
+const (
+	// The message is sent to the contract with sudo, the creator of the schedule
+	// must be the contract admin, or the contract creator when there is no admin.
+	ScheduleCallTypeSudo ScheduleCallType = 0
+	// The message is executed on the contract with the creator of the schedule
+	// as sender.
+	ScheduleCallTypeExecute ScheduleCallType = 1
+)
+
+var ScheduleCallType_name = map[int32]string{
+	0: "SCHEDULE_CALL_TYPE_SUDO",
+	1: "SCHEDULE_CALL_TYPE_EXECUTE",
+}
+
+var ScheduleCallType_value = map[string]int32{
+	"SCHEDULE_CALL_TYPE_SUDO":    0,
+	"SCHEDULE_CALL_TYPE_EXECUTE": 1,
+}
+
+func (x ScheduleCallType) String() string {
+	return proto.EnumName(ScheduleCallType_name, int32(x))
+}
+
+func (ScheduleCallType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_8d24bf5436440887, []int{0}
+}
+
+// Schedule defines a contract call run once at a block height or a block time,
+// or repeatedly according to a cron expression.
+type Schedule struct {
+	// The id of the schedule.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The address which created the schedule.
+	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	// The address of the called contract.
+	ContractAddress string `protobuf:"bytes,3,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// The JSON message sent to the contract.
+	Msg string `protobuf:"bytes,4,opt,name=msg,proto3" json:"msg,omitempty"`
+	// How the message is sent to the contract.
+	CallType ScheduleCallType `protobuf:"varint,5,opt,name=call_type,json=callType,proto3,enum=bitsong.cadance.v1.ScheduleCallType" json:"call_type,omitempty"`
+	// The gas limit of the call, the contract_gas_limit param is used when zero.
+	GasLimit uint64 `protobuf:"varint,6,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// The height at which the call is run once, zero for time and cron schedules.
+	RunAtHeight int64 `protobuf:"varint,7,opt,name=run_at_height,json=runAtHeight,proto3" json:"run_at_height,omitempty"`
+	// The block time from which the call is run once, nil for height and cron
+	// schedules.
+	RunAtTime *time.Time `protobuf:"bytes,8,opt,name=run_at_time,json=runAtTime,proto3,stdtime" json:"run_at_time,omitempty"`
+	// The cron expression, in UTC, of the times at which the call is run, empty
+	// for one-off schedules.
+	Cron string `protobuf:"bytes,9,opt,name=cron,proto3" json:"cron,omitempty"`
+	// The block time from which the next call of a cron schedule is run.
+	NextRunTime *time.Time `protobuf:"bytes,10,opt,name=next_run_time,json=nextRunTime,proto3,stdtime" json:"next_run_time,omitempty"`
+}
+
+func (m *Schedule) Reset()         { *m = Schedule{} }
+func (m *Schedule) String() string { return proto.CompactTextString(m) }
+func (*Schedule) ProtoMessage()    {}
+func (*Schedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d24bf5436440887, []int{0}
+}
+func (m *Schedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Schedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Schedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Schedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Schedule.Merge(m, src)
+}
+func (m *Schedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *Schedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_Schedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Schedule proto.InternalMessageInfo
+
+func (m *Schedule) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Schedule) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *Schedule) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *Schedule) GetMsg() string {
+	if m != nil {
+		return m.Msg
+	}
+	return ""
+}
+
+func (m *Schedule) GetCallType() ScheduleCallType {
+	if m != nil {
+		return m.CallType
+	}
+	return ScheduleCallTypeSudo
+}
+
+func (m *Schedule) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+func (m *Schedule) GetRunAtHeight() int64 {
+	if m != nil {
+		return m.RunAtHeight
+	}
+	return 0
+}
+
+func (m *Schedule) GetRunAtTime() *time.Time {
+	if m != nil {
+		return m.RunAtTime
+	}
+	return nil
+}
+
+func (m *Schedule) GetCron() string {
+	if m != nil {
+		return m.Cron
+	}
+	return ""
+}
+
+func (m *Schedule) GetNextRunTime() *time.Time {
+	if m != nil {
+		return m.NextRunTime
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("bitsong.cadance.v1.ScheduleCallType", ScheduleCallType_name, ScheduleCallType_value)
+	proto.RegisterType((*Schedule)(nil), "bitsong.cadance.v1.Schedule")
+}
+
+func init() { proto.RegisterFile("bitsong/cadance/v1/schedule.proto", fileDescriptor_8d24bf5436440887) }
+
+var fileDescriptor_8d24bf5436440887 = []byte{
+	// 495 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0x41, 0x6b, 0xdb, 0x3e,
+	0x1c, 0x8d, 0x92, 0xfc, 0xdb, 0x44, 0xa1, 0xfd, 0x1b, 0x51, 0xa8, 0x70, 0xc1, 0xf5, 0xca, 0x0e,
+	0xde, 0x60, 0x36, 0xed, 0xe8, 0x69, 0x97, 0xa5, 0x89, 0xa1, 0x87, 0x40, 0x87, 0x93, 0xc0, 0xb6,
+	0x8b, 0x51, 0x64, 0x45, 0x11, 0xd8, 0x56, 0xb0, 0xe5, 0x92, 0x7e, 0x83, 0x51, 0x76, 0xe8, 0x3e,
+	0x40, 0x4f, 0xfb, 0x32, 0x3b, 0xf6, 0xb8, 0xdb, 0x46, 0xf2, 0x45, 0x86, 0x15, 0x7b, 0x87, 0x6c,
+	0x87, 0xdd, 0xde, 0xef, 0xe9, 0xbd, 0x87, 0x7e, 0x4f, 0x82, 0xcf, 0x66, 0x42, 0xe5, 0x32, 0xe5,
+	0x1e, 0x25, 0x11, 0x49, 0x29, 0xf3, 0x6e, 0xcf, 0xbd, 0x9c, 0x2e, 0x58, 0x54, 0xc4, 0xcc, 0x5d,
+	0x66, 0x52, 0x49, 0x84, 0x2a, 0x89, 0x5b, 0x49, 0xdc, 0xdb, 0x73, 0xf3, 0x88, 0x4b, 0x2e, 0xf5,
+	0xb1, 0x57, 0xa2, 0xad, 0xd2, 0x3c, 0xe5, 0x52, 0xf2, 0x98, 0x79, 0x7a, 0x9a, 0x15, 0x73, 0x4f,
+	0x89, 0x84, 0xe5, 0x8a, 0x24, 0xcb, 0xad, 0xe0, 0xec, 0x4b, 0x0b, 0x76, 0xc6, 0x55, 0x3a, 0x3a,
+	0x84, 0x4d, 0x11, 0x61, 0x60, 0x03, 0xa7, 0x1d, 0x34, 0x45, 0x84, 0x30, 0xdc, 0xa7, 0x19, 0x23,
+	0x4a, 0x66, 0xb8, 0x69, 0x03, 0xa7, 0x1b, 0xd4, 0x23, 0x7a, 0x01, 0x0d, 0x2a, 0x53, 0x95, 0x11,
+	0xaa, 0x42, 0x12, 0x45, 0x19, 0xcb, 0x73, 0xdc, 0xd2, 0x92, 0xff, 0x6b, 0xbe, 0xbf, 0xa5, 0x91,
+	0x01, 0x5b, 0x49, 0xce, 0x71, 0x5b, 0x9f, 0x96, 0x10, 0xf5, 0x61, 0x97, 0x92, 0x38, 0x0e, 0xd5,
+	0xdd, 0x92, 0xe1, 0xff, 0x6c, 0xe0, 0x1c, 0x5e, 0x3c, 0x77, 0xff, 0x5c, 0xc9, 0xad, 0xef, 0x35,
+	0x20, 0x71, 0x3c, 0xb9, 0x5b, 0xb2, 0xa0, 0x43, 0x2b, 0x84, 0x4e, 0x60, 0x97, 0x93, 0x3c, 0x8c,
+	0x45, 0x22, 0x14, 0xde, 0xd3, 0x17, 0xee, 0x70, 0x92, 0x8f, 0xca, 0x19, 0x9d, 0xc1, 0x83, 0xac,
+	0x48, 0x43, 0xa2, 0xc2, 0x05, 0x13, 0x7c, 0xa1, 0xf0, 0xbe, 0x0d, 0x9c, 0x56, 0xd0, 0xcb, 0x8a,
+	0xb4, 0xaf, 0xae, 0x35, 0x85, 0xde, 0xc2, 0x5e, 0xa5, 0x29, 0x1b, 0xc1, 0x1d, 0x1b, 0x38, 0xbd,
+	0x0b, 0xd3, 0xdd, 0xd6, 0xe5, 0xd6, 0x75, 0xb9, 0x93, 0xba, 0xae, 0xab, 0xf6, 0xc3, 0x8f, 0x53,
+	0x10, 0x74, 0x75, 0x46, 0xc9, 0x22, 0x04, 0xdb, 0x34, 0x93, 0x29, 0xee, 0xea, 0xc5, 0x34, 0x46,
+	0x43, 0x78, 0x90, 0xb2, 0x95, 0x0a, 0xcb, 0x68, 0x9d, 0x0b, 0xff, 0x31, 0xb7, 0x57, 0xda, 0x82,
+	0x22, 0x2d, 0xf9, 0x97, 0x9f, 0x01, 0x34, 0x76, 0x77, 0x47, 0x97, 0xf0, 0x78, 0x3c, 0xb8, 0xf6,
+	0x87, 0xd3, 0x91, 0x1f, 0x0e, 0xfa, 0xa3, 0x51, 0x38, 0xf9, 0xf0, 0xce, 0x0f, 0xc7, 0xd3, 0xe1,
+	0x8d, 0xd1, 0x30, 0xf1, 0xfd, 0xa3, 0x7d, 0xb4, 0x6b, 0x19, 0x17, 0x91, 0x44, 0x6f, 0xa0, 0xf9,
+	0x17, 0x9b, 0xff, 0xde, 0x1f, 0x4c, 0x27, 0xbe, 0x01, 0xcc, 0x93, 0xfb, 0x47, 0xfb, 0x78, 0xd7,
+	0xe9, 0xaf, 0x18, 0x2d, 0x14, 0x33, 0xdb, 0x9f, 0xbe, 0x5a, 0x8d, 0xab, 0x9b, 0x6f, 0x6b, 0x0b,
+	0x3c, 0xad, 0x2d, 0xf0, 0x73, 0x6d, 0x81, 0x87, 0x8d, 0xd5, 0x78, 0xda, 0x58, 0x8d, 0xef, 0x1b,
+	0xab, 0xf1, 0xf1, 0x92, 0x0b, 0xb5, 0x28, 0x66, 0x2e, 0x95, 0x89, 0x57, 0xbd, 0x9f, 0x9c, 0xcf,
+	0x05, 0x15, 0x24, 0xf6, 0xb8, 0x7c, 0x55, 0x7f, 0xe4, 0xd5, 0xef, 0xaf, 0x5c, 0xbe, 0x78, 0x3e,
+	0xdb, 0xd3, 0x35, 0xbc, 0xfe, 0x35, 0x00, 0x43, 0x77, 0xf6, 0x14, 0xea, 0x02, 0x00, 0x00,
+}
+
+func (m *Schedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Schedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Schedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextRunTime != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.NextRunTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.NextRunTime):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintSchedule(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Cron) > 0 {
+		i -= len(m.Cron)
+		copy(dAtA[i:], m.Cron)
+		i = encodeVarintSchedule(dAtA, i, uint64(len(m.Cron)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.RunAtTime != nil {
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.RunAtTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.RunAtTime):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintSchedule(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.RunAtHeight != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.RunAtHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.CallType != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.CallType))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintSchedule(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintSchedule(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintSchedule(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSchedule(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSchedule(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Schedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovSchedule(uint64(m.Id))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.CallType != 0 {
+		n += 1 + sovSchedule(uint64(m.CallType))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovSchedule(uint64(m.GasLimit))
+	}
+	if m.RunAtHeight != 0 {
+		n += 1 + sovSchedule(uint64(m.RunAtHeight))
+	}
+	if m.RunAtTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.RunAtTime)
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	l = len(m.Cron)
+	if l > 0 {
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.NextRunTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.NextRunTime)
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	return n
+}
+
+func sovSchedule(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSchedule(x uint64) (n int) {
+	return sovSchedule(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Schedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchedule
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Schedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Schedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallType", wireType)
+			}
+			m.CallType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CallType |= ScheduleCallType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunAtHeight", wireType)
+			}
+			m.RunAtHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RunAtHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunAtTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RunAtTime == nil {
+				m.RunAtTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.RunAtTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cron", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cron = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRunTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NextRunTime == nil {
+				m.NextRunTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.NextRunTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchedule(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSchedule(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSchedule
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSchedule
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSchedule
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSchedule
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSchedule        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSchedule          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSchedule = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,41 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bitsongofficial/go-bitsong/x/cadance/types"
+)
+
+func TestValidateScheduleTrigger(t *testing.T) {
+	runAtTime := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name        string
+		runAtHeight int64
+		runAtTime   *time.Time
+		cron        string
+		success     bool
+	}{
+		{"Success - Height", 100, nil, "", true},
+		{"Success - Time", 0, &runAtTime, "", true},
+		{"Success - Cron", 0, nil, "0 0 * * *", true},
+		{"Fail - No Trigger", 0, nil, "", false},
+		{"Fail - Negative Height", -1, nil, "", false},
+		{"Fail - Height And Time", 100, &runAtTime, "", false},
+		{"Fail - Time And Cron", 0, &runAtTime, "0 0 * * *", false},
+		{"Fail - Invalid Cron", 0, nil, "0 0 * *", false},
+	}
+
+	for _, tc := range testCases {
+		err := types.ValidateScheduleTrigger(tc.runAtHeight, tc.runAtTime, tc.cron)
+
+		if tc.success {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
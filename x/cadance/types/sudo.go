@@ -0,0 +1,116 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// MaxSudoPayloadSize is the maximum size in bytes of the custom payload of a contract and of the
+// message of a schedule
+const MaxSudoPayloadSize = 4096
+
+// SudoBlockContext is the content of the sudo message of a phase in the v2 schema
+type SudoBlockContext struct {
+	// Height of the block
+	Height uint64 `json:"height"`
+	// Time of the block in nanoseconds since the unix epoch, encoded as a string like the
+	// CosmWasm timestamps
+	Time string `json:"time"`
+	// Id of the registration of the contract
+	RegistrationID uint64 `json:"registration_id"`
+	// Custom payload of the contract, omitted when not set
+	Payload json.RawMessage `json:"payload,omitempty"`
+}
+
+// SudoMsgV2 is the sudo message sent to the contracts in the v2 schema, only the field of the
+// current phase is set
+type SudoMsgV2 struct {
+	CadanceBeginBlock *SudoBlockContext `json:"cadance_begin_block,omitempty"`
+	CadanceEndBlock   *SudoBlockContext `json:"cadance_end_block,omitempty"`
+}
+
+// Validate ensures the sudo message version is a known one
+func (v SudoMessageVersion) Validate() error {
+	if _, ok := SudoMessageVersion_name[int32(v)]; !ok {
+		return ErrInvalidSudoVersion.Wrapf("%d", v)
+	}
+
+	return nil
+}
+
+// ValidateSudoMessage ensures the sudo message version and the custom payload of a contract are valid
+func ValidateSudoMessage(version SudoMessageVersion, payload string) error {
+	if err := version.Validate(); err != nil {
+		return err
+	}
+
+	return ValidateSudoPayload(payload)
+}
+
+// ValidateSudoPayload ensures the custom payload is either empty or a JSON object within the
+// maximum payload size
+func ValidateSudoPayload(payload string) error {
+	if payload == "" {
+		return nil
+	}
+
+	if err := validateJSONObject(payload); err != nil {
+		return ErrInvalidSudoPayload.Wrap(err.Error())
+	}
+
+	return nil
+}
+
+// validateJSONObject ensures the message is a JSON object within the maximum payload size
+func validateJSONObject(msg string) error {
+	if len(msg) > MaxSudoPayloadSize {
+		return fmt.Errorf("size %d exceeds the maximum of %d bytes", len(msg), MaxSudoPayloadSize)
+	}
+
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(msg), &obj); err != nil {
+		return fmt.Errorf("message must be a JSON object: %s", err)
+	}
+
+	return nil
+}
+
+// BuildSudoMessage builds the sudo message sent to the contract in the given phase of the block
+// at the given height and time, according to the sudo message version of the contract. The v1
+// schema sends the custom payload as is when set, or the static message of the phase otherwise,
+// so that the contracts registered before the v2 schema keep working.
+func (c CadanceContract) BuildSudoMessage(phase ExecutionPhase, height int64, blockTime time.Time) ([]byte, error) {
+	switch c.SudoMessageVersion {
+	case SudoMessageVersionV1:
+		if c.SudoPayload != "" {
+			return []byte(c.SudoPayload), nil
+		}
+		if phase == ExecutionPhaseBeginBlock {
+			return []byte(BeginBlockSudoMessage), nil
+		}
+		return []byte(EndBlockSudoMessage), nil
+
+	case SudoMessageVersionV2:
+		blockCtx := &SudoBlockContext{
+			Height:         uint64(height),
+			Time:           strconv.FormatInt(blockTime.UnixNano(), 10),
+			RegistrationID: c.RegistrationId,
+		}
+		if c.SudoPayload != "" {
+			blockCtx.Payload = json.RawMessage(c.SudoPayload)
+		}
+
+		msg := SudoMsgV2{}
+		if phase == ExecutionPhaseBeginBlock {
+			msg.CadanceBeginBlock = blockCtx
+		} else {
+			msg.CadanceEndBlock = blockCtx
+		}
+		return json.Marshal(msg)
+
+	default:
+		return nil, ErrInvalidSudoVersion.Wrapf("%d", c.SudoMessageVersion)
+	}
+}
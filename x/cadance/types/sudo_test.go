@@ -0,0 +1,87 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bitsongofficial/go-bitsong/x/cadance/types"
+)
+
+func TestValidateSudoPayload(t *testing.T) {
+	testCases := []struct {
+		name    string
+		payload string
+		success bool
+	}{
+		{"Success - Empty Payload", "", true},
+		{"Success - JSON Object", `{"clock_end_block":{}}`, true},
+		{"Fail - Invalid JSON", `{"clock_end_block":`, false},
+		{"Fail - Not A JSON Object", `["clock_end_block"]`, false},
+		{"Fail - Payload Too Large", `{"data":"` + strings.Repeat("a", types.MaxSudoPayloadSize) + `"}`, false},
+	}
+
+	for _, tc := range testCases {
+		err := types.ValidateSudoPayload(tc.payload)
+
+		if tc.success {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestCadanceContractBuildSudoMessage(t *testing.T) {
+	blockTime := time.Unix(1_700_000_000, 5)
+
+	testCases := []struct {
+		name     string
+		contract types.CadanceContract
+		phase    types.ExecutionPhase
+		expected string
+	}{
+		{
+			"V1 - End Block",
+			types.CadanceContract{},
+			types.ExecutionPhaseEndBlock,
+			types.EndBlockSudoMessage,
+		},
+		{
+			"V1 - Begin Block",
+			types.CadanceContract{},
+			types.ExecutionPhaseBeginBlock,
+			types.BeginBlockSudoMessage,
+		},
+		{
+			"V1 - Custom Payload",
+			types.CadanceContract{SudoPayload: `{"clock_end_block":{}}`},
+			types.ExecutionPhaseBeginBlock,
+			`{"clock_end_block":{}}`,
+		},
+		{
+			"V2 - End Block",
+			types.CadanceContract{RegistrationId: 7, SudoMessageVersion: types.SudoMessageVersionV2},
+			types.ExecutionPhaseEndBlock,
+			`{"cadance_end_block":{"height":42,"time":"1700000000000000005","registration_id":7}}`,
+		},
+		{
+			"V2 - Begin Block With Custom Payload",
+			types.CadanceContract{RegistrationId: 7, SudoPayload: `{"id": 1}`, SudoMessageVersion: types.SudoMessageVersionV2},
+			types.ExecutionPhaseBeginBlock,
+			`{"cadance_begin_block":{"height":42,"time":"1700000000000000005","registration_id":7,"payload":{"id":1}}}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		msg, err := tc.contract.BuildSudoMessage(tc.phase, 42, blockTime)
+		require.NoError(t, err, tc.name)
+		require.Equal(t, tc.expected, string(msg), tc.name)
+	}
+
+	// Unknown versions are rejected
+	_, err := types.CadanceContract{SudoMessageVersion: 2}.BuildSudoMessage(types.ExecutionPhaseEndBlock, 42, blockTime)
+	require.Error(t, err)
+}
@@ -12,6 +12,8 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "github.com/gogo/protobuf/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -19,12 +21,14 @@ import (
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -38,6 +42,25 @@ type MsgRegisterCadanceContract struct {
 	SenderAddress string `protobuf:"bytes,1,opt,name=sender_address,json=senderAddress,proto3" json:"sender_address,omitempty"`
 	// The address of the contract to register.
 	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// The phases of the block in which the contract is executed.
+	Phase ExecutionPhase `protobuf:"varint,3,opt,name=phase,proto3,enum=bitsong.cadance.v1.ExecutionPhase" json:"phase,omitempty"`
+	// The number of blocks between two executions of the contract, the
+	// contract is executed every block when zero.
+	ExecutionInterval uint64 `protobuf:"varint,4,opt,name=execution_interval,json=executionInterval,proto3" json:"execution_interval,omitempty"`
+	// The height from which the contract is executed.
+	StartHeight int64 `protobuf:"varint,5,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// The gas limit of the contract executions, the contract_gas_limit param is
+	// used when zero.
+	GasLimit uint64 `protobuf:"varint,6,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// The custom JSON payload sent to the contract.
+	SudoPayload string `protobuf:"bytes,7,opt,name=sudo_payload,json=sudoPayload,proto3" json:"sudo_payload,omitempty"`
+	// The schema of the sudo message sent to the contract.
+	SudoMessageVersion SudoMessageVersion `protobuf:"varint,8,opt,name=sudo_message_version,json=sudoMessageVersion,proto3,enum=bitsong.cadance.v1.SudoMessageVersion" json:"sudo_message_version,omitempty"`
+	// The account paying the execution gas, either the sender or empty for the
+	// contract itself.
+	FeePayer string `protobuf:"bytes,9,opt,name=fee_payer,json=feePayer,proto3" json:"fee_payer,omitempty"`
+	// The priority of the contract, up to the max_priority param.
+	Priority uint32 `protobuf:"varint,10,opt,name=priority,proto3" json:"priority,omitempty"`
 }
 
 func (m *MsgRegisterCadanceContract) Reset()         { *m = MsgRegisterCadanceContract{} }
@@ -87,9 +110,67 @@ func (m *MsgRegisterCadanceContract) GetContractAddress() string {
 	return ""
 }
 
+func (m *MsgRegisterCadanceContract) GetPhase() ExecutionPhase {
+	if m != nil {
+		return m.Phase
+	}
+	return ExecutionPhaseEndBlock
+}
+
+func (m *MsgRegisterCadanceContract) GetExecutionInterval() uint64 {
+	if m != nil {
+		return m.ExecutionInterval
+	}
+	return 0
+}
+
+func (m *MsgRegisterCadanceContract) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *MsgRegisterCadanceContract) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+func (m *MsgRegisterCadanceContract) GetSudoPayload() string {
+	if m != nil {
+		return m.SudoPayload
+	}
+	return ""
+}
+
+func (m *MsgRegisterCadanceContract) GetSudoMessageVersion() SudoMessageVersion {
+	if m != nil {
+		return m.SudoMessageVersion
+	}
+	return SudoMessageVersionV1
+}
+
+func (m *MsgRegisterCadanceContract) GetFeePayer() string {
+	if m != nil {
+		return m.FeePayer
+	}
+	return ""
+}
+
+func (m *MsgRegisterCadanceContract) GetPriority() uint32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
 // MsgRegisterCadanceContractResponse defines the response structure for executing a
 // MsgRegisterCadanceContract message.
 type MsgRegisterCadanceContractResponse struct {
+	// The id assigned to the registration of the contract.
+	RegistrationId uint64 `protobuf:"varint,1,opt,name=registration_id,json=registrationId,proto3" json:"registration_id,omitempty"`
 }
 
 func (m *MsgRegisterCadanceContractResponse) Reset()         { *m = MsgRegisterCadanceContractResponse{} }
@@ -125,6 +206,13 @@ func (m *MsgRegisterCadanceContractResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRegisterCadanceContractResponse proto.InternalMessageInfo
 
+func (m *MsgRegisterCadanceContractResponse) GetRegistrationId() uint64 {
+	if m != nil {
+		return m.RegistrationId
+	}
+	return 0
+}
+
 // MsgUnregisterCadanceContract is the Msg/UnregisterCadanceContract request type.
 type MsgUnregisterCadanceContract struct {
 	// The address of the sender.
@@ -218,6 +306,166 @@ func (m *MsgUnregisterCadanceContractResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUnregisterCadanceContractResponse proto.InternalMessageInfo
 
+// MsgUpdateCadanceContract is the Msg/UpdateCadanceContract request type.
+type MsgUpdateCadanceContract struct {
+	// The address of the sender.
+	SenderAddress string `protobuf:"bytes,1,opt,name=sender_address,json=senderAddress,proto3" json:"sender_address,omitempty"`
+	// The address of the contract to update.
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// The number of blocks between two executions of the contract, the
+	// contract is executed every block when zero.
+	ExecutionInterval uint64 `protobuf:"varint,3,opt,name=execution_interval,json=executionInterval,proto3" json:"execution_interval,omitempty"`
+	// The height from which the contract is executed.
+	StartHeight int64 `protobuf:"varint,4,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// The gas limit of the contract executions, the contract_gas_limit param is
+	// used when zero.
+	GasLimit uint64 `protobuf:"varint,5,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// The custom JSON payload sent to the contract.
+	SudoPayload string `protobuf:"bytes,6,opt,name=sudo_payload,json=sudoPayload,proto3" json:"sudo_payload,omitempty"`
+	// The schema of the sudo message sent to the contract.
+	SudoMessageVersion SudoMessageVersion `protobuf:"varint,7,opt,name=sudo_message_version,json=sudoMessageVersion,proto3,enum=bitsong.cadance.v1.SudoMessageVersion" json:"sudo_message_version,omitempty"`
+	// The account paying the execution gas, either the sender or empty for the
+	// contract itself.
+	FeePayer string `protobuf:"bytes,8,opt,name=fee_payer,json=feePayer,proto3" json:"fee_payer,omitempty"`
+	// The priority of the contract, up to the max_priority param unless it is
+	// unchanged.
+	Priority uint32 `protobuf:"varint,9,opt,name=priority,proto3" json:"priority,omitempty"`
+}
+
+func (m *MsgUpdateCadanceContract) Reset()         { *m = MsgUpdateCadanceContract{} }
+func (m *MsgUpdateCadanceContract) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateCadanceContract) ProtoMessage()    {}
+func (*MsgUpdateCadanceContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5bbd129cd018eed, []int{4}
+}
+func (m *MsgUpdateCadanceContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateCadanceContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateCadanceContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateCadanceContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateCadanceContract.Merge(m, src)
+}
+func (m *MsgUpdateCadanceContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateCadanceContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateCadanceContract.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateCadanceContract proto.InternalMessageInfo
+
+func (m *MsgUpdateCadanceContract) GetSenderAddress() string {
+	if m != nil {
+		return m.SenderAddress
+	}
+	return ""
+}
+
+func (m *MsgUpdateCadanceContract) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *MsgUpdateCadanceContract) GetExecutionInterval() uint64 {
+	if m != nil {
+		return m.ExecutionInterval
+	}
+	return 0
+}
+
+func (m *MsgUpdateCadanceContract) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *MsgUpdateCadanceContract) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+func (m *MsgUpdateCadanceContract) GetSudoPayload() string {
+	if m != nil {
+		return m.SudoPayload
+	}
+	return ""
+}
+
+func (m *MsgUpdateCadanceContract) GetSudoMessageVersion() SudoMessageVersion {
+	if m != nil {
+		return m.SudoMessageVersion
+	}
+	return SudoMessageVersionV1
+}
+
+func (m *MsgUpdateCadanceContract) GetFeePayer() string {
+	if m != nil {
+		return m.FeePayer
+	}
+	return ""
+}
+
+func (m *MsgUpdateCadanceContract) GetPriority() uint32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+// MsgUpdateCadanceContractResponse defines the response structure for executing a
+// MsgUpdateCadanceContract message.
+type MsgUpdateCadanceContractResponse struct {
+}
+
+func (m *MsgUpdateCadanceContractResponse) Reset()         { *m = MsgUpdateCadanceContractResponse{} }
+func (m *MsgUpdateCadanceContractResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateCadanceContractResponse) ProtoMessage()    {}
+func (*MsgUpdateCadanceContractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5bbd129cd018eed, []int{5}
+}
+func (m *MsgUpdateCadanceContractResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateCadanceContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateCadanceContractResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateCadanceContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateCadanceContractResponse.Merge(m, src)
+}
+func (m *MsgUpdateCadanceContractResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateCadanceContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateCadanceContractResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateCadanceContractResponse proto.InternalMessageInfo
+
 // MsgUnjailCadanceContract is the Msg/UnjailCadanceContract request type.
 type MsgUnjailCadanceContract struct {
 	// The address of the sender.
@@ -230,7 +478,7 @@ func (m *MsgUnjailCadanceContract) Reset()         { *m = MsgUnjailCadanceContra
 func (m *MsgUnjailCadanceContract) String() string { return proto.CompactTextString(m) }
 func (*MsgUnjailCadanceContract) ProtoMessage()    {}
 func (*MsgUnjailCadanceContract) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e5bbd129cd018eed, []int{4}
+	return fileDescriptor_e5bbd129cd018eed, []int{6}
 }
 func (m *MsgUnjailCadanceContract) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -282,7 +530,7 @@ func (m *MsgUnjailCadanceContractResponse) Reset()         { *m = MsgUnjailCadan
 func (m *MsgUnjailCadanceContractResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUnjailCadanceContractResponse) ProtoMessage()    {}
 func (*MsgUnjailCadanceContractResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e5bbd129cd018eed, []int{5}
+	return fileDescriptor_e5bbd129cd018eed, []int{7}
 }
 func (m *MsgUnjailCadanceContractResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -311,30 +559,26 @@ func (m *MsgUnjailCadanceContractResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUnjailCadanceContractResponse proto.InternalMessageInfo
 
-// MsgUpdateParams is the Msg/UpdateParams request type.
-//
-// Since: cosmos-sdk 0.47
-type MsgUpdateParams struct {
-	// authority is the address of the governance account.
-	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	// params defines the x/cadance parameters to update.
-	//
-	// NOTE: All parameters must be supplied.
-	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+// MsgConfirmCadanceContract is the Msg/ConfirmCadanceContract request type.
+type MsgConfirmCadanceContract struct {
+	// The address of the sender.
+	SenderAddress string `protobuf:"bytes,1,opt,name=sender_address,json=senderAddress,proto3" json:"sender_address,omitempty"`
+	// The address of the contract to confirm.
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
 }
 
-func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
-func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateParams) ProtoMessage()    {}
-func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e5bbd129cd018eed, []int{6}
+func (m *MsgConfirmCadanceContract) Reset()         { *m = MsgConfirmCadanceContract{} }
+func (m *MsgConfirmCadanceContract) String() string { return proto.CompactTextString(m) }
+func (*MsgConfirmCadanceContract) ProtoMessage()    {}
+func (*MsgConfirmCadanceContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5bbd129cd018eed, []int{8}
 }
-func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+func (m *MsgConfirmCadanceContract) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgConfirmCadanceContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgConfirmCadanceContract.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -344,51 +588,49 @@ func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+func (m *MsgConfirmCadanceContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConfirmCadanceContract.Merge(m, src)
 }
-func (m *MsgUpdateParams) XXX_Size() int {
+func (m *MsgConfirmCadanceContract) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateParams) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+func (m *MsgConfirmCadanceContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConfirmCadanceContract.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+var xxx_messageInfo_MsgConfirmCadanceContract proto.InternalMessageInfo
 
-func (m *MsgUpdateParams) GetAuthority() string {
+func (m *MsgConfirmCadanceContract) GetSenderAddress() string {
 	if m != nil {
-		return m.Authority
+		return m.SenderAddress
 	}
 	return ""
 }
 
-func (m *MsgUpdateParams) GetParams() Params {
+func (m *MsgConfirmCadanceContract) GetContractAddress() string {
 	if m != nil {
-		return m.Params
+		return m.ContractAddress
 	}
-	return Params{}
+	return ""
 }
 
-// MsgUpdateParamsResponse defines the response structure for executing a
-// MsgUpdateParams message.
-//
-// Since: cosmos-sdk 0.47
-type MsgUpdateParamsResponse struct {
+// MsgConfirmCadanceContractResponse defines the response structure for executing a
+// MsgConfirmCadanceContract message.
+type MsgConfirmCadanceContractResponse struct {
 }
 
-func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
-func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateParamsResponse) ProtoMessage()    {}
-func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e5bbd129cd018eed, []int{7}
+func (m *MsgConfirmCadanceContractResponse) Reset()         { *m = MsgConfirmCadanceContractResponse{} }
+func (m *MsgConfirmCadanceContractResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgConfirmCadanceContractResponse) ProtoMessage()    {}
+func (*MsgConfirmCadanceContractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5bbd129cd018eed, []int{9}
 }
-func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgConfirmCadanceContractResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgConfirmCadanceContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgConfirmCadanceContractResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -398,642 +640,4255 @@ func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+func (m *MsgConfirmCadanceContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConfirmCadanceContractResponse.Merge(m, src)
 }
-func (m *MsgUpdateParamsResponse) XXX_Size() int {
+func (m *MsgConfirmCadanceContractResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+func (m *MsgConfirmCadanceContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConfirmCadanceContractResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgConfirmCadanceContractResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgRegisterCadanceContract)(nil), "bitsong.cadance.v1.MsgRegisterCadanceContract")
-	proto.RegisterType((*MsgRegisterCadanceContractResponse)(nil), "bitsong.cadance.v1.MsgRegisterCadanceContractResponse")
-	proto.RegisterType((*MsgUnregisterCadanceContract)(nil), "bitsong.cadance.v1.MsgUnregisterCadanceContract")
-	proto.RegisterType((*MsgUnregisterCadanceContractResponse)(nil), "bitsong.cadance.v1.MsgUnregisterCadanceContractResponse")
-	proto.RegisterType((*MsgUnjailCadanceContract)(nil), "bitsong.cadance.v1.MsgUnjailCadanceContract")
-	proto.RegisterType((*MsgUnjailCadanceContractResponse)(nil), "bitsong.cadance.v1.MsgUnjailCadanceContractResponse")
-	proto.RegisterType((*MsgUpdateParams)(nil), "bitsong.cadance.v1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "bitsong.cadance.v1.MsgUpdateParamsResponse")
+// MsgCreateSchedule is the Msg/CreateSchedule request type. Exactly one of
+// run_at_height, run_at_time and cron must be set.
+type MsgCreateSchedule struct {
+	// The address of the creator, which pays the schedule fee.
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// The address of the called contract.
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// The JSON message sent to the contract.
+	Msg string `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	// How the message is sent to the contract.
+	CallType ScheduleCallType `protobuf:"varint,4,opt,name=call_type,json=callType,proto3,enum=bitsong.cadance.v1.ScheduleCallType" json:"call_type,omitempty"`
+	// The gas limit of the call, the contract_gas_limit param is used when zero.
+	GasLimit uint64 `protobuf:"varint,5,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// The height at which the call is run once.
+	RunAtHeight int64 `protobuf:"varint,6,opt,name=run_at_height,json=runAtHeight,proto3" json:"run_at_height,omitempty"`
+	// The block time from which the call is run once.
+	RunAtTime *time.Time `protobuf:"bytes,7,opt,name=run_at_time,json=runAtTime,proto3,stdtime" json:"run_at_time,omitempty"`
+	// The cron expression, in UTC, of the times at which the call is run.
+	Cron string `protobuf:"bytes,8,opt,name=cron,proto3" json:"cron,omitempty"`
 }
 
-func init() { proto.RegisterFile("bitsong/cadance/v1/tx.proto", fileDescriptor_e5bbd129cd018eed) }
-
-var fileDescriptor_e5bbd129cd018eed = []byte{
-	// 552 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x94, 0x4f, 0x6f, 0xd3, 0x30,
-	0x18, 0xc6, 0x9b, 0x31, 0x4d, 0x9a, 0x81, 0x0d, 0xac, 0xa1, 0xb6, 0x61, 0xa4, 0x5d, 0xb6, 0x01,
-	0x03, 0x16, 0xb3, 0x01, 0xd3, 0xc4, 0x8d, 0xee, 0x5c, 0x81, 0x8a, 0xb8, 0x70, 0x19, 0x6e, 0xe2,
-	0x79, 0x46, 0x8d, 0x1d, 0xc5, 0xee, 0xb4, 0x5d, 0xf7, 0x09, 0x90, 0xf6, 0x0d, 0x38, 0x72, 0x42,
-	0x88, 0x0f, 0xb1, 0xe3, 0x04, 0x17, 0x4e, 0x08, 0xb5, 0x48, 0xdc, 0xf8, 0x0c, 0xa8, 0xb1, 0x93,
-	0xf1, 0x27, 0x46, 0xf4, 0xc0, 0x2e, 0x91, 0xf3, 0xbe, 0xcf, 0xfb, 0x3e, 0x3f, 0x45, 0x4f, 0x0c,
-	0xae, 0x76, 0x99, 0x92, 0x82, 0x53, 0x14, 0xe2, 0x08, 0xf3, 0x90, 0xa0, 0xbd, 0x35, 0xa4, 0xf6,
-	0x83, 0x24, 0x15, 0x4a, 0x40, 0x68, 0x9a, 0x81, 0x69, 0x06, 0x7b, 0x6b, 0xee, 0x3c, 0x15, 0x82,
-	0xf6, 0x08, 0xc2, 0x09, 0x43, 0x98, 0x73, 0xa1, 0xb0, 0x62, 0x82, 0x4b, 0x3d, 0xe1, 0x56, 0x43,
-	0x21, 0x63, 0x21, 0x51, 0x2c, 0xe9, 0x68, 0x53, 0x2c, 0xa9, 0x69, 0x34, 0x4b, 0x7c, 0x28, 0xe1,
-	0x44, 0xb2, 0x7c, 0x74, 0x8e, 0x0a, 0x2a, 0xb2, 0x23, 0x1a, 0x9d, 0x4c, 0xb5, 0xae, 0x17, 0x6e,
-	0xeb, 0x86, 0x7e, 0x31, 0xad, 0xcb, 0x38, 0x66, 0x5c, 0xa0, 0xec, 0xa9, 0x4b, 0x3e, 0x07, 0x6e,
-	0x5b, 0xd2, 0x0e, 0xa1, 0x4c, 0x2a, 0x92, 0x6e, 0x69, 0xab, 0x2d, 0xc1, 0x55, 0x8a, 0x43, 0x05,
-	0x97, 0xc1, 0x8c, 0x24, 0x3c, 0x22, 0xe9, 0x36, 0x8e, 0xa2, 0x94, 0x48, 0x59, 0x73, 0x9a, 0xce,
-	0xcd, 0xe9, 0xce, 0x45, 0x5d, 0x7d, 0xa4, 0x8b, 0x70, 0x05, 0x5c, 0x0a, 0xcd, 0x48, 0x21, 0x9c,
-	0xc8, 0x84, 0xb3, 0x79, 0xdd, 0x48, 0xfd, 0x25, 0xe0, 0xdb, 0xfd, 0x3a, 0x44, 0x26, 0x82, 0x4b,
-	0xe2, 0x27, 0x60, 0xbe, 0x2d, 0xe9, 0x33, 0x9e, 0x9e, 0x19, 0xd7, 0x75, 0xb0, 0xf4, 0x37, 0xc7,
-	0x82, 0xac, 0x07, 0x6a, 0x99, 0xee, 0x25, 0x66, 0xbd, 0xff, 0x4f, 0xe5, 0x83, 0xa6, 0xcd, 0xad,
-	0x20, 0x3a, 0x72, 0xc0, 0xec, 0x48, 0x94, 0x44, 0x58, 0x91, 0x27, 0x38, 0xc5, 0xb1, 0x84, 0x1b,
-	0x60, 0x1a, 0xf7, 0xd5, 0xae, 0x48, 0x99, 0x3a, 0xd0, 0x10, 0xad, 0xda, 0x87, 0xf7, 0xab, 0x73,
-	0x26, 0x0d, 0x66, 0xfd, 0x53, 0x95, 0x32, 0x4e, 0x3b, 0xa7, 0x52, 0xb8, 0x09, 0xa6, 0x92, 0x6c,
-	0x43, 0x06, 0x74, 0x7e, 0xdd, 0x0d, 0xfe, 0xcc, 0x73, 0xa0, 0x3d, 0x5a, 0x93, 0xc7, 0x9f, 0x1b,
-	0x95, 0x8e, 0xd1, 0x3f, 0x9c, 0x39, 0xfc, 0xf6, 0xf6, 0xd6, 0xe9, 0x26, 0xbf, 0x0e, 0xaa, 0xbf,
-	0x41, 0xe5, 0xc0, 0xeb, 0xdf, 0x27, 0xc1, 0xb9, 0xb6, 0xa4, 0xf0, 0x8d, 0x03, 0xaa, 0xb6, 0xe0,
-	0x05, 0x65, 0xc6, 0xf6, 0xe0, 0xb8, 0x1b, 0xe3, 0xe9, 0x8b, 0x8f, 0x77, 0xe3, 0xf0, 0xe3, 0xd7,
-	0xa3, 0x89, 0x05, 0xbf, 0x81, 0x4a, 0xff, 0x6a, 0x94, 0xc7, 0x01, 0xbe, 0x73, 0x40, 0xdd, 0x9e,
-	0xc7, 0xbb, 0x16, 0x7b, 0xeb, 0x84, 0xbb, 0x39, 0xee, 0x44, 0x81, 0xbc, 0x92, 0x21, 0x2f, 0xfa,
-	0x0b, 0x16, 0xe4, 0x7e, 0xb1, 0x01, 0xbe, 0x76, 0xc0, 0x95, 0xf2, 0xa8, 0xde, 0xb1, 0xda, 0x97,
-	0xa8, 0xdd, 0xfb, 0xe3, 0xa8, 0x0b, 0xd0, 0xe5, 0x0c, 0xb4, 0xe1, 0x5f, 0xb3, 0x82, 0x8e, 0xa6,
-	0xe1, 0x0b, 0x70, 0xe1, 0x97, 0xec, 0x2e, 0xda, 0xcc, 0x7e, 0x12, 0xb9, 0xb7, 0xff, 0x41, 0x94,
-	0x83, 0xb4, 0x1e, 0x1f, 0x0f, 0x3c, 0xe7, 0x64, 0xe0, 0x39, 0x5f, 0x06, 0x9e, 0xf3, 0x6a, 0xe8,
-	0x55, 0x4e, 0x86, 0x5e, 0xe5, 0xd3, 0xd0, 0xab, 0x3c, 0x7f, 0x40, 0x99, 0xda, 0xed, 0x77, 0x83,
-	0x50, 0xc4, 0x39, 0xa4, 0xd8, 0xd9, 0x61, 0x21, 0xc3, 0x3d, 0x44, 0xc5, 0x6a, 0xce, 0xbd, 0x5f,
-	0x90, 0xab, 0x83, 0x84, 0xc8, 0xee, 0x54, 0x76, 0x77, 0xde, 0xfb, 0x11, 0x00, 0x00, 0xff, 0xff,
-	0x26, 0x4c, 0xd8, 0xb9, 0x0b, 0x06, 0x00, 0x00,
+func (m *MsgCreateSchedule) Reset()         { *m = MsgCreateSchedule{} }
+func (m *MsgCreateSchedule) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateSchedule) ProtoMessage()    {}
+func (*MsgCreateSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5bbd129cd018eed, []int{10}
+}
+func (m *MsgCreateSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateSchedule.Merge(m, src)
+}
+func (m *MsgCreateSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateSchedule.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_MsgCreateSchedule proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *MsgCreateSchedule) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// RegisterCadanceContract defines the endpoint for
-	// registering a new cadance contract .
-	RegisterCadanceContract(ctx context.Context, in *MsgRegisterCadanceContract, opts ...grpc.CallOption) (*MsgRegisterCadanceContractResponse, error)
-	// UnregisterCadanceContract defines the endpoint for
-	// unregistering a cadance contract .
-	UnregisterCadanceContract(ctx context.Context, in *MsgUnregisterCadanceContract, opts ...grpc.CallOption) (*MsgUnregisterCadanceContractResponse, error)
-	// UnjailCadanceContract defines the endpoint for
-	// unjailing a cadance contract .
-	UnjailCadanceContract(ctx context.Context, in *MsgUnjailCadanceContract, opts ...grpc.CallOption) (*MsgUnjailCadanceContractResponse, error)
-	// UpdateParams defines a governance operation for updating the x/cadance module
-	// parameters. The authority is hard-coded to the x/gov module account.
-	//
-	// Since: cosmos-sdk 0.47
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+func (m *MsgCreateSchedule) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgCreateSchedule) GetMsg() string {
+	if m != nil {
+		return m.Msg
+	}
+	return ""
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgCreateSchedule) GetCallType() ScheduleCallType {
+	if m != nil {
+		return m.CallType
+	}
+	return ScheduleCallTypeSudo
 }
 
-func (c *msgClient) RegisterCadanceContract(ctx context.Context, in *MsgRegisterCadanceContract, opts ...grpc.CallOption) (*MsgRegisterCadanceContractResponse, error) {
-	out := new(MsgRegisterCadanceContractResponse)
-	err := c.cc.Invoke(ctx, "/bitsong.cadance.v1.Msg/RegisterCadanceContract", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCreateSchedule) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) UnregisterCadanceContract(ctx context.Context, in *MsgUnregisterCadanceContract, opts ...grpc.CallOption) (*MsgUnregisterCadanceContractResponse, error) {
-	out := new(MsgUnregisterCadanceContractResponse)
-	err := c.cc.Invoke(ctx, "/bitsong.cadance.v1.Msg/UnregisterCadanceContract", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCreateSchedule) GetRunAtHeight() int64 {
+	if m != nil {
+		return m.RunAtHeight
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) UnjailCadanceContract(ctx context.Context, in *MsgUnjailCadanceContract, opts ...grpc.CallOption) (*MsgUnjailCadanceContractResponse, error) {
-	out := new(MsgUnjailCadanceContractResponse)
-	err := c.cc.Invoke(ctx, "/bitsong.cadance.v1.Msg/UnjailCadanceContract", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCreateSchedule) GetRunAtTime() *time.Time {
+	if m != nil {
+		return m.RunAtTime
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/bitsong.cadance.v1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCreateSchedule) GetCron() string {
+	if m != nil {
+		return m.Cron
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// RegisterCadanceContract defines the endpoint for
-	// registering a new cadance contract .
-	RegisterCadanceContract(context.Context, *MsgRegisterCadanceContract) (*MsgRegisterCadanceContractResponse, error)
-	// UnregisterCadanceContract defines the endpoint for
-	// unregistering a cadance contract .
-	UnregisterCadanceContract(context.Context, *MsgUnregisterCadanceContract) (*MsgUnregisterCadanceContractResponse, error)
-	// UnjailCadanceContract defines the endpoint for
-	// unjailing a cadance contract .
-	UnjailCadanceContract(context.Context, *MsgUnjailCadanceContract) (*MsgUnjailCadanceContractResponse, error)
-	// UpdateParams defines a governance operation for updating the x/cadance module
-	// parameters. The authority is hard-coded to the x/gov module account.
-	//
-	// Since: cosmos-sdk 0.47
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+// MsgCreateScheduleResponse defines the response structure for executing a
+// MsgCreateSchedule message.
+type MsgCreateScheduleResponse struct {
+	// The id of the created schedule.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgCreateScheduleResponse) Reset()         { *m = MsgCreateScheduleResponse{} }
+func (m *MsgCreateScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateScheduleResponse) ProtoMessage()    {}
+func (*MsgCreateScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5bbd129cd018eed, []int{11}
 }
-
-func (*UnimplementedMsgServer) RegisterCadanceContract(ctx context.Context, req *MsgRegisterCadanceContract) (*MsgRegisterCadanceContractResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisterCadanceContract not implemented")
+func (m *MsgCreateScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) UnregisterCadanceContract(ctx context.Context, req *MsgUnregisterCadanceContract) (*MsgUnregisterCadanceContractResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnregisterCadanceContract not implemented")
+func (m *MsgCreateScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) UnjailCadanceContract(ctx context.Context, req *MsgUnjailCadanceContract) (*MsgUnjailCadanceContractResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnjailCadanceContract not implemented")
+func (m *MsgCreateScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateScheduleResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgCreateScheduleResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgCreateScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateScheduleResponse.DiscardUnknown(m)
 }
 
-func _Msg_RegisterCadanceContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRegisterCadanceContract)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RegisterCadanceContract(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/bitsong.cadance.v1.Msg/RegisterCadanceContract",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RegisterCadanceContract(ctx, req.(*MsgRegisterCadanceContract))
-	}
-	return interceptor(ctx, in, info, handler)
-}
+var xxx_messageInfo_MsgCreateScheduleResponse proto.InternalMessageInfo
 
-func _Msg_UnregisterCadanceContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUnregisterCadanceContract)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UnregisterCadanceContract(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/bitsong.cadance.v1.Msg/UnregisterCadanceContract",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UnregisterCadanceContract(ctx, req.(*MsgUnregisterCadanceContract))
+func (m *MsgCreateScheduleResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_UnjailCadanceContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUnjailCadanceContract)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UnjailCadanceContract(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/bitsong.cadance.v1.Msg/UnjailCadanceContract",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UnjailCadanceContract(ctx, req.(*MsgUnjailCadanceContract))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgCancelSchedule is the Msg/CancelSchedule request type.
+type MsgCancelSchedule struct {
+	// The address of the creator of the schedule.
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// The id of the schedule to cancel.
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/bitsong.cadance.v1.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgCancelSchedule) Reset()         { *m = MsgCancelSchedule{} }
+func (m *MsgCancelSchedule) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelSchedule) ProtoMessage()    {}
+func (*MsgCancelSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5bbd129cd018eed, []int{12}
 }
-
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "bitsong.cadance.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "RegisterCadanceContract",
-			Handler:    _Msg_RegisterCadanceContract_Handler,
-		},
-		{
-			MethodName: "UnregisterCadanceContract",
-			Handler:    _Msg_UnregisterCadanceContract_Handler,
-		},
-		{
-			MethodName: "UnjailCadanceContract",
-			Handler:    _Msg_UnjailCadanceContract_Handler,
-		},
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "bitsong/cadance/v1/tx.proto",
+func (m *MsgCancelSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgRegisterCadanceContract) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCancelSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgRegisterCadanceContract) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgCancelSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelSchedule.Merge(m, src)
+}
+func (m *MsgCancelSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelSchedule.DiscardUnknown(m)
 }
 
-func (m *MsgRegisterCadanceContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ContractAddress) > 0 {
-		i -= len(m.ContractAddress)
-		copy(dAtA[i:], m.ContractAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.SenderAddress) > 0 {
-		i -= len(m.SenderAddress)
-		copy(dAtA[i:], m.SenderAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SenderAddress)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_MsgCancelSchedule proto.InternalMessageInfo
+
+func (m *MsgCancelSchedule) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgRegisterCadanceContractResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCancelSchedule) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgRegisterCadanceContractResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgCancelScheduleResponse defines the response structure for executing a
+// MsgCancelSchedule message.
+type MsgCancelScheduleResponse struct {
 }
 
-func (m *MsgRegisterCadanceContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgCancelScheduleResponse) Reset()         { *m = MsgCancelScheduleResponse{} }
+func (m *MsgCancelScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelScheduleResponse) ProtoMessage()    {}
+func (*MsgCancelScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5bbd129cd018eed, []int{13}
 }
-
-func (m *MsgUnregisterCadanceContract) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCancelScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgUnregisterCadanceContract) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgCancelScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelScheduleResponse.Merge(m, src)
+}
+func (m *MsgCancelScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelScheduleResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUnregisterCadanceContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ContractAddress) > 0 {
-		i -= len(m.ContractAddress)
-		copy(dAtA[i:], m.ContractAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.SenderAddress) > 0 {
-		i -= len(m.SenderAddress)
-		copy(dAtA[i:], m.SenderAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SenderAddress)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_MsgCancelScheduleResponse proto.InternalMessageInfo
+
+// MsgUpdateParams is the Msg/UpdateParams request type.
+//
+// Since: cosmos-sdk 0.47
+type MsgUpdateParams struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the x/cadance parameters to update.
+	//
+	// NOTE: All parameters must be supplied.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5bbd129cd018eed, []int{14}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
 }
 
-func (m *MsgUnregisterCadanceContractResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUnregisterCadanceContractResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
 }
 
-func (m *MsgUnregisterCadanceContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgUpdateParamsResponse defines the response structure for executing a
+// MsgUpdateParams message.
+//
+// Since: cosmos-sdk 0.47
+type MsgUpdateParamsResponse struct {
 }
 
-func (m *MsgUnjailCadanceContract) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5bbd129cd018eed, []int{15}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUnjailCadanceContract) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+// MsgForceRegisterCadanceContract is the Msg/ForceRegisterCadanceContract
+// request type.
+type MsgForceRegisterCadanceContract struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// The address of the contract to register.
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// The phases of the block in which the contract is executed.
+	Phase ExecutionPhase `protobuf:"varint,3,opt,name=phase,proto3,enum=bitsong.cadance.v1.ExecutionPhase" json:"phase,omitempty"`
+	// The number of blocks between two executions of the contract, the
+	// contract is executed every block when zero.
+	ExecutionInterval uint64 `protobuf:"varint,4,opt,name=execution_interval,json=executionInterval,proto3" json:"execution_interval,omitempty"`
+	// The height from which the contract is executed.
+	StartHeight int64 `protobuf:"varint,5,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// The gas limit of the contract executions, the contract_gas_limit param is
+	// used when zero.
+	GasLimit uint64 `protobuf:"varint,6,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// The custom JSON payload sent to the contract.
+	SudoPayload string `protobuf:"bytes,7,opt,name=sudo_payload,json=sudoPayload,proto3" json:"sudo_payload,omitempty"`
+	// The schema of the sudo message sent to the contract.
+	SudoMessageVersion SudoMessageVersion `protobuf:"varint,8,opt,name=sudo_message_version,json=sudoMessageVersion,proto3,enum=bitsong.cadance.v1.SudoMessageVersion" json:"sudo_message_version,omitempty"`
+	// The priority of the contract, which is not bounded by the max_priority
+	// param.
+	Priority uint32 `protobuf:"varint,9,opt,name=priority,proto3" json:"priority,omitempty"`
 }
 
-func (m *MsgUnjailCadanceContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ContractAddress) > 0 {
-		i -= len(m.ContractAddress)
-		copy(dAtA[i:], m.ContractAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.SenderAddress) > 0 {
-		i -= len(m.SenderAddress)
-		copy(dAtA[i:], m.SenderAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SenderAddress)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgForceRegisterCadanceContract) Reset()         { *m = MsgForceRegisterCadanceContract{} }
+func (m *MsgForceRegisterCadanceContract) String() string { return proto.CompactTextString(m) }
+func (*MsgForceRegisterCadanceContract) ProtoMessage()    {}
+func (*MsgForceRegisterCadanceContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5bbd129cd018eed, []int{16}
+}
+func (m *MsgForceRegisterCadanceContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgForceRegisterCadanceContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgForceRegisterCadanceContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgForceRegisterCadanceContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgForceRegisterCadanceContract.Merge(m, src)
+}
+func (m *MsgForceRegisterCadanceContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgForceRegisterCadanceContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgForceRegisterCadanceContract.DiscardUnknown(m)
 }
 
-func (m *MsgUnjailCadanceContractResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgForceRegisterCadanceContract proto.InternalMessageInfo
+
+func (m *MsgForceRegisterCadanceContract) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUnjailCadanceContractResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgForceRegisterCadanceContract) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
 }
 
-func (m *MsgUnjailCadanceContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgForceRegisterCadanceContract) GetPhase() ExecutionPhase {
+	if m != nil {
+		return m.Phase
+	}
+	return ExecutionPhaseEndBlock
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgForceRegisterCadanceContract) GetExecutionInterval() uint64 {
+	if m != nil {
+		return m.ExecutionInterval
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgForceRegisterCadanceContract) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *MsgForceRegisterCadanceContract) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *MsgForceRegisterCadanceContract) GetSudoPayload() string {
+	if m != nil {
+		return m.SudoPayload
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgForceRegisterCadanceContract) GetSudoMessageVersion() SudoMessageVersion {
+	if m != nil {
+		return m.SudoMessageVersion
 	}
-	return dAtA[:n], nil
+	return SudoMessageVersionV1
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgForceRegisterCadanceContract) GetPriority() uint32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgForceRegisterCadanceContractResponse defines the response structure for
+// executing a MsgForceRegisterCadanceContract message.
+type MsgForceRegisterCadanceContractResponse struct {
+	// The id assigned to the registration of the contract.
+	RegistrationId uint64 `protobuf:"varint,1,opt,name=registration_id,json=registrationId,proto3" json:"registration_id,omitempty"`
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *MsgForceRegisterCadanceContractResponse) Reset() {
+	*m = MsgForceRegisterCadanceContractResponse{}
 }
-func (m *MsgRegisterCadanceContract) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgForceRegisterCadanceContractResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgForceRegisterCadanceContractResponse) ProtoMessage()    {}
+func (*MsgForceRegisterCadanceContractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5bbd129cd018eed, []int{17}
+}
+func (m *MsgForceRegisterCadanceContractResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgForceRegisterCadanceContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgForceRegisterCadanceContractResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.SenderAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgForceRegisterCadanceContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgForceRegisterCadanceContractResponse.Merge(m, src)
+}
+func (m *MsgForceRegisterCadanceContractResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgForceRegisterCadanceContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgForceRegisterCadanceContractResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgForceRegisterCadanceContractResponse proto.InternalMessageInfo
+
+func (m *MsgForceRegisterCadanceContractResponse) GetRegistrationId() uint64 {
+	if m != nil {
+		return m.RegistrationId
 	}
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return 0
+}
+
+// MsgForceJailCadanceContract is the Msg/ForceJailCadanceContract request
+// type.
+type MsgForceJailCadanceContract struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// The address of the contract to jail.
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// The reason the contract is jailed.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *MsgForceJailCadanceContract) Reset()         { *m = MsgForceJailCadanceContract{} }
+func (m *MsgForceJailCadanceContract) String() string { return proto.CompactTextString(m) }
+func (*MsgForceJailCadanceContract) ProtoMessage()    {}
+func (*MsgForceJailCadanceContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5bbd129cd018eed, []int{18}
+}
+func (m *MsgForceJailCadanceContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgForceJailCadanceContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgForceJailCadanceContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgForceJailCadanceContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgForceJailCadanceContract.Merge(m, src)
+}
+func (m *MsgForceJailCadanceContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgForceJailCadanceContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgForceJailCadanceContract.DiscardUnknown(m)
 }
 
-func (m *MsgRegisterCadanceContractResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgForceJailCadanceContract proto.InternalMessageInfo
+
+func (m *MsgForceJailCadanceContract) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *MsgUnregisterCadanceContract) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgForceJailCadanceContract) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
 	}
-	var l int
-	_ = l
-	l = len(m.SenderAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgForceJailCadanceContract) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+// MsgForceJailCadanceContractResponse defines the response structure for
+// executing a MsgForceJailCadanceContract message.
+type MsgForceJailCadanceContractResponse struct {
+}
+
+func (m *MsgForceJailCadanceContractResponse) Reset()         { *m = MsgForceJailCadanceContractResponse{} }
+func (m *MsgForceJailCadanceContractResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgForceJailCadanceContractResponse) ProtoMessage()    {}
+func (*MsgForceJailCadanceContractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5bbd129cd018eed, []int{19}
+}
+func (m *MsgForceJailCadanceContractResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgForceJailCadanceContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgForceJailCadanceContractResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgForceJailCadanceContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgForceJailCadanceContractResponse.Merge(m, src)
+}
+func (m *MsgForceJailCadanceContractResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgForceJailCadanceContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgForceJailCadanceContractResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUnregisterCadanceContractResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgForceJailCadanceContractResponse proto.InternalMessageInfo
+
+// MsgForceUnregisterCadanceContract is the Msg/ForceUnregisterCadanceContract
+// request type.
+type MsgForceUnregisterCadanceContract struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// The address of the contract to unregister.
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+}
+
+func (m *MsgForceUnregisterCadanceContract) Reset()         { *m = MsgForceUnregisterCadanceContract{} }
+func (m *MsgForceUnregisterCadanceContract) String() string { return proto.CompactTextString(m) }
+func (*MsgForceUnregisterCadanceContract) ProtoMessage()    {}
+func (*MsgForceUnregisterCadanceContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5bbd129cd018eed, []int{20}
+}
+func (m *MsgForceUnregisterCadanceContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgForceUnregisterCadanceContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgForceUnregisterCadanceContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *MsgForceUnregisterCadanceContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgForceUnregisterCadanceContract.Merge(m, src)
+}
+func (m *MsgForceUnregisterCadanceContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgForceUnregisterCadanceContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgForceUnregisterCadanceContract.DiscardUnknown(m)
 }
 
-func (m *MsgUnjailCadanceContract) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgForceUnregisterCadanceContract proto.InternalMessageInfo
+
+func (m *MsgForceUnregisterCadanceContract) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	var l int
-	_ = l
-	l = len(m.SenderAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgForceUnregisterCadanceContract) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
 	}
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+// MsgForceUnregisterCadanceContractResponse defines the response structure for
+// executing a MsgForceUnregisterCadanceContract message.
+type MsgForceUnregisterCadanceContractResponse struct {
+}
+
+func (m *MsgForceUnregisterCadanceContractResponse) Reset() {
+	*m = MsgForceUnregisterCadanceContractResponse{}
+}
+func (m *MsgForceUnregisterCadanceContractResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgForceUnregisterCadanceContractResponse) ProtoMessage() {}
+func (*MsgForceUnregisterCadanceContractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5bbd129cd018eed, []int{21}
+}
+func (m *MsgForceUnregisterCadanceContractResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgForceUnregisterCadanceContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgForceUnregisterCadanceContractResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgForceUnregisterCadanceContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgForceUnregisterCadanceContractResponse.Merge(m, src)
+}
+func (m *MsgForceUnregisterCadanceContractResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgForceUnregisterCadanceContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgForceUnregisterCadanceContractResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUnjailCadanceContractResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgForceUnregisterCadanceContractResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgRegisterCadanceContract)(nil), "bitsong.cadance.v1.MsgRegisterCadanceContract")
+	proto.RegisterType((*MsgRegisterCadanceContractResponse)(nil), "bitsong.cadance.v1.MsgRegisterCadanceContractResponse")
+	proto.RegisterType((*MsgUnregisterCadanceContract)(nil), "bitsong.cadance.v1.MsgUnregisterCadanceContract")
+	proto.RegisterType((*MsgUnregisterCadanceContractResponse)(nil), "bitsong.cadance.v1.MsgUnregisterCadanceContractResponse")
+	proto.RegisterType((*MsgUpdateCadanceContract)(nil), "bitsong.cadance.v1.MsgUpdateCadanceContract")
+	proto.RegisterType((*MsgUpdateCadanceContractResponse)(nil), "bitsong.cadance.v1.MsgUpdateCadanceContractResponse")
+	proto.RegisterType((*MsgUnjailCadanceContract)(nil), "bitsong.cadance.v1.MsgUnjailCadanceContract")
+	proto.RegisterType((*MsgUnjailCadanceContractResponse)(nil), "bitsong.cadance.v1.MsgUnjailCadanceContractResponse")
+	proto.RegisterType((*MsgConfirmCadanceContract)(nil), "bitsong.cadance.v1.MsgConfirmCadanceContract")
+	proto.RegisterType((*MsgConfirmCadanceContractResponse)(nil), "bitsong.cadance.v1.MsgConfirmCadanceContractResponse")
+	proto.RegisterType((*MsgCreateSchedule)(nil), "bitsong.cadance.v1.MsgCreateSchedule")
+	proto.RegisterType((*MsgCreateScheduleResponse)(nil), "bitsong.cadance.v1.MsgCreateScheduleResponse")
+	proto.RegisterType((*MsgCancelSchedule)(nil), "bitsong.cadance.v1.MsgCancelSchedule")
+	proto.RegisterType((*MsgCancelScheduleResponse)(nil), "bitsong.cadance.v1.MsgCancelScheduleResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "bitsong.cadance.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "bitsong.cadance.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgForceRegisterCadanceContract)(nil), "bitsong.cadance.v1.MsgForceRegisterCadanceContract")
+	proto.RegisterType((*MsgForceRegisterCadanceContractResponse)(nil), "bitsong.cadance.v1.MsgForceRegisterCadanceContractResponse")
+	proto.RegisterType((*MsgForceJailCadanceContract)(nil), "bitsong.cadance.v1.MsgForceJailCadanceContract")
+	proto.RegisterType((*MsgForceJailCadanceContractResponse)(nil), "bitsong.cadance.v1.MsgForceJailCadanceContractResponse")
+	proto.RegisterType((*MsgForceUnregisterCadanceContract)(nil), "bitsong.cadance.v1.MsgForceUnregisterCadanceContract")
+	proto.RegisterType((*MsgForceUnregisterCadanceContractResponse)(nil), "bitsong.cadance.v1.MsgForceUnregisterCadanceContractResponse")
+}
+
+func init() { proto.RegisterFile("bitsong/cadance/v1/tx.proto", fileDescriptor_e5bbd129cd018eed) }
+
+var fileDescriptor_e5bbd129cd018eed = []byte{
+	// 1295 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0xcf, 0x6f, 0xdc, 0xc4,
+	0x17, 0x8f, 0x77, 0xb7, 0x69, 0xf6, 0xa5, 0xd9, 0xb6, 0xf3, 0xed, 0xb7, 0x75, 0x9c, 0xb2, 0xd9,
+	0x38, 0x4d, 0xba, 0xa5, 0xec, 0x9a, 0xa6, 0x3f, 0xa8, 0x8a, 0x90, 0x68, 0x22, 0x10, 0x05, 0x56,
+	0x44, 0x6e, 0x41, 0x88, 0xcb, 0x32, 0xb1, 0x67, 0xbd, 0x46, 0xb6, 0xc7, 0x9a, 0xf1, 0x46, 0xcd,
+	0xb5, 0xe2, 0x0f, 0xa8, 0x54, 0xa9, 0x77, 0x38, 0x54, 0x88, 0x13, 0x42, 0xfc, 0x11, 0xbd, 0x20,
+	0x55, 0x45, 0x42, 0x9c, 0x00, 0xb5, 0x48, 0xfc, 0x1b, 0xc8, 0x33, 0xb6, 0xcb, 0x26, 0xf6, 0x66,
+	0x37, 0x6a, 0x7a, 0xe2, 0x12, 0x79, 0xde, 0x7c, 0xde, 0x7b, 0x9f, 0x99, 0xf7, 0x99, 0x99, 0xb7,
+	0x81, 0x85, 0x2d, 0x37, 0xe2, 0x34, 0x70, 0x0c, 0x0b, 0xdb, 0x38, 0xb0, 0x88, 0xb1, 0x7d, 0xc9,
+	0x88, 0xee, 0xb6, 0x43, 0x46, 0x23, 0x8a, 0x50, 0x32, 0xd9, 0x4e, 0x26, 0xdb, 0xdb, 0x97, 0xb4,
+	0xb3, 0x0e, 0xa5, 0x8e, 0x47, 0x0c, 0x1c, 0xba, 0x06, 0x0e, 0x02, 0x1a, 0xe1, 0xc8, 0xa5, 0x01,
+	0x97, 0x1e, 0xda, 0x19, 0x8b, 0x72, 0x9f, 0x72, 0xc3, 0xe7, 0x4e, 0x1c, 0xc9, 0xe7, 0x4e, 0x32,
+	0xd1, 0xc8, 0xc9, 0xe3, 0x90, 0x80, 0x70, 0x97, 0x8f, 0x40, 0xa4, 0x79, 0x25, 0x62, 0x29, 0x07,
+	0xc1, 0xad, 0x3e, 0xb1, 0x07, 0x5e, 0x0a, 0x59, 0x4c, 0xd8, 0x89, 0xd1, 0xd6, 0xa0, 0x67, 0x44,
+	0xae, 0x4f, 0x78, 0x84, 0xfd, 0x30, 0x01, 0x9c, 0x72, 0xa8, 0x43, 0xc5, 0xa7, 0x11, 0x7f, 0x25,
+	0xd6, 0x79, 0x49, 0xbb, 0x2b, 0x27, 0xe4, 0x20, 0x99, 0x3a, 0x89, 0x7d, 0x37, 0xa0, 0x86, 0xf8,
+	0x2b, 0x4d, 0xfa, 0xcf, 0x65, 0xd0, 0x3a, 0xdc, 0x31, 0x89, 0xe3, 0xf2, 0x88, 0xb0, 0x0d, 0xc9,
+	0x66, 0x83, 0x06, 0x11, 0xc3, 0x56, 0x84, 0x56, 0xa0, 0xc6, 0x49, 0x60, 0x13, 0xd6, 0xc5, 0xb6,
+	0xcd, 0x08, 0xe7, 0xaa, 0xd2, 0x50, 0x9a, 0x55, 0x73, 0x4e, 0x5a, 0x6f, 0x4a, 0x23, 0xba, 0x00,
+	0x27, 0xac, 0xc4, 0x25, 0x03, 0x96, 0x04, 0xf0, 0x78, 0x6a, 0x4f, 0xa1, 0xd7, 0xe1, 0x48, 0xd8,
+	0xc7, 0x9c, 0xa8, 0xe5, 0x86, 0xd2, 0xac, 0xad, 0xe9, 0xed, 0xbd, 0x75, 0x69, 0xbf, 0x77, 0x97,
+	0x58, 0x83, 0xb8, 0x14, 0x9b, 0x31, 0xd2, 0x94, 0x0e, 0xa8, 0x05, 0x88, 0xa4, 0x13, 0x5d, 0x37,
+	0x88, 0x08, 0xdb, 0xc6, 0x9e, 0x5a, 0x69, 0x28, 0xcd, 0x8a, 0x79, 0x32, 0x9b, 0xb9, 0x95, 0x4c,
+	0xa0, 0x25, 0x38, 0xc6, 0x23, 0xcc, 0xa2, 0x6e, 0x9f, 0xb8, 0x4e, 0x3f, 0x52, 0x8f, 0x34, 0x94,
+	0x66, 0xd9, 0x9c, 0x15, 0xb6, 0x0f, 0x84, 0x09, 0x2d, 0x40, 0xd5, 0xc1, 0xbc, 0xeb, 0xb9, 0xbe,
+	0x1b, 0xa9, 0xd3, 0x22, 0xd0, 0x8c, 0x83, 0xf9, 0xc7, 0xf1, 0x58, 0xf8, 0x0f, 0x6c, 0xda, 0x0d,
+	0xf1, 0x8e, 0x47, 0xb1, 0xad, 0x1e, 0x15, 0xeb, 0x99, 0x8d, 0x6d, 0x9b, 0xd2, 0x84, 0x3e, 0x87,
+	0x53, 0x02, 0xe2, 0x13, 0xce, 0xb1, 0x43, 0xba, 0xdb, 0x84, 0x71, 0x97, 0x06, 0xea, 0x8c, 0x58,
+	0xda, 0x6a, 0xde, 0xd2, 0x6e, 0x0f, 0x6c, 0xda, 0x91, 0xf0, 0xcf, 0x24, 0xda, 0x44, 0x7c, 0x8f,
+	0x2d, 0x66, 0xd6, 0x23, 0x24, 0xce, 0x4d, 0x98, 0x5a, 0x15, 0x99, 0x67, 0x7a, 0x84, 0x6c, 0xc6,
+	0x63, 0xa4, 0xc1, 0x4c, 0xc8, 0x5c, 0xca, 0xdc, 0x68, 0x47, 0x85, 0x86, 0xd2, 0x9c, 0x33, 0xb3,
+	0xb1, 0xde, 0x01, 0xbd, 0xb8, 0x9c, 0x26, 0xe1, 0x21, 0x0d, 0x38, 0x41, 0xe7, 0xe1, 0x38, 0x13,
+	0x10, 0x86, 0xe5, 0x6e, 0xda, 0xa2, 0xae, 0x15, 0xb3, 0xf6, 0x6f, 0xf3, 0x2d, 0x5b, 0x0f, 0xe1,
+	0x6c, 0x87, 0x3b, 0x9f, 0x06, 0xec, 0x55, 0xe9, 0x43, 0x5f, 0x85, 0x73, 0xa3, 0x32, 0xa6, 0x4b,
+	0xd0, 0x1f, 0x95, 0x41, 0x8d, 0x81, 0xa1, 0x8d, 0x23, 0x72, 0xf8, 0xb2, 0xcd, 0x17, 0x5f, 0x79,
+	0x5c, 0xf1, 0x55, 0xf6, 0x11, 0xdf, 0x91, 0x7d, 0xc4, 0x37, 0x3d, 0xbe, 0xf8, 0x8e, 0xbe, 0x5c,
+	0xf1, 0xcd, 0x8c, 0x10, 0x5f, 0x75, 0x58, 0x7c, 0x37, 0xfe, 0x77, 0xef, 0xef, 0x1f, 0x5e, 0xdf,
+	0xb5, 0xf3, 0xba, 0x0e, 0x8d, 0xa2, 0x3a, 0x65, 0xc5, 0xf4, 0x64, 0x2d, 0x83, 0xaf, 0xb0, 0xeb,
+	0x1d, 0xbe, 0xc4, 0x12, 0x46, 0x79, 0xd9, 0x32, 0x46, 0xf7, 0x14, 0x98, 0xef, 0x70, 0x67, 0x83,
+	0x06, 0x3d, 0x97, 0xf9, 0x87, 0xce, 0x29, 0x7f, 0xeb, 0x96, 0x61, 0xa9, 0x90, 0x43, 0xc6, 0xf4,
+	0x69, 0x09, 0x4e, 0xc6, 0x28, 0x46, 0x70, 0x44, 0x6e, 0x27, 0x4f, 0x08, 0x52, 0xe1, 0xa8, 0x15,
+	0x5b, 0x28, 0x4b, 0xa8, 0xa5, 0xc3, 0x49, 0x44, 0x7f, 0x02, 0xca, 0x3e, 0x77, 0x84, 0xca, 0xab,
+	0x66, 0xfc, 0x89, 0x6e, 0x42, 0xd5, 0xc2, 0x9e, 0xd7, 0x8d, 0x76, 0x42, 0x22, 0x44, 0x5d, 0x5b,
+	0x3b, 0x97, 0xab, 0xb4, 0x84, 0xc7, 0x06, 0xf6, 0xbc, 0x3b, 0x3b, 0x21, 0x31, 0x67, 0xac, 0xe4,
+	0x6b, 0xb4, 0xee, 0x75, 0x98, 0x63, 0x83, 0xa0, 0x8b, 0xb3, 0x83, 0x33, 0x2d, 0x0f, 0x0e, 0x1b,
+	0x04, 0x37, 0xd3, 0x83, 0xf3, 0x2e, 0xcc, 0x26, 0x98, 0xf8, 0x41, 0x14, 0x7a, 0x9f, 0x5d, 0xd3,
+	0xda, 0xf2, 0xb5, 0x6c, 0xa7, 0xaf, 0x65, 0xfb, 0x4e, 0xfa, 0x5a, 0xae, 0x57, 0xee, 0xff, 0xb1,
+	0xa8, 0x98, 0x55, 0x11, 0x23, 0xb6, 0x22, 0x04, 0x15, 0x8b, 0x25, 0xf7, 0x74, 0xd5, 0x14, 0xdf,
+	0x37, 0x8e, 0xc5, 0x05, 0x48, 0x37, 0x49, 0xbf, 0x28, 0xab, 0x3f, 0xb4, 0xa7, 0xd9, 0xed, 0x59,
+	0x83, 0x52, 0x76, 0x61, 0x96, 0x5c, 0x5b, 0xff, 0x48, 0x16, 0x20, 0x5e, 0xbb, 0x37, 0x46, 0x01,
+	0xa4, 0x7b, 0x29, 0x75, 0xdf, 0x95, 0x79, 0x01, 0xe6, 0xf7, 0x04, 0xcb, 0x6a, 0xfd, 0x40, 0x81,
+	0xe3, 0xd9, 0x61, 0xda, 0xc4, 0x0c, 0xfb, 0x1c, 0x5d, 0x83, 0x2a, 0x1e, 0x44, 0x7d, 0x79, 0x22,
+	0x45, 0xaa, 0x75, 0xf5, 0xe9, 0x4f, 0xad, 0x53, 0xc9, 0xcb, 0x9f, 0xd4, 0xf2, 0x76, 0xc4, 0xdc,
+	0xc0, 0x31, 0x5f, 0x40, 0xd1, 0x75, 0x98, 0x0e, 0x45, 0x04, 0xb5, 0x94, 0xec, 0x60, 0x4e, 0x1d,
+	0x65, 0x8e, 0xf5, 0xca, 0xe3, 0xdf, 0x17, 0xa7, 0xcc, 0x04, 0x7f, 0xa3, 0x16, 0x13, 0x7e, 0x11,
+	0x49, 0x9f, 0x87, 0x33, 0xbb, 0x48, 0x65, 0x84, 0x7f, 0x2d, 0xc3, 0x62, 0x87, 0x3b, 0xef, 0x53,
+	0x66, 0x91, 0xa2, 0x1e, 0xe3, 0xa0, 0x0b, 0xf8, 0xaf, 0xe9, 0x78, 0xc9, 0x4d, 0xc7, 0xa8, 0xab,
+	0x7d, 0x77, 0xcd, 0x4d, 0x38, 0xbf, 0x4f, 0x5d, 0x27, 0x6f, 0x36, 0xbe, 0x53, 0x60, 0x21, 0x0d,
+	0xfa, 0x61, 0xce, 0x4b, 0xf0, 0x0a, 0x84, 0x72, 0x1a, 0xa6, 0x19, 0xc1, 0x9c, 0x06, 0xc9, 0xa5,
+	0x97, 0x8c, 0xf6, 0x2c, 0x7f, 0x05, 0x96, 0x47, 0x30, 0xcd, 0xe4, 0xff, 0x50, 0x81, 0xa5, 0x14,
+	0x57, 0xdc, 0x44, 0x1d, 0xfe, 0xba, 0xf6, 0xf0, 0xbf, 0x08, 0x17, 0xf6, 0xe5, 0x95, 0xae, 0x62,
+	0xed, 0x9b, 0x39, 0x28, 0x77, 0xb8, 0x83, 0xbe, 0x57, 0xe0, 0x4c, 0xd1, 0x21, 0x6e, 0xe7, 0xe9,
+	0xae, 0xb8, 0x13, 0xd5, 0xae, 0x4d, 0x86, 0xcf, 0x76, 0xf4, 0xfc, 0xbd, 0x5f, 0xfe, 0x7a, 0x50,
+	0x5a, 0xd2, 0x17, 0x8d, 0xdc, 0x1f, 0x7b, 0x46, 0xba, 0x16, 0xf4, 0xa3, 0x02, 0xf3, 0xc5, 0x5b,
+	0xfe, 0x66, 0x41, 0xfa, 0x42, 0x0f, 0xed, 0xfa, 0xa4, 0x1e, 0x19, 0xe5, 0x0b, 0x82, 0xf2, 0xb2,
+	0xbe, 0x54, 0x40, 0x79, 0x90, 0x45, 0x40, 0xdf, 0x2a, 0xf0, 0xff, 0xfc, 0x8e, 0xf6, 0x8d, 0xa2,
+	0xf4, 0x79, 0x68, 0xed, 0xca, 0x24, 0xe8, 0x8c, 0xe8, 0x8a, 0x20, 0xba, 0xa8, 0xbf, 0x56, 0x44,
+	0x54, 0x78, 0x4b, 0x92, 0xb9, 0xad, 0x5a, 0x21, 0xc9, 0x3c, 0xb4, 0x76, 0x65, 0x12, 0xf4, 0xf8,
+	0x24, 0x85, 0x37, 0x7a, 0xa4, 0xc0, 0xe9, 0x82, 0xe6, 0xad, 0x55, 0x90, 0x37, 0x1f, 0xae, 0x5d,
+	0x9d, 0x08, 0x9e, 0xf1, 0x5c, 0x15, 0x3c, 0x1b, 0x7a, 0xbd, 0x80, 0xa7, 0x25, 0xdd, 0xd1, 0x43,
+	0x05, 0x6a, 0xbb, 0x7a, 0xb7, 0x95, 0xa2, 0x8c, 0x43, 0x30, 0xad, 0x35, 0x16, 0x2c, 0x23, 0xd4,
+	0x16, 0x84, 0x9a, 0xfa, 0x6a, 0x01, 0xa1, 0xf4, 0xbf, 0x0f, 0x86, 0xe8, 0x44, 0x88, 0x24, 0x36,
+	0xdc, 0xd3, 0x14, 0x12, 0x1b, 0x82, 0x69, 0xad, 0xb1, 0x60, 0x07, 0x20, 0x26, 0xfc, 0xd1, 0x97,
+	0x70, 0x6c, 0xa8, 0x01, 0x5a, 0x1e, 0x29, 0x76, 0x09, 0xd2, 0x2e, 0x8e, 0x01, 0xca, 0x5e, 0xac,
+	0xfb, 0x0a, 0x9c, 0x1d, 0xd9, 0xb2, 0x5c, 0x2e, 0x88, 0x36, 0xca, 0x49, 0x7b, 0xfb, 0x00, 0x4e,
+	0x19, 0xa5, 0xaf, 0x15, 0x50, 0x0b, 0x1f, 0x46, 0x63, 0x54, 0xe4, 0x1c, 0x07, 0xed, 0xad, 0x09,
+	0x1d, 0x32, 0x1a, 0x0f, 0x15, 0xa8, 0xef, 0xf3, 0x9a, 0x5d, 0x1d, 0x15, 0xbb, 0xf8, 0x7e, 0x7d,
+	0xe7, 0x40, 0x6e, 0x29, 0xb1, 0xf5, 0x4f, 0x1e, 0x3f, 0xab, 0x2b, 0x4f, 0x9e, 0xd5, 0x95, 0x3f,
+	0x9f, 0xd5, 0x95, 0xfb, 0xcf, 0xeb, 0x53, 0x4f, 0x9e, 0xd7, 0xa7, 0x7e, 0x7b, 0x5e, 0x9f, 0xfa,
+	0xe2, 0xaa, 0xe3, 0x46, 0xfd, 0xc1, 0x56, 0xdb, 0xa2, 0x7e, 0x2a, 0x30, 0xda, 0xeb, 0xb9, 0x96,
+	0x8b, 0x3d, 0xc3, 0xa1, 0xad, 0x54, 0x73, 0x77, 0x33, 0xd5, 0xc5, 0xbf, 0x6d, 0xf8, 0xd6, 0xb4,
+	0xf8, 0x21, 0x71, 0xf9, 0x9f, 0x01, 0x00, 0x74, 0xa9, 0x8a, 0x24, 0x55, 0x14, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// RegisterCadanceContract defines the endpoint for
+	// registering a new cadance contract .
+	RegisterCadanceContract(ctx context.Context, in *MsgRegisterCadanceContract, opts ...grpc.CallOption) (*MsgRegisterCadanceContractResponse, error)
+	// UnregisterCadanceContract defines the endpoint for
+	// unregistering a cadance contract .
+	UnregisterCadanceContract(ctx context.Context, in *MsgUnregisterCadanceContract, opts ...grpc.CallOption) (*MsgUnregisterCadanceContractResponse, error)
+	// UpdateCadanceContract defines the endpoint for
+	// updating the execution interval and the gas limit of a cadance contract .
+	UpdateCadanceContract(ctx context.Context, in *MsgUpdateCadanceContract, opts ...grpc.CallOption) (*MsgUpdateCadanceContractResponse, error)
+	// UnjailCadanceContract defines the endpoint for
+	// unjailing a cadance contract .
+	UnjailCadanceContract(ctx context.Context, in *MsgUnjailCadanceContract, opts ...grpc.CallOption) (*MsgUnjailCadanceContractResponse, error)
+	// ConfirmCadanceContract defines the endpoint for
+	// confirming a cadance contract after its code or its manager changed.
+	ConfirmCadanceContract(ctx context.Context, in *MsgConfirmCadanceContract, opts ...grpc.CallOption) (*MsgConfirmCadanceContractResponse, error)
+	// CreateSchedule defines the endpoint for
+	// scheduling a one-off or cron contract call.
+	CreateSchedule(ctx context.Context, in *MsgCreateSchedule, opts ...grpc.CallOption) (*MsgCreateScheduleResponse, error)
+	// CancelSchedule defines the endpoint for
+	// cancelling a scheduled contract call.
+	CancelSchedule(ctx context.Context, in *MsgCancelSchedule, opts ...grpc.CallOption) (*MsgCancelScheduleResponse, error)
+	// UpdateParams defines a governance operation for updating the x/cadance module
+	// parameters. The authority is hard-coded to the x/gov module account.
+	//
+	// Since: cosmos-sdk 0.47
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// ForceRegisterCadanceContract defines a governance operation for registering
+	// a cadance contract on behalf of its admin or creator, without deposit.
+	ForceRegisterCadanceContract(ctx context.Context, in *MsgForceRegisterCadanceContract, opts ...grpc.CallOption) (*MsgForceRegisterCadanceContractResponse, error)
+	// ForceJailCadanceContract defines a governance operation for jailing a
+	// cadance contract until it is unregistered.
+	ForceJailCadanceContract(ctx context.Context, in *MsgForceJailCadanceContract, opts ...grpc.CallOption) (*MsgForceJailCadanceContractResponse, error)
+	// ForceUnregisterCadanceContract defines a governance operation for
+	// unregistering a cadance contract, refunding its deposit.
+	ForceUnregisterCadanceContract(ctx context.Context, in *MsgForceUnregisterCadanceContract, opts ...grpc.CallOption) (*MsgForceUnregisterCadanceContractResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) RegisterCadanceContract(ctx context.Context, in *MsgRegisterCadanceContract, opts ...grpc.CallOption) (*MsgRegisterCadanceContractResponse, error) {
+	out := new(MsgRegisterCadanceContractResponse)
+	err := c.cc.Invoke(ctx, "/bitsong.cadance.v1.Msg/RegisterCadanceContract", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) UnregisterCadanceContract(ctx context.Context, in *MsgUnregisterCadanceContract, opts ...grpc.CallOption) (*MsgUnregisterCadanceContractResponse, error) {
+	out := new(MsgUnregisterCadanceContractResponse)
+	err := c.cc.Invoke(ctx, "/bitsong.cadance.v1.Msg/UnregisterCadanceContract", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) UpdateCadanceContract(ctx context.Context, in *MsgUpdateCadanceContract, opts ...grpc.CallOption) (*MsgUpdateCadanceContractResponse, error) {
+	out := new(MsgUpdateCadanceContractResponse)
+	err := c.cc.Invoke(ctx, "/bitsong.cadance.v1.Msg/UpdateCadanceContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnjailCadanceContract(ctx context.Context, in *MsgUnjailCadanceContract, opts ...grpc.CallOption) (*MsgUnjailCadanceContractResponse, error) {
+	out := new(MsgUnjailCadanceContractResponse)
+	err := c.cc.Invoke(ctx, "/bitsong.cadance.v1.Msg/UnjailCadanceContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ConfirmCadanceContract(ctx context.Context, in *MsgConfirmCadanceContract, opts ...grpc.CallOption) (*MsgConfirmCadanceContractResponse, error) {
+	out := new(MsgConfirmCadanceContractResponse)
+	err := c.cc.Invoke(ctx, "/bitsong.cadance.v1.Msg/ConfirmCadanceContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateSchedule(ctx context.Context, in *MsgCreateSchedule, opts ...grpc.CallOption) (*MsgCreateScheduleResponse, error) {
+	out := new(MsgCreateScheduleResponse)
+	err := c.cc.Invoke(ctx, "/bitsong.cadance.v1.Msg/CreateSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelSchedule(ctx context.Context, in *MsgCancelSchedule, opts ...grpc.CallOption) (*MsgCancelScheduleResponse, error) {
+	out := new(MsgCancelScheduleResponse)
+	err := c.cc.Invoke(ctx, "/bitsong.cadance.v1.Msg/CancelSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/bitsong.cadance.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ForceRegisterCadanceContract(ctx context.Context, in *MsgForceRegisterCadanceContract, opts ...grpc.CallOption) (*MsgForceRegisterCadanceContractResponse, error) {
+	out := new(MsgForceRegisterCadanceContractResponse)
+	err := c.cc.Invoke(ctx, "/bitsong.cadance.v1.Msg/ForceRegisterCadanceContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ForceJailCadanceContract(ctx context.Context, in *MsgForceJailCadanceContract, opts ...grpc.CallOption) (*MsgForceJailCadanceContractResponse, error) {
+	out := new(MsgForceJailCadanceContractResponse)
+	err := c.cc.Invoke(ctx, "/bitsong.cadance.v1.Msg/ForceJailCadanceContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ForceUnregisterCadanceContract(ctx context.Context, in *MsgForceUnregisterCadanceContract, opts ...grpc.CallOption) (*MsgForceUnregisterCadanceContractResponse, error) {
+	out := new(MsgForceUnregisterCadanceContractResponse)
+	err := c.cc.Invoke(ctx, "/bitsong.cadance.v1.Msg/ForceUnregisterCadanceContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// RegisterCadanceContract defines the endpoint for
+	// registering a new cadance contract .
+	RegisterCadanceContract(context.Context, *MsgRegisterCadanceContract) (*MsgRegisterCadanceContractResponse, error)
+	// UnregisterCadanceContract defines the endpoint for
+	// unregistering a cadance contract .
+	UnregisterCadanceContract(context.Context, *MsgUnregisterCadanceContract) (*MsgUnregisterCadanceContractResponse, error)
+	// UpdateCadanceContract defines the endpoint for
+	// updating the execution interval and the gas limit of a cadance contract .
+	UpdateCadanceContract(context.Context, *MsgUpdateCadanceContract) (*MsgUpdateCadanceContractResponse, error)
+	// UnjailCadanceContract defines the endpoint for
+	// unjailing a cadance contract .
+	UnjailCadanceContract(context.Context, *MsgUnjailCadanceContract) (*MsgUnjailCadanceContractResponse, error)
+	// ConfirmCadanceContract defines the endpoint for
+	// confirming a cadance contract after its code or its manager changed.
+	ConfirmCadanceContract(context.Context, *MsgConfirmCadanceContract) (*MsgConfirmCadanceContractResponse, error)
+	// CreateSchedule defines the endpoint for
+	// scheduling a one-off or cron contract call.
+	CreateSchedule(context.Context, *MsgCreateSchedule) (*MsgCreateScheduleResponse, error)
+	// CancelSchedule defines the endpoint for
+	// cancelling a scheduled contract call.
+	CancelSchedule(context.Context, *MsgCancelSchedule) (*MsgCancelScheduleResponse, error)
+	// UpdateParams defines a governance operation for updating the x/cadance module
+	// parameters. The authority is hard-coded to the x/gov module account.
+	//
+	// Since: cosmos-sdk 0.47
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// ForceRegisterCadanceContract defines a governance operation for registering
+	// a cadance contract on behalf of its admin or creator, without deposit.
+	ForceRegisterCadanceContract(context.Context, *MsgForceRegisterCadanceContract) (*MsgForceRegisterCadanceContractResponse, error)
+	// ForceJailCadanceContract defines a governance operation for jailing a
+	// cadance contract until it is unregistered.
+	ForceJailCadanceContract(context.Context, *MsgForceJailCadanceContract) (*MsgForceJailCadanceContractResponse, error)
+	// ForceUnregisterCadanceContract defines a governance operation for
+	// unregistering a cadance contract, refunding its deposit.
+	ForceUnregisterCadanceContract(context.Context, *MsgForceUnregisterCadanceContract) (*MsgForceUnregisterCadanceContractResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) RegisterCadanceContract(ctx context.Context, req *MsgRegisterCadanceContract) (*MsgRegisterCadanceContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterCadanceContract not implemented")
+}
+func (*UnimplementedMsgServer) UnregisterCadanceContract(ctx context.Context, req *MsgUnregisterCadanceContract) (*MsgUnregisterCadanceContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnregisterCadanceContract not implemented")
+}
+func (*UnimplementedMsgServer) UpdateCadanceContract(ctx context.Context, req *MsgUpdateCadanceContract) (*MsgUpdateCadanceContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCadanceContract not implemented")
+}
+func (*UnimplementedMsgServer) UnjailCadanceContract(ctx context.Context, req *MsgUnjailCadanceContract) (*MsgUnjailCadanceContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnjailCadanceContract not implemented")
+}
+func (*UnimplementedMsgServer) ConfirmCadanceContract(ctx context.Context, req *MsgConfirmCadanceContract) (*MsgConfirmCadanceContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmCadanceContract not implemented")
+}
+func (*UnimplementedMsgServer) CreateSchedule(ctx context.Context, req *MsgCreateSchedule) (*MsgCreateScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSchedule not implemented")
+}
+func (*UnimplementedMsgServer) CancelSchedule(ctx context.Context, req *MsgCancelSchedule) (*MsgCancelScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelSchedule not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) ForceRegisterCadanceContract(ctx context.Context, req *MsgForceRegisterCadanceContract) (*MsgForceRegisterCadanceContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForceRegisterCadanceContract not implemented")
+}
+func (*UnimplementedMsgServer) ForceJailCadanceContract(ctx context.Context, req *MsgForceJailCadanceContract) (*MsgForceJailCadanceContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForceJailCadanceContract not implemented")
+}
+func (*UnimplementedMsgServer) ForceUnregisterCadanceContract(ctx context.Context, req *MsgForceUnregisterCadanceContract) (*MsgForceUnregisterCadanceContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForceUnregisterCadanceContract not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_RegisterCadanceContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterCadanceContract)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterCadanceContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitsong.cadance.v1.Msg/RegisterCadanceContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterCadanceContract(ctx, req.(*MsgRegisterCadanceContract))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnregisterCadanceContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnregisterCadanceContract)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnregisterCadanceContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitsong.cadance.v1.Msg/UnregisterCadanceContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnregisterCadanceContract(ctx, req.(*MsgUnregisterCadanceContract))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateCadanceContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateCadanceContract)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateCadanceContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitsong.cadance.v1.Msg/UpdateCadanceContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateCadanceContract(ctx, req.(*MsgUpdateCadanceContract))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnjailCadanceContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnjailCadanceContract)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnjailCadanceContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitsong.cadance.v1.Msg/UnjailCadanceContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnjailCadanceContract(ctx, req.(*MsgUnjailCadanceContract))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ConfirmCadanceContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConfirmCadanceContract)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ConfirmCadanceContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitsong.cadance.v1.Msg/ConfirmCadanceContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConfirmCadanceContract(ctx, req.(*MsgConfirmCadanceContract))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitsong.cadance.v1.Msg/CreateSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateSchedule(ctx, req.(*MsgCreateSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitsong.cadance.v1.Msg/CancelSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelSchedule(ctx, req.(*MsgCancelSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitsong.cadance.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ForceRegisterCadanceContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgForceRegisterCadanceContract)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ForceRegisterCadanceContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitsong.cadance.v1.Msg/ForceRegisterCadanceContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ForceRegisterCadanceContract(ctx, req.(*MsgForceRegisterCadanceContract))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ForceJailCadanceContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgForceJailCadanceContract)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ForceJailCadanceContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitsong.cadance.v1.Msg/ForceJailCadanceContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ForceJailCadanceContract(ctx, req.(*MsgForceJailCadanceContract))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ForceUnregisterCadanceContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgForceUnregisterCadanceContract)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ForceUnregisterCadanceContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitsong.cadance.v1.Msg/ForceUnregisterCadanceContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ForceUnregisterCadanceContract(ctx, req.(*MsgForceUnregisterCadanceContract))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "bitsong.cadance.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RegisterCadanceContract",
+			Handler:    _Msg_RegisterCadanceContract_Handler,
+		},
+		{
+			MethodName: "UnregisterCadanceContract",
+			Handler:    _Msg_UnregisterCadanceContract_Handler,
+		},
+		{
+			MethodName: "UpdateCadanceContract",
+			Handler:    _Msg_UpdateCadanceContract_Handler,
+		},
+		{
+			MethodName: "UnjailCadanceContract",
+			Handler:    _Msg_UnjailCadanceContract_Handler,
+		},
+		{
+			MethodName: "ConfirmCadanceContract",
+			Handler:    _Msg_ConfirmCadanceContract_Handler,
+		},
+		{
+			MethodName: "CreateSchedule",
+			Handler:    _Msg_CreateSchedule_Handler,
+		},
+		{
+			MethodName: "CancelSchedule",
+			Handler:    _Msg_CancelSchedule_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "ForceRegisterCadanceContract",
+			Handler:    _Msg_ForceRegisterCadanceContract_Handler,
+		},
+		{
+			MethodName: "ForceJailCadanceContract",
+			Handler:    _Msg_ForceJailCadanceContract_Handler,
+		},
+		{
+			MethodName: "ForceUnregisterCadanceContract",
+			Handler:    _Msg_ForceUnregisterCadanceContract_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "bitsong/cadance/v1/tx.proto",
+}
+
+func (m *MsgRegisterCadanceContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterCadanceContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterCadanceContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Priority != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.FeePayer) > 0 {
+		i -= len(m.FeePayer)
+		copy(dAtA[i:], m.FeePayer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FeePayer)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.SudoMessageVersion != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SudoMessageVersion))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.SudoPayload) > 0 {
+		i -= len(m.SudoPayload)
+		copy(dAtA[i:], m.SudoPayload)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SudoPayload)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ExecutionInterval != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExecutionInterval))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Phase != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Phase))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SenderAddress) > 0 {
+		i -= len(m.SenderAddress)
+		copy(dAtA[i:], m.SenderAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SenderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterCadanceContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterCadanceContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterCadanceContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RegistrationId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RegistrationId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnregisterCadanceContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnregisterCadanceContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnregisterCadanceContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SenderAddress) > 0 {
+		i -= len(m.SenderAddress)
+		copy(dAtA[i:], m.SenderAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SenderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnregisterCadanceContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnregisterCadanceContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnregisterCadanceContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateCadanceContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateCadanceContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateCadanceContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Priority != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.FeePayer) > 0 {
+		i -= len(m.FeePayer)
+		copy(dAtA[i:], m.FeePayer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FeePayer)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.SudoMessageVersion != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SudoMessageVersion))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.SudoPayload) > 0 {
+		i -= len(m.SudoPayload)
+		copy(dAtA[i:], m.SudoPayload)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SudoPayload)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ExecutionInterval != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExecutionInterval))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SenderAddress) > 0 {
+		i -= len(m.SenderAddress)
+		copy(dAtA[i:], m.SenderAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SenderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateCadanceContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateCadanceContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateCadanceContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnjailCadanceContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnjailCadanceContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnjailCadanceContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SenderAddress) > 0 {
+		i -= len(m.SenderAddress)
+		copy(dAtA[i:], m.SenderAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SenderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnjailCadanceContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnjailCadanceContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnjailCadanceContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConfirmCadanceContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConfirmCadanceContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConfirmCadanceContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SenderAddress) > 0 {
+		i -= len(m.SenderAddress)
+		copy(dAtA[i:], m.SenderAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SenderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConfirmCadanceContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConfirmCadanceContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConfirmCadanceContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Cron) > 0 {
+		i -= len(m.Cron)
+		copy(dAtA[i:], m.Cron)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Cron)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.RunAtTime != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.RunAtTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.RunAtTime):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintTx(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.RunAtHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RunAtHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.CallType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CallType))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgForceRegisterCadanceContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgForceRegisterCadanceContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgForceRegisterCadanceContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Priority != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.SudoMessageVersion != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SudoMessageVersion))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.SudoPayload) > 0 {
+		i -= len(m.SudoPayload)
+		copy(dAtA[i:], m.SudoPayload)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SudoPayload)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ExecutionInterval != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExecutionInterval))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Phase != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Phase))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgForceRegisterCadanceContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgForceRegisterCadanceContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgForceRegisterCadanceContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RegistrationId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RegistrationId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgForceJailCadanceContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgForceJailCadanceContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgForceJailCadanceContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgForceJailCadanceContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgForceJailCadanceContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgForceJailCadanceContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgForceUnregisterCadanceContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgForceUnregisterCadanceContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgForceUnregisterCadanceContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgForceUnregisterCadanceContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgForceUnregisterCadanceContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgForceUnregisterCadanceContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgRegisterCadanceContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SenderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Phase != 0 {
+		n += 1 + sovTx(uint64(m.Phase))
+	}
+	if m.ExecutionInterval != 0 {
+		n += 1 + sovTx(uint64(m.ExecutionInterval))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovTx(uint64(m.StartHeight))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovTx(uint64(m.GasLimit))
+	}
+	l = len(m.SudoPayload)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SudoMessageVersion != 0 {
+		n += 1 + sovTx(uint64(m.SudoMessageVersion))
+	}
+	l = len(m.FeePayer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Priority != 0 {
+		n += 1 + sovTx(uint64(m.Priority))
+	}
+	return n
+}
+
+func (m *MsgRegisterCadanceContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RegistrationId != 0 {
+		n += 1 + sovTx(uint64(m.RegistrationId))
+	}
+	return n
+}
+
+func (m *MsgUnregisterCadanceContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SenderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnregisterCadanceContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateCadanceContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SenderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExecutionInterval != 0 {
+		n += 1 + sovTx(uint64(m.ExecutionInterval))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovTx(uint64(m.StartHeight))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovTx(uint64(m.GasLimit))
+	}
+	l = len(m.SudoPayload)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SudoMessageVersion != 0 {
+		n += 1 + sovTx(uint64(m.SudoMessageVersion))
+	}
+	l = len(m.FeePayer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Priority != 0 {
+		n += 1 + sovTx(uint64(m.Priority))
+	}
+	return n
+}
+
+func (m *MsgUpdateCadanceContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnjailCadanceContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SenderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnjailCadanceContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgConfirmCadanceContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SenderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgConfirmCadanceContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CallType != 0 {
+		n += 1 + sovTx(uint64(m.CallType))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovTx(uint64(m.GasLimit))
+	}
+	if m.RunAtHeight != 0 {
+		n += 1 + sovTx(uint64(m.RunAtHeight))
+	}
+	if m.RunAtTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.RunAtTime)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Cron)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgForceRegisterCadanceContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Phase != 0 {
+		n += 1 + sovTx(uint64(m.Phase))
+	}
+	if m.ExecutionInterval != 0 {
+		n += 1 + sovTx(uint64(m.ExecutionInterval))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovTx(uint64(m.StartHeight))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovTx(uint64(m.GasLimit))
+	}
+	l = len(m.SudoPayload)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SudoMessageVersion != 0 {
+		n += 1 + sovTx(uint64(m.SudoMessageVersion))
+	}
+	if m.Priority != 0 {
+		n += 1 + sovTx(uint64(m.Priority))
+	}
+	return n
+}
+
+func (m *MsgForceRegisterCadanceContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RegistrationId != 0 {
+		n += 1 + sovTx(uint64(m.RegistrationId))
+	}
+	return n
+}
+
+func (m *MsgForceJailCadanceContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgForceJailCadanceContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgForceUnregisterCadanceContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgForceUnregisterCadanceContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgRegisterCadanceContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterCadanceContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterCadanceContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SenderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			m.Phase = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Phase |= ExecutionPhase(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionInterval", wireType)
+			}
+			m.ExecutionInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutionInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SudoPayload", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SudoPayload = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SudoMessageVersion", wireType)
+			}
+			m.SudoMessageVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SudoMessageVersion |= SudoMessageVersion(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeePayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterCadanceContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterCadanceContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterCadanceContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationId", wireType)
+			}
+			m.RegistrationId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RegistrationId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnregisterCadanceContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnregisterCadanceContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnregisterCadanceContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SenderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnregisterCadanceContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnregisterCadanceContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnregisterCadanceContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateCadanceContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateCadanceContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateCadanceContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SenderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionInterval", wireType)
+			}
+			m.ExecutionInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutionInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SudoPayload", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SudoPayload = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SudoMessageVersion", wireType)
+			}
+			m.SudoMessageVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SudoMessageVersion |= SudoMessageVersion(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeePayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateCadanceContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateCadanceContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateCadanceContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnjailCadanceContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnjailCadanceContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnjailCadanceContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SenderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnjailCadanceContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnjailCadanceContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnjailCadanceContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConfirmCadanceContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConfirmCadanceContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConfirmCadanceContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SenderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConfirmCadanceContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConfirmCadanceContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConfirmCadanceContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallType", wireType)
+			}
+			m.CallType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CallType |= ScheduleCallType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunAtHeight", wireType)
+			}
+			m.RunAtHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RunAtHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunAtTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RunAtTime == nil {
+				m.RunAtTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.RunAtTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cron", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cron = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgRegisterCadanceContract) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1056,15 +4911,15 @@ func (m *MsgRegisterCadanceContract) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterCadanceContract: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterCadanceContract: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SenderAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1092,13 +4947,13 @@ func (m *MsgRegisterCadanceContract) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SenderAddress = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1108,23 +4963,24 @@ func (m *MsgRegisterCadanceContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1147,7 +5003,7 @@ func (m *MsgRegisterCadanceContract) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterCadanceContractResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1170,10 +5026,10 @@ func (m *MsgRegisterCadanceContractResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterCadanceContractResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterCadanceContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1197,7 +5053,7 @@ func (m *MsgRegisterCadanceContractResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnregisterCadanceContract) Unmarshal(dAtA []byte) error {
+func (m *MsgForceRegisterCadanceContract) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1220,15 +5076,15 @@ func (m *MsgUnregisterCadanceContract) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnregisterCadanceContract: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgForceRegisterCadanceContract: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnregisterCadanceContract: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgForceRegisterCadanceContract: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SenderAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1256,7 +5112,7 @@ func (m *MsgUnregisterCadanceContract) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SenderAddress = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -1290,6 +5146,152 @@ func (m *MsgUnregisterCadanceContract) Unmarshal(dAtA []byte) error {
 			}
 			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			m.Phase = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Phase |= ExecutionPhase(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionInterval", wireType)
+			}
+			m.ExecutionInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutionInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SudoPayload", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SudoPayload = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SudoMessageVersion", wireType)
+			}
+			m.SudoMessageVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SudoMessageVersion |= SudoMessageVersion(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1311,7 +5313,7 @@ func (m *MsgUnregisterCadanceContract) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnregisterCadanceContractResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgForceRegisterCadanceContractResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1334,12 +5336,31 @@ func (m *MsgUnregisterCadanceContractResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnregisterCadanceContractResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgForceRegisterCadanceContractResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnregisterCadanceContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgForceRegisterCadanceContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationId", wireType)
+			}
+			m.RegistrationId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RegistrationId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1361,7 +5382,7 @@ func (m *MsgUnregisterCadanceContractResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnjailCadanceContract) Unmarshal(dAtA []byte) error {
+func (m *MsgForceJailCadanceContract) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1384,15 +5405,15 @@ func (m *MsgUnjailCadanceContract) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnjailCadanceContract: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgForceJailCadanceContract: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnjailCadanceContract: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgForceJailCadanceContract: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SenderAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1420,7 +5441,7 @@ func (m *MsgUnjailCadanceContract) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SenderAddress = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -1454,6 +5475,38 @@ func (m *MsgUnjailCadanceContract) Unmarshal(dAtA []byte) error {
 			}
 			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1475,7 +5528,7 @@ func (m *MsgUnjailCadanceContract) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnjailCadanceContractResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgForceJailCadanceContractResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1498,10 +5551,10 @@ func (m *MsgUnjailCadanceContractResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnjailCadanceContractResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgForceJailCadanceContractResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnjailCadanceContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgForceJailCadanceContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1525,7 +5578,7 @@ func (m *MsgUnjailCadanceContractResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgForceUnregisterCadanceContract) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1548,10 +5601,10 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgForceUnregisterCadanceContract: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgForceUnregisterCadanceContract: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1588,9 +5641,9 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1600,24 +5653,23 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1640,7 +5692,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgForceUnregisterCadanceContractResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1663,10 +5715,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgForceUnregisterCadanceContractResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgForceUnregisterCadanceContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
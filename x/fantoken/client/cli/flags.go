@@ -13,6 +13,11 @@ const (
 	FlagNewMinter    = "new-minter"
 	FlagAmount       = "amount"
 	FlagURI          = "uri"
+	FlagHeight       = "height"
+	FlagClawback     = "clawback"
+	FlagExpiryHeight = "expiry-height"
+	FlagExpiryTime   = "expiry-time"
+	FlagChannels     = "channels"
 )
 
 var (
@@ -22,6 +27,9 @@ var (
 	FsSetAuthority = flag.NewFlagSet("", flag.ContinueOnError)
 	FsSetMinter    = flag.NewFlagSet("", flag.ContinueOnError)
 	FsSetUri       = flag.NewFlagSet("", flag.ContinueOnError)
+	FsIssuePreview = flag.NewFlagSet("", flag.ContinueOnError)
+	FsClawback     = flag.NewFlagSet("", flag.ContinueOnError)
+	FsIBCPolicy    = flag.NewFlagSet("", flag.ContinueOnError)
 )
 
 func init() {
@@ -29,6 +37,9 @@ func init() {
 	FsIssue.String(FlagName, "", "The fantoken name, e.g. Bitsong Network")
 	FsIssue.String(FlagMaxSupply, "", "The maximum supply of the fantoken")
 	FsIssue.String(FlagURI, "", "The fantoken uri")
+	FsIssue.Bool(FlagClawback, false, "Allow the minter and the authority to burn or reclaim the fantoken from any holder. Once created, it cannot be modified")
+	FsIssue.Int64(FlagExpiryHeight, 0, "The block height from which the fantoken cannot be transferred anymore")
+	FsIssue.String(FlagExpiryTime, "", "The time (RFC3339) from which the fantoken cannot be transferred anymore")
 
 	FsMint.String(FlagRecipient, "", "Address to which the fantoken is to be minted")
 
@@ -40,4 +51,11 @@ func init() {
 	FsSetMinter.String(FlagNewMinter, "", "The new minter")
 
 	FsSetUri.String(FlagURI, "", "The uri of the fantoken")
+
+	FsIssuePreview.AddFlagSet(FsIssue)
+	FsIssuePreview.Int64(FlagHeight, 0, "The height at which the issue is expected to be included, defaults to the next block")
+
+	FsClawback.String(FlagRecipient, "", "Address to which the fantoken is to be sent, if empty the fantoken is burned")
+
+	FsIBCPolicy.StringSlice(FlagChannels, nil, "The source channels the fantoken can be sent over, used by the allowlist policy")
 }
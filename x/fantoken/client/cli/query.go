@@ -3,6 +3,9 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strings"
+
+	"cosmossdk.io/math"
 
 	"github.com/spf13/cobra"
 
@@ -26,6 +29,10 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryFanToken(),
 		GetCmdQueryFanTokens(),
 		GetCmdQueryParams(),
+		GetCmdQueryIBCTransferPolicy(),
+		GetCmdQueryIssuePreview(),
+		GetCmdQueryMintPreview(),
+		GetCmdQueryBurnPreview(),
 	)
 
 	return queryCmd
@@ -67,6 +74,40 @@ func GetCmdQueryFanToken() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryIBCTransferPolicy implements the query fantoken ibc transfer policy command.
+func GetCmdQueryIBCTransferPolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "ibc-transfer-policy [denom]",
+		Short:   "Query the IBC channels a fantoken can be sent over.",
+		Example: fmt.Sprintf("$ %s query fantoken ibc-transfer-policy <denom>", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if err := types.ValidateDenom(args[0]); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.IBCTransferPolicy(context.Background(), &types.QueryIBCTransferPolicyRequest{
+				Denom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQueryFanTokens implements the query fantokens command.
 func GetCmdQueryFanTokens() *cobra.Command {
 	cmd := &cobra.Command{
@@ -132,3 +173,160 @@ func GetCmdQueryParams() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQueryIssuePreview implements the dry-run of a fantoken issue.
+func GetCmdQueryIssuePreview() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "preview-issue [minter]",
+		Short: "Preview the denom, the fee and the outcome of a fantoken issue.",
+		Example: fmt.Sprintf(
+			"$ %s query fantoken preview-issue <minter> "+
+				"--name=\"Kitty Token\" "+
+				"--symbol=\"kitty\" "+
+				"--max-supply=\"1000000000000\" "+
+				"--uri=\"ipfs://...\"",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			minter, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			symbol, err := cmd.Flags().GetString(FlagSymbol)
+			if err != nil {
+				return err
+			}
+			name, err := cmd.Flags().GetString(FlagName)
+			if err != nil {
+				return err
+			}
+			maxSupplyStr, err := cmd.Flags().GetString(FlagMaxSupply)
+			if err != nil {
+				return err
+			}
+			maxSupply, ok := math.NewIntFromString(maxSupplyStr)
+			if !ok {
+				return fmt.Errorf("failed to parse max supply: %s", maxSupplyStr)
+			}
+			uri, err := cmd.Flags().GetString(FlagURI)
+			if err != nil {
+				return err
+			}
+			height, err := cmd.Flags().GetInt64(FlagHeight)
+			if err != nil {
+				return err
+			}
+			opts, err := issueOptionsFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.IssuePreview(context.Background(), &types.QueryIssuePreviewRequest{
+				Symbol:          symbol,
+				Name:            name,
+				MaxSupply:       maxSupply,
+				Authority:       minter.String(),
+				Minter:          minter.String(),
+				URI:             uri,
+				Height:          height,
+				ClawbackEnabled: opts.ClawbackEnabled,
+				ExpiryHeight:    opts.ExpiryHeight,
+				ExpiryTime:      opts.ExpiryTime,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsIssuePreview)
+	_ = cmd.MarkFlagRequired(FlagSymbol)
+	_ = cmd.MarkFlagRequired(FlagMaxSupply)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryMintPreview implements the dry-run of a fantoken mint.
+func GetCmdQueryMintPreview() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "preview-mint [minter] [amount][denom]",
+		Short:   "Preview the fee and the outcome of a fantoken mint.",
+		Example: fmt.Sprintf("$ %s query fantoken preview-mint <minter> 1000<denom> --recipient=<recipient>", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			coin, err := sdk.ParseCoinNormalized(strings.TrimSpace(args[1]))
+			if err != nil {
+				return err
+			}
+			rcpt, err := cmd.Flags().GetString(FlagRecipient)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.MintPreview(context.Background(), &types.QueryMintPreviewRequest{
+				Recipient: rcpt,
+				Coin:      coin,
+				Minter:    args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsMint)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryBurnPreview implements the dry-run of a fantoken burn.
+func GetCmdQueryBurnPreview() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "preview-burn [sender] [amount][denom]",
+		Short:   "Preview the fee and the outcome of a fantoken burn.",
+		Example: fmt.Sprintf("$ %s query fantoken preview-burn <sender> 1000<denom>", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			coin, err := sdk.ParseCoinNormalized(strings.TrimSpace(args[1]))
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.BurnPreview(context.Background(), &types.QueryBurnPreviewRequest{
+				Coin:   coin,
+				Sender: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -38,6 +39,8 @@ func NewTxCmd() *cobra.Command {
 		GetCmdSetAuthority(),
 		GetCmdSetMinter(),
 		GetCmdSetUri(),
+		GetCmdClawback(),
+		GetCmdSetIBCTransferPolicy(),
 		// GetCmdUpdateFantokenFees(),
 	)
 
@@ -55,6 +58,8 @@ func GetCmdIssue() *cobra.Command {
 				"--symbol=\"kitty\" "+
 				"--max-supply=\"1000000000000\" "+
 				"--uri=\"ipfs://...\" "+
+				"--clawback "+
+				"--expiry-time=\"2025-12-31T23:59:59Z\" "+
 				"--from=<key-name> "+
 				"--chain-id=<chain-id> "+
 				"--fees=<fee>",
@@ -87,14 +92,21 @@ func GetCmdIssue() *cobra.Command {
 			if err != nil {
 				return fmt.Errorf("the uri field is invalid")
 			}
+			opts, err := issueOptionsFromFlags(cmd)
+			if err != nil {
+				return err
+			}
 
 			msg := &fantokentypes.MsgIssue{
-				Symbol:    symbol,
-				Name:      name,
-				MaxSupply: maxSupply,
-				Authority: authority.String(),
-				URI:       uri,
-				Minter:    authority.String(),
+				Symbol:          symbol,
+				Name:            name,
+				MaxSupply:       maxSupply,
+				Authority:       authority.String(),
+				URI:             uri,
+				Minter:          authority.String(),
+				ClawbackEnabled: opts.ClawbackEnabled,
+				ExpiryHeight:    opts.ExpiryHeight,
+				ExpiryTime:      opts.ExpiryTime,
 			}
 
 			if err := msg.ValidateBasic(); err != nil {
@@ -114,6 +126,37 @@ func GetCmdIssue() *cobra.Command {
 	return cmd
 }
 
+// issueOptionsFromFlags parses the clawback and the expiry flags of an issue
+func issueOptionsFromFlags(cmd *cobra.Command) (fantokentypes.IssueOptions, error) {
+	clawback, err := cmd.Flags().GetBool(FlagClawback)
+	if err != nil {
+		return fantokentypes.IssueOptions{}, err
+	}
+	expiryHeight, err := cmd.Flags().GetInt64(FlagExpiryHeight)
+	if err != nil {
+		return fantokentypes.IssueOptions{}, err
+	}
+	expiryTimeStr, err := cmd.Flags().GetString(FlagExpiryTime)
+	if err != nil {
+		return fantokentypes.IssueOptions{}, err
+	}
+
+	var expiryTime *time.Time
+	if expiryTimeStr != "" {
+		t, err := time.Parse(time.RFC3339, expiryTimeStr)
+		if err != nil {
+			return fantokentypes.IssueOptions{}, fmt.Errorf("failed to parse expiry time: %w", err)
+		}
+		expiryTime = &t
+	}
+
+	return fantokentypes.IssueOptions{
+		ClawbackEnabled: clawback,
+		ExpiryHeight:    expiryHeight,
+		ExpiryTime:      expiryTime,
+	}, nil
+}
+
 // GetCmdDisableMint implements the edit fan token mintable command
 func GetCmdDisableMint() *cobra.Command {
 	cmd := &cobra.Command{
@@ -381,6 +424,115 @@ func GetCmdSetUri() *cobra.Command {
 	return cmd
 }
 
+// GetCmdClawback implements the clawback fan token command
+func GetCmdClawback() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clawback [holder] [amount][denom]",
+		Short: "Burn or reclaim fantoken from a holder.",
+		Example: fmt.Sprintf(
+			"$ %s tx fantoken clawback <holder> [amount][denom] "+
+				"--recipient=<recipient> "+
+				"--from=<key-name> "+
+				"--chain-id=<chain-id> "+
+				"--fees=<fee>",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sender := clientCtx.GetFromAddress().String()
+
+			rcpt, err := cmd.Flags().GetString(FlagRecipient)
+			if err != nil {
+				return err
+			}
+
+			coin, err := sdk.ParseCoinNormalized(strings.TrimSpace(args[1]))
+			if err != nil {
+				return err
+			}
+
+			msg := fantokentypes.NewMsgClawback(sender, strings.TrimSpace(args[0]), rcpt, coin)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FsClawback)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdSetIBCTransferPolicy implements the set fan token ibc transfer policy command
+func GetCmdSetIBCTransferPolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-ibc-transfer-policy [denom] [allow-all|deny-all|allowlist]",
+		Short: "Set the IBC channels the fantoken can be sent over",
+		Example: fmt.Sprintf(
+			"$ %s tx fantoken set-ibc-transfer-policy <denom> allowlist "+
+				"--channels=channel-0,channel-1 "+
+				"--from=<key-name> "+
+				"--chain-id=<chain-id> "+
+				"--fees=<fee>",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			policy, err := parseIBCTransferPolicy(args[1])
+			if err != nil {
+				return err
+			}
+
+			channels, err := cmd.Flags().GetStringSlice(FlagChannels)
+			if err != nil {
+				return err
+			}
+
+			authority := clientCtx.GetFromAddress().String()
+
+			msg := fantokentypes.NewMsgSetIBCTransferPolicy(strings.TrimSpace(args[0]), policy, channels, authority)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FsIBCPolicy)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func parseIBCTransferPolicy(policy string) (fantokentypes.IBCTransferPolicy, error) {
+	switch policy {
+	case "allow-all":
+		return fantokentypes.IBCTransferPolicyAllowAll, nil
+	case "deny-all":
+		return fantokentypes.IBCTransferPolicyDenyAll, nil
+	case "allowlist":
+		return fantokentypes.IBCTransferPolicyAllowlist, nil
+	default:
+		return 0, fmt.Errorf("invalid ibc transfer policy %s, expected allow-all, deny-all or allowlist", policy)
+	}
+}
+
 func GetCmdUpdateFantokenFees() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "update-fantoken-fees [proposal-file]",
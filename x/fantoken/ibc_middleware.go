@@ -0,0 +1,77 @@
+package fantoken
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+
+	"github.com/bitsongofficial/go-bitsong/x/fantoken/keeper"
+)
+
+var (
+	_ porttypes.ICS4Wrapper = ICS4Middleware{}
+	_ porttypes.Middleware  = IBCMiddleware{}
+)
+
+// ICS4Middleware enforces the fantokens IBC transfer policy on the outgoing transfer packets
+type ICS4Middleware struct {
+	channel porttypes.ICS4Wrapper
+	keeper  keeper.Keeper
+}
+
+// NewICS4Middleware creates a new ICS4Middleware wrapping the given ICS4Wrapper
+func NewICS4Middleware(channel porttypes.ICS4Wrapper, k keeper.Keeper) ICS4Middleware {
+	return ICS4Middleware{
+		channel: channel,
+		keeper:  k,
+	}
+}
+
+// SendPacket implements the ICS4Wrapper interface. It rejects the transfer packets of the
+// fantokens whose policy does not allow the source channel.
+func (i ICS4Middleware) SendPacket(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	sourcePort string,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	data []byte,
+) (uint64, error) {
+	var packet transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(data, &packet); err == nil {
+		if err := i.keeper.ValidateIBCTransfer(ctx, packet.Denom, sourceChannel); err != nil {
+			return 0, err
+		}
+	}
+
+	return i.channel.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+}
+
+// WriteAcknowledgement implements the ICS4Wrapper interface
+func (i ICS4Middleware) WriteAcknowledgement(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet ibcexported.PacketI, ack ibcexported.Acknowledgement) error {
+	return i.channel.WriteAcknowledgement(ctx, chanCap, packet, ack)
+}
+
+// GetAppVersion implements the ICS4Wrapper interface
+func (i ICS4Middleware) GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool) {
+	return i.channel.GetAppVersion(ctx, portID, channelID)
+}
+
+// IBCMiddleware is the transfer stack middleware of the fantoken module, the packet callbacks
+// are passed through to the wrapped app while the packets are sent through the ICS4Middleware
+type IBCMiddleware struct {
+	porttypes.IBCModule
+	ICS4Middleware
+}
+
+// NewIBCMiddleware creates a new IBCMiddleware wrapping the given app
+func NewIBCMiddleware(app porttypes.IBCModule, ics4 ICS4Middleware) IBCMiddleware {
+	return IBCMiddleware{
+		IBCModule:      app,
+		ICS4Middleware: ics4,
+	}
+}
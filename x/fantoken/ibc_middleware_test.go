@@ -0,0 +1,127 @@
+package fantoken_test
+
+import (
+	"os"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/bitsongofficial/go-bitsong/tests/bitsongibctesting"
+	fantokentypes "github.com/bitsongofficial/go-bitsong/x/fantoken/types"
+)
+
+type IBCMiddlewareTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	chainA *bitsongibctesting.TestChain
+	chainB *bitsongibctesting.TestChain
+	path   *ibctesting.Path
+}
+
+func TestIBCMiddlewareTestSuite(t *testing.T) {
+	suite.Run(t, new(IBCMiddlewareTestSuite))
+}
+
+func (s *IBCMiddlewareTestSuite) SetupTest() {
+	ibctesting.DefaultTestingAppInit = bitsongibctesting.SetupTestingApp
+
+	s.coordinator = ibctesting.NewCoordinator(s.T(), 2)
+	s.chainA = &bitsongibctesting.TestChain{
+		TestChain: s.coordinator.GetChain(ibctesting.GetChainID(1)),
+	}
+	s.chainB = &bitsongibctesting.TestChain{
+		TestChain: s.coordinator.GetChain(ibctesting.GetChainID(2)),
+	}
+
+	s.path = ibctesting.NewTransferPath(s.chainA.TestChain, s.chainB.TestChain)
+	s.coordinator.Setup(s.path)
+}
+
+func (s *IBCMiddlewareTestSuite) TearDownSuite() {
+	for _, dir := range bitsongibctesting.TestingDirectories {
+		os.RemoveAll(dir)
+	}
+}
+
+// issueFanToken issues and mints a fantoken on chain A to the sender account
+func (s *IBCMiddlewareTestSuite) issueFanToken() string {
+	sender := s.chainA.SenderAccount.GetAddress().String()
+
+	_, err := s.chainA.SendMsgsNoCheck(&fantokentypes.MsgIssue{
+		Symbol:    "tour",
+		Name:      "Tour Pass",
+		MaxSupply: math.NewInt(1_000_000),
+		Authority: sender,
+		Minter:    sender,
+	})
+	s.Require().NoError(err)
+
+	fantokens := s.chainA.GetBitsongApp().AppKeepers.FanTokenKeeper.GetFanTokens(s.chainA.GetContext(), s.chainA.SenderAccount.GetAddress())
+	s.Require().Len(fantokens, 1)
+	denom := fantokens[0].GetDenom()
+
+	_, err = s.chainA.SendMsgsNoCheck(fantokentypes.NewMsgMint(sender, sdk.NewCoin(denom, math.NewInt(1_000)), sender))
+	s.Require().NoError(err)
+
+	return denom
+}
+
+func (s *IBCMiddlewareTestSuite) setPolicy(denom string, policy fantokentypes.IBCTransferPolicy, channels ...string) {
+	msg := fantokentypes.NewMsgSetIBCTransferPolicy(denom, policy, channels, s.chainA.SenderAccount.GetAddress().String())
+	_, err := s.chainA.SendMsgsNoCheck(msg)
+	s.Require().NoError(err)
+}
+
+func (s *IBCMiddlewareTestSuite) transfer(denom string) error {
+	msg := transfertypes.NewMsgTransfer(
+		s.path.EndpointA.ChannelConfig.PortID,
+		s.path.EndpointA.ChannelID,
+		sdk.NewCoin(denom, math.NewInt(10)),
+		s.chainA.SenderAccount.GetAddress().String(),
+		s.chainB.SenderAccount.GetAddress().String(),
+		clienttypes.NewHeight(1, 1000),
+		0,
+		"",
+	)
+	_, err := s.chainA.SendMsgsNoCheck(msg)
+	return err
+}
+
+func (s *IBCMiddlewareTestSuite) TestTransferPolicy() {
+	denom := s.issueFanToken()
+
+	// allowed by default
+	s.Require().NoError(s.transfer(denom))
+
+	s.setPolicy(denom, fantokentypes.IBCTransferPolicyDenyAll)
+	err := s.transfer(denom)
+	s.Require().ErrorContains(err, fantokentypes.ErrIBCTransferNotAllowed.Error())
+
+	s.setPolicy(denom, fantokentypes.IBCTransferPolicyAllowlist, "channel-99")
+	err = s.transfer(denom)
+	s.Require().ErrorContains(err, fantokentypes.ErrIBCTransferNotAllowed.Error())
+
+	s.setPolicy(denom, fantokentypes.IBCTransferPolicyAllowlist, "channel-99", s.path.EndpointA.ChannelID)
+	s.Require().NoError(s.transfer(denom))
+
+	res, err := s.chainA.GetBitsongApp().AppKeepers.FanTokenKeeper.IBCTransferPolicy(
+		s.chainA.GetContext(),
+		&fantokentypes.QueryIBCTransferPolicyRequest{Denom: denom},
+	)
+	s.Require().NoError(err)
+	s.Require().Equal(fantokentypes.IBCTransferPolicyAllowlist, res.Policy)
+	s.Require().Equal([]string{"channel-99", s.path.EndpointA.ChannelID}, res.AllowedChannels)
+
+	// only the authority can set the policy
+	err = s.chainA.GetBitsongApp().AppKeepers.FanTokenKeeper.SetIBCTransferPolicy(
+		s.chainA.GetContext(), denom, fantokentypes.IBCTransferPolicyAllowAll, nil, s.chainB.SenderAccount.GetAddress(),
+	)
+	s.Require().ErrorIs(err, fantokentypes.ErrInvalidAuthority)
+}
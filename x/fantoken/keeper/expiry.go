@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	"cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bitsongofficial/go-bitsong/x/fantoken/types"
+)
+
+// isExpired returns true if the fantoken expiry has been reached at the current block
+func (k Keeper) isExpired(ctx sdk.Context, fantoken types.FanToken) bool {
+	return fantoken.Expired || fantoken.IsExpiredAt(ctx.BlockHeight(), ctx.BlockTime())
+}
+
+// MaxExpiredFanTokensPerBlock is the maximum number of fantokens marked as expired in a block,
+// the remaining ones are marked in the next blocks.
+const MaxExpiredFanTokensPerBlock = 100
+
+// SendRestrictionFn blocks the transfers of expired fantokens. The transfers from and to the
+// module account are still allowed so that the holders can burn them.
+func (k Keeper) SendRestrictionFn(goCtx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+	if fromAddr.Equals(k.moduleAddress) || toAddr.Equals(k.moduleAddress) {
+		return toAddr, nil
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	for _, coin := range amt {
+		if !strings.HasPrefix(coin.Denom, types.DenomPrefix) {
+			continue
+		}
+
+		fantoken, err := k.getFanTokenByDenom(ctx, coin.Denom)
+		if err != nil {
+			// not a fantoken
+			continue
+		}
+
+		if k.isExpired(ctx, fantoken) {
+			return toAddr, errors.Wrapf(types.ErrFanTokenExpired, "the fantoken %s cannot be transferred", coin.Denom)
+		}
+	}
+
+	return toAddr, nil
+}
+
+// ExpireFanTokens marks as expired the fantokens whose expiry has been reached and emits
+// an expire event for each of them. Only the queued expiries up to the current height and
+// time are iterated, and at most MaxExpiredFanTokensPerBlock fantokens are expired per block.
+func (k Keeper) ExpireFanTokens(ctx sdk.Context) error {
+	store := ctx.KVStore(k.storeKey)
+
+	var denoms []string
+	seen := make(map[string]bool)
+	collect := func(prefix, until []byte) {
+		// the keys are the queue prefix of an expiry followed by the denom
+		it := store.Iterator(prefix, storetypes.PrefixEndBytes(until))
+		defer it.Close()
+
+		for ; it.Valid() && len(denoms) < MaxExpiredFanTokensPerBlock; it.Next() {
+			denom := string(it.Key()[len(until):])
+			if !seen[denom] {
+				seen[denom] = true
+				denoms = append(denoms, denom)
+			}
+		}
+	}
+	collect(types.PrefixExpiryHeightQueue, types.ExpiryHeightQueuePrefix(ctx.BlockHeight()))
+	collect(types.PrefixExpiryTimeQueue, types.ExpiryTimeQueuePrefix(ctx.BlockTime()))
+
+	for _, denom := range denoms {
+		fantoken, err := k.getFanTokenByDenom(ctx, denom)
+		if err != nil {
+			return err
+		}
+
+		fantoken.Expired = true
+		k.setFanToken(ctx, &fantoken)
+		k.deleteExpiryQueue(ctx, &fantoken)
+
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventExpire{Denom: fantoken.GetDenom()}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
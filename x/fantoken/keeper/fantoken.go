@@ -69,6 +69,11 @@ func (k Keeper) AddFanToken(ctx sdk.Context, token *types.FanToken) error {
 		k.setWithMetadataAuthority(ctx, token.GetAuthority(), token.GetDenom())
 	}
 
+	if token.HasExpiry() && !token.Expired {
+		// wait for the end blocker to mark the token as expired
+		k.setExpiryQueue(ctx, token)
+	}
+
 	return nil
 }
 
@@ -39,6 +39,28 @@ func (k Keeper) FanToken(c context.Context, req *types.QueryFanTokenRequest) (*t
 	return &types.QueryFanTokenResponse{Fantoken: fantoken}, nil
 }
 
+func (k Keeper) IBCTransferPolicy(c context.Context, req *types.QueryIBCTransferPolicyRequest) (*types.QueryIBCTransferPolicyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if len(req.Denom) == 0 {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, "empty denom")
+	}
+
+	fantoken, err := k.GetFanToken(ctx, req.Denom)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "fan token %s not found", req.Denom)
+	}
+
+	return &types.QueryIBCTransferPolicyResponse{
+		Policy:          fantoken.IBCTransferPolicy,
+		AllowedChannels: fantoken.IBCAllowedChannels,
+	}, nil
+}
+
 func (k Keeper) FanTokens(c context.Context, req *types.QueryFanTokensRequest) (*types.QueryFanTokensResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
@@ -106,3 +128,108 @@ func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types
 
 	return &types.QueryParamsResponse{Params: params}, nil
 }
+
+// IssuePreview runs the issue of a fantoken against a cached copy of the current state
+// and returns the predicted denom, the fee charged and the error the issue would fail with
+func (k Keeper) IssuePreview(c context.Context, req *types.QueryIssuePreviewRequest) (*types.QueryIssuePreviewResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	// the denom depends on the height at which the issue is included
+	height := req.Height
+	if height == 0 {
+		height = ctx.BlockHeight() + 1
+	}
+
+	msg := &types.MsgIssue{
+		Symbol:          req.Symbol,
+		Name:            req.Name,
+		MaxSupply:       req.MaxSupply,
+		Authority:       req.Authority,
+		Minter:          req.Minter,
+		URI:             req.URI,
+		ClawbackEnabled: req.ClawbackEnabled,
+		ExpiryHeight:    req.ExpiryHeight,
+		ExpiryTime:      req.ExpiryTime,
+	}
+
+	res := &types.QueryIssuePreviewResponse{
+		Fee: k.GetParamSet(ctx).IssueFee,
+	}
+
+	if minter, err := sdk.AccAddressFromBech32(req.Minter); err == nil {
+		res.Denom = types.GetFantokenDenom(height, minter, req.Symbol, req.Name)
+	}
+
+	if err := k.dryRun(ctx.WithBlockHeight(height), msg, func(cacheCtx sdk.Context) error {
+		_, err := NewMsgServerImpl(&k).Issue(cacheCtx, msg)
+		return err
+	}); err != nil {
+		res.Error = err.Error()
+	}
+
+	return res, nil
+}
+
+// MintPreview runs the mint of a fantoken against a cached copy of the current state
+// and returns the fee charged and the error the mint would fail with
+func (k Keeper) MintPreview(c context.Context, req *types.QueryMintPreviewRequest) (*types.QueryMintPreviewResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	msg := types.NewMsgMint(req.Recipient, req.Coin, req.Minter)
+
+	res := &types.QueryMintPreviewResponse{
+		Fee: k.GetParamSet(ctx).MintFee,
+	}
+
+	if err := k.dryRun(ctx, msg, func(cacheCtx sdk.Context) error {
+		_, err := NewMsgServerImpl(&k).Mint(cacheCtx, msg)
+		return err
+	}); err != nil {
+		res.Error = err.Error()
+	}
+
+	return res, nil
+}
+
+// BurnPreview runs the burn of a fantoken against a cached copy of the current state
+// and returns the fee charged and the error the burn would fail with
+func (k Keeper) BurnPreview(c context.Context, req *types.QueryBurnPreviewRequest) (*types.QueryBurnPreviewResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	msg := types.NewMsgBurn(req.Coin, req.Sender)
+
+	res := &types.QueryBurnPreviewResponse{
+		Fee: k.GetParamSet(ctx).BurnFee,
+	}
+
+	if err := k.dryRun(ctx, msg, func(cacheCtx sdk.Context) error {
+		_, err := NewMsgServerImpl(&k).Burn(cacheCtx, msg)
+		return err
+	}); err != nil {
+		res.Error = err.Error()
+	}
+
+	return res, nil
+}
+
+// dryRun validates the msg and executes it in a cache context whose writes are discarded
+func (k Keeper) dryRun(ctx sdk.Context, msg sdk.HasValidateBasic, exec func(sdk.Context) error) error {
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	cacheCtx, _ := ctx.CacheContext()
+	return exec(cacheCtx)
+}
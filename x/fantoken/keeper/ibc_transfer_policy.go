@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bitsongofficial/go-bitsong/x/fantoken/types"
+)
+
+// SetIBCTransferPolicy sets which IBC channels the specified fantoken can be sent over
+func (k Keeper) SetIBCTransferPolicy(ctx sdk.Context, denom string, policy types.IBCTransferPolicy, allowedChannels []string, authority sdk.AccAddress) error {
+	if authority.Empty() {
+		return types.ErrInvalidAuthority
+	}
+
+	fantoken, err := k.getFanTokenByDenom(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	if authority.String() != fantoken.MetaData.Authority {
+		return errors.Wrapf(types.ErrInvalidAuthority, "the address %s is not the authority of the fantoken %s", authority, denom)
+	}
+
+	fantoken.IBCTransferPolicy = policy
+	fantoken.IBCAllowedChannels = allowedChannels
+
+	if err := fantoken.Validate(); err != nil {
+		return err
+	}
+
+	// update fantoken
+	k.setFanToken(ctx, &fantoken)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventSetIBCTransferPolicy{
+		Denom:           denom,
+		Policy:          policy,
+		AllowedChannels: allowedChannels,
+	})
+}
+
+// ValidateIBCTransfer returns an error if the denom is a fantoken which cannot be sent over the
+// given source channel. Denoms which are not fantokens are always allowed.
+func (k Keeper) ValidateIBCTransfer(ctx sdk.Context, denom, sourceChannel string) error {
+	fantoken, err := k.getFanTokenByDenom(ctx, denom)
+	if err != nil {
+		// not a fantoken issued on this chain
+		return nil
+	}
+
+	if !fantoken.IsIBCTransferAllowed(sourceChannel) {
+		return errors.Wrapf(types.ErrIBCTransferNotAllowed, "the fantoken %s cannot be sent over %s", denom, sourceChannel)
+	}
+
+	return nil
+}
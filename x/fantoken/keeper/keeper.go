@@ -14,8 +14,9 @@ import (
 )
 
 type Keeper struct {
-	storeKey storetypes.StoreKey
-	cdc      codec.Codec
+	storeKey      storetypes.StoreKey
+	cdc           codec.Codec
+	moduleAddress sdk.AccAddress
 	// accountKeeper types.AccountKeeper
 	bankKeeper   types.BankKeeper
 	distrKeeper  types.DistrKeeper
@@ -32,7 +33,8 @@ func NewKeeper(
 	distrKeeper types.DistrKeeper,
 	blockedAddrs map[string]bool,
 ) Keeper {
-	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
+	moduleAddress := ak.GetModuleAddress(types.ModuleName)
+	if moduleAddress == nil {
 		panic("the " + types.ModuleName + " module account has not been set")
 	}
 
@@ -42,12 +44,13 @@ func NewKeeper(
 	}
 
 	return Keeper{
-		storeKey:     key,
-		cdc:          cdc,
-		paramSpace:   paramSpace,
-		bankKeeper:   bankKeeper,
-		distrKeeper:  distrKeeper,
-		blockedAddrs: blockedAddrs,
+		storeKey:      key,
+		cdc:           cdc,
+		moduleAddress: moduleAddress,
+		paramSpace:    paramSpace,
+		bankKeeper:    bankKeeper,
+		distrKeeper:   distrKeeper,
+		blockedAddrs:  blockedAddrs,
 	}
 }
 
@@ -57,7 +60,7 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 }
 
 // Issue issues a new fantoken
-func (k Keeper) Issue(ctx sdk.Context, name, symbol, uri string, maxSupply math.Int, minter, authority sdk.AccAddress) (denom string, err error) {
+func (k Keeper) Issue(ctx sdk.Context, name, symbol, uri string, maxSupply math.Int, minter, authority sdk.AccAddress, opts types.IssueOptions) (denom string, err error) {
 	if k.blockedAddrs[authority.String()] {
 		return denom, errors.Wrapf(sdkerrors.ErrUnauthorized, "%s is a module account", authority.String())
 	}
@@ -72,12 +75,24 @@ func (k Keeper) Issue(ctx sdk.Context, name, symbol, uri string, maxSupply math.
 		return denom, errors.Wrapf(types.ErrInvalidMinter, "the address %s is not a valid minter address", minter)
 	}
 
+	// check expiry
+	if opts.ExpiryHeight > 0 && opts.ExpiryHeight <= ctx.BlockHeight() {
+		return denom, errors.Wrapf(types.ErrInvalidExpiry, "the expiry height %d must be after the current height %d", opts.ExpiryHeight, ctx.BlockHeight())
+	}
+
+	if opts.ExpiryTime != nil && !opts.ExpiryTime.After(ctx.BlockTime()) {
+		return denom, errors.Wrapf(types.ErrInvalidExpiry, "the expiry time %s must be after the current block time %s", opts.ExpiryTime, ctx.BlockTime())
+	}
+
 	// handle issue fee
 	if err := k.deductIssueFee(ctx, minter); err != nil {
 		return denom, err
 	}
 
 	fantoken := types.NewFanToken(name, symbol, uri, maxSupply, minter, authority, ctx.BlockHeight())
+	fantoken.ClawbackEnabled = opts.ClawbackEnabled
+	fantoken.ExpiryHeight = opts.ExpiryHeight
+	fantoken.ExpiryTime = opts.ExpiryTime
 	if err := fantoken.Validate(); err != nil {
 		return denom, err
 	}
@@ -125,6 +140,10 @@ func (k Keeper) Mint(ctx sdk.Context, minter, recipient sdk.AccAddress, coin sdk
 		return errors.Wrapf(types.ErrInvalidMinter, "the address %s is not the minter of the fantoken %s", minter.String(), coin.Denom)
 	}
 
+	if k.isExpired(ctx, fantoken) {
+		return errors.Wrapf(types.ErrFanTokenExpired, "the fantoken %s cannot be minted", coin.Denom)
+	}
+
 	// handle Mint fee
 	if err := k.deductMintFee(ctx, minter); err != nil {
 		return err
@@ -296,3 +315,63 @@ func (k Keeper) SetUri(ctx sdk.Context, denom, newUri string, authority sdk.AccA
 
 	return nil
 }
+
+// Clawback burns the specified amount of fantoken from the holder, or sends it
+// to the recipient when not empty. Only fantokens issued with the clawback
+// enabled can be reclaimed, by their minter or authority.
+func (k Keeper) Clawback(ctx sdk.Context, sender, holder, recipient sdk.AccAddress, coin sdk.Coin) error {
+	if sender.Empty() {
+		return types.ErrInvalidOwner
+	}
+
+	if holder.Empty() {
+		return errors.Wrapf(types.ErrInvalidOwner, "the address %s is not a valid holder", holder.String())
+	}
+
+	if k.blockedAddrs[holder.String()] {
+		return errors.Wrapf(sdkerrors.ErrUnauthorized, "%s is a module account", holder.String())
+	}
+
+	if !recipient.Empty() && k.blockedAddrs[recipient.String()] {
+		return errors.Wrapf(sdkerrors.ErrUnauthorized, "%s is a module account", recipient.String())
+	}
+
+	if err := types.ValidateAmount(coin.Amount); err != nil {
+		return err
+	}
+
+	fantoken, err := k.getFanTokenByDenom(ctx, coin.Denom)
+	if err != nil {
+		return err
+	}
+
+	if !fantoken.ClawbackEnabled {
+		return errors.Wrapf(types.ErrClawbackDisabled, "the fantoken %s has been issued without clawback", coin.Denom)
+	}
+
+	if sender.String() != fantoken.Minter && sender.String() != fantoken.MetaData.Authority {
+		return errors.Wrapf(sdkerrors.ErrUnauthorized, "the address %s is neither the minter nor the authority of the fantoken %s", sender, coin.Denom)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, holder, types.ModuleName, sdk.NewCoins(coin)); err != nil {
+		return err
+	}
+
+	if recipient.Empty() {
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(coin)); err != nil {
+			return err
+		}
+	} else {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, sdk.NewCoins(coin)); err != nil {
+			return err
+		}
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventClawback{
+		Denom:     coin.Denom,
+		Sender:    sender.String(),
+		Holder:    holder.String(),
+		Recipient: recipient.String(),
+		Coin:      coin.String(),
+	})
+}
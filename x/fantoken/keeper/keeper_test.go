@@ -11,6 +11,7 @@ import (
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 
 	"testing"
+	"time"
 
 	"github.com/cometbft/cometbft/crypto/tmhash"
 	"github.com/stretchr/testify/suite"
@@ -63,7 +64,7 @@ func TestKeeperSuite(t *testing.T) {
 }
 
 func (suite *KeeperTestSuite) TestIssue() {
-	denom, err := suite.keeper.Issue(suite.ctx, name, symbol, uri, maxSupply, owner, owner)
+	denom, err := suite.keeper.Issue(suite.ctx, name, symbol, uri, maxSupply, owner, owner, fantokentypes.IssueOptions{})
 	suite.NoError(err)
 	suite.True(suite.keeper.HasFanToken(suite.ctx, denom))
 
@@ -81,7 +82,7 @@ func (suite *KeeperTestSuite) TestIssue() {
 
 func (suite *KeeperTestSuite) TestMint() {
 	// issue a new fantoken
-	denom, err := suite.keeper.Issue(suite.ctx, name, symbol, uri, maxSupply, owner, owner)
+	denom, err := suite.keeper.Issue(suite.ctx, name, symbol, uri, maxSupply, owner, owner, fantokentypes.IssueOptions{})
 	suite.NoError(err)
 
 	// check actual fantoken balance
@@ -110,7 +111,7 @@ func (suite *KeeperTestSuite) TestMint() {
 
 func (suite *KeeperTestSuite) TestBurn() {
 	// issue a new fantoken
-	denom, err := suite.keeper.Issue(suite.ctx, name, symbol, uri, maxSupply, owner, owner)
+	denom, err := suite.keeper.Issue(suite.ctx, name, symbol, uri, maxSupply, owner, owner, fantokentypes.IssueOptions{})
 	suite.NoError(err)
 
 	// mint some token
@@ -132,7 +133,7 @@ func (suite *KeeperTestSuite) TestBurn() {
 
 func (suite *KeeperTestSuite) TestSetMinter() {
 	// issue a new fantoken
-	denom, err := suite.keeper.Issue(suite.ctx, name, symbol, uri, maxSupply, owner, owner)
+	denom, err := suite.keeper.Issue(suite.ctx, name, symbol, uri, maxSupply, owner, owner, fantokentypes.IssueOptions{})
 	suite.NoError(err)
 
 	// set the new minter
@@ -157,7 +158,7 @@ func (suite *KeeperTestSuite) TestSetMinter() {
 
 func (suite *KeeperTestSuite) TestSetAuthority() {
 	// issue a new fantoken
-	denom, err := suite.keeper.Issue(suite.ctx, name, symbol, uri, maxSupply, owner, owner)
+	denom, err := suite.keeper.Issue(suite.ctx, name, symbol, uri, maxSupply, owner, owner, fantokentypes.IssueOptions{})
 	suite.NoError(err)
 
 	// set the new authority
@@ -182,7 +183,7 @@ func (suite *KeeperTestSuite) TestSetAuthority() {
 
 func (suite *KeeperTestSuite) TestSetUri() {
 	// issue a new fantoken
-	denom, err := suite.keeper.Issue(suite.ctx, name, symbol, uri, maxSupply, owner, owner)
+	denom, err := suite.keeper.Issue(suite.ctx, name, symbol, uri, maxSupply, owner, owner, fantokentypes.IssueOptions{})
 	suite.NoError(err)
 
 	newUri := "ipfs://newUri"
@@ -217,3 +218,257 @@ func (suite *KeeperTestSuite) TestSetUri() {
 	err = suite.keeper.SetUri(suite.ctx, denom, malformedUri, owner)
 	suite.Error(err)
 }
+
+func (suite *KeeperTestSuite) TestIssuePreview() {
+	req := &fantokentypes.QueryIssuePreviewRequest{
+		Symbol:    symbol,
+		Name:      name,
+		MaxSupply: maxSupply,
+		Authority: owner.String(),
+		Minter:    owner.String(),
+		URI:       uri,
+	}
+
+	res, err := suite.keeper.IssuePreview(suite.ctx, req)
+	suite.NoError(err)
+	suite.Empty(res.Error)
+	suite.Equal(suite.keeper.GetParamSet(suite.ctx).IssueFee, res.Fee)
+
+	// the preview must not persist the fantoken
+	suite.False(suite.keeper.HasFanToken(suite.ctx, res.Denom))
+
+	// the predicted denom matches the one issued in the next block
+	denom, err := suite.keeper.Issue(suite.ctx.WithBlockHeight(suite.ctx.BlockHeight()+1), name, symbol, uri, maxSupply, owner, owner, fantokentypes.IssueOptions{})
+	suite.NoError(err)
+	suite.Equal(denom, res.Denom)
+
+	// top up the issue fee spent above
+	suite.NoError(suite.bk.MintCoins(suite.ctx, fantokentypes.ModuleName, initCoin))
+	suite.NoError(suite.bk.SendCoinsFromModuleToAccount(suite.ctx, fantokentypes.ModuleName, owner, initCoin))
+
+	// a duplicate denom is reported
+	res, err = suite.keeper.IssuePreview(suite.ctx, req)
+	suite.NoError(err)
+	suite.Contains(res.Error, fantokentypes.ErrDenomAlreadyExists.Error())
+
+	// an invalid symbol is reported
+	req.Symbol = "BTC"
+	res, err = suite.keeper.IssuePreview(suite.ctx, req)
+	suite.NoError(err)
+	suite.Contains(res.Error, fantokentypes.ErrInvalidSymbol.Error())
+
+	// an expiry reached at the height of the issue is reported
+	req.Symbol = symbol
+	req.Height = suite.ctx.BlockHeight() + 2
+	req.ExpiryHeight = req.Height
+	res, err = suite.keeper.IssuePreview(suite.ctx, req)
+	suite.NoError(err)
+	suite.Contains(res.Error, fantokentypes.ErrInvalidExpiry.Error())
+
+	req.ExpiryHeight = 0
+	expiryTime := suite.ctx.BlockTime().Add(-time.Hour)
+	req.ExpiryTime = &expiryTime
+	res, err = suite.keeper.IssuePreview(suite.ctx, req)
+	suite.NoError(err)
+	suite.Contains(res.Error, fantokentypes.ErrInvalidExpiry.Error())
+
+	// the options are previewed along with the issue
+	expiryTime = suite.ctx.BlockTime().Add(time.Hour)
+	req.ClawbackEnabled = true
+	res, err = suite.keeper.IssuePreview(suite.ctx, req)
+	suite.NoError(err)
+	suite.Empty(res.Error)
+}
+
+func (suite *KeeperTestSuite) TestMintPreview() {
+	denom, err := suite.keeper.Issue(suite.ctx, name, symbol, uri, maxSupply, owner, owner, fantokentypes.IssueOptions{})
+	suite.NoError(err)
+
+	res, err := suite.keeper.MintPreview(suite.ctx, &fantokentypes.QueryMintPreviewRequest{
+		Coin:   sdk.NewCoin(denom, math.NewInt(10)),
+		Minter: owner.String(),
+	})
+	suite.NoError(err)
+	suite.Empty(res.Error)
+	suite.Equal(suite.keeper.GetParamSet(suite.ctx).MintFee, res.Fee)
+
+	// the preview must not mint anything
+	suite.Equal(math.ZeroInt(), suite.bk.GetSupply(suite.ctx, denom).Amount)
+
+	// exceeding the max supply is reported
+	res, err = suite.keeper.MintPreview(suite.ctx, &fantokentypes.QueryMintPreviewRequest{
+		Coin:   sdk.NewCoin(denom, maxSupply.AddRaw(1)),
+		Minter: owner.String(),
+	})
+	suite.NoError(err)
+	suite.Contains(res.Error, fantokentypes.ErrInvalidAmount.Error())
+}
+
+func (suite *KeeperTestSuite) TestBurnPreview() {
+	denom, err := suite.keeper.Issue(suite.ctx, name, symbol, uri, maxSupply, owner, owner, fantokentypes.IssueOptions{})
+	suite.NoError(err)
+
+	err = suite.keeper.Mint(suite.ctx, owner, owner, sdk.NewCoin(denom, math.NewInt(10)))
+	suite.NoError(err)
+
+	res, err := suite.keeper.BurnPreview(suite.ctx, &fantokentypes.QueryBurnPreviewRequest{
+		Coin:   sdk.NewCoin(denom, math.NewInt(6)),
+		Sender: owner.String(),
+	})
+	suite.NoError(err)
+	suite.Empty(res.Error)
+	suite.Equal(suite.keeper.GetParamSet(suite.ctx).BurnFee, res.Fee)
+
+	// the preview must not burn anything
+	suite.Equal(math.NewInt(10), suite.bk.GetBalance(suite.ctx, owner, denom).Amount)
+
+	// burning more than the balance is reported
+	res, err = suite.keeper.BurnPreview(suite.ctx, &fantokentypes.QueryBurnPreviewRequest{
+		Coin:   sdk.NewCoin(denom, math.NewInt(11)),
+		Sender: owner.String(),
+	})
+	suite.NoError(err)
+	suite.NotEmpty(res.Error)
+}
+
+func (suite *KeeperTestSuite) TestClawback() {
+	holder := sdk.AccAddress(tmhash.SumTruncated([]byte("holder")))
+	recipient := sdk.AccAddress(tmhash.SumTruncated([]byte("recipient")))
+
+	// fantokens issued without clawback are immune
+	immuneDenom, err := suite.keeper.Issue(suite.ctx, name, symbol, uri, maxSupply, owner, owner, fantokentypes.IssueOptions{})
+	suite.NoError(err)
+	suite.NoError(suite.keeper.Mint(suite.ctx, owner, holder, sdk.NewCoin(immuneDenom, math.NewInt(10))))
+
+	err = suite.keeper.Clawback(suite.ctx, owner, holder, sdk.AccAddress{}, sdk.NewCoin(immuneDenom, math.NewInt(5)))
+	suite.ErrorIs(err, fantokentypes.ErrClawbackDisabled)
+	suite.Equal(math.NewInt(10), suite.bk.GetBalance(suite.ctx, holder, immuneDenom).Amount)
+
+	// top up the issue fee spent above
+	suite.NoError(suite.bk.MintCoins(suite.ctx, fantokentypes.ModuleName, initCoin))
+	suite.NoError(suite.bk.SendCoinsFromModuleToAccount(suite.ctx, fantokentypes.ModuleName, owner, initCoin))
+
+	// issue a new fantoken with clawback enabled
+	denom, err := suite.keeper.Issue(suite.ctx, name, "eth", uri, maxSupply, owner, owner, fantokentypes.IssueOptions{ClawbackEnabled: true})
+	suite.NoError(err)
+
+	fantoken, err := suite.keeper.GetFanToken(suite.ctx, denom)
+	suite.NoError(err)
+	suite.True(fantoken.ClawbackEnabled)
+
+	suite.NoError(suite.keeper.Mint(suite.ctx, owner, holder, sdk.NewCoin(denom, math.NewInt(10))))
+
+	// only the minter or the authority can clawback
+	err = suite.keeper.Clawback(suite.ctx, recipient, holder, sdk.AccAddress{}, sdk.NewCoin(denom, math.NewInt(5)))
+	suite.Error(err)
+
+	// burn from the holder
+	err = suite.keeper.Clawback(suite.ctx, owner, holder, sdk.AccAddress{}, sdk.NewCoin(denom, math.NewInt(4)))
+	suite.NoError(err)
+	suite.Equal(math.NewInt(6), suite.bk.GetBalance(suite.ctx, holder, denom).Amount)
+	suite.Equal(math.NewInt(6), suite.bk.GetSupply(suite.ctx, denom).Amount)
+
+	// reclaim to the recipient
+	err = suite.keeper.Clawback(suite.ctx, owner, holder, recipient, sdk.NewCoin(denom, math.NewInt(6)))
+	suite.NoError(err)
+	suite.Equal(math.ZeroInt(), suite.bk.GetBalance(suite.ctx, holder, denom).Amount)
+	suite.Equal(math.NewInt(6), suite.bk.GetBalance(suite.ctx, recipient, denom).Amount)
+	suite.Equal(math.NewInt(6), suite.bk.GetSupply(suite.ctx, denom).Amount)
+}
+
+func (suite *KeeperTestSuite) TestExpiry() {
+	holder := sdk.AccAddress(tmhash.SumTruncated([]byte("holder")))
+	recipient := sdk.AccAddress(tmhash.SumTruncated([]byte("recipient")))
+	expiryHeight := suite.ctx.BlockHeight() + 10
+
+	// the expiry must be in the future
+	pastTime := suite.ctx.BlockTime()
+	_, err := suite.keeper.Issue(suite.ctx, name, symbol, uri, maxSupply, owner, owner, fantokentypes.IssueOptions{ExpiryTime: &pastTime})
+	suite.ErrorIs(err, fantokentypes.ErrInvalidExpiry)
+
+	denom, err := suite.keeper.Issue(suite.ctx, name, symbol, uri, maxSupply, owner, owner, fantokentypes.IssueOptions{ExpiryHeight: expiryHeight})
+	suite.NoError(err)
+	suite.NoError(suite.keeper.Mint(suite.ctx, owner, holder, sdk.NewCoin(denom, math.NewInt(10))))
+
+	// transferable before the expiry
+	suite.NoError(suite.bk.SendCoins(suite.ctx, holder, recipient, sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(2)))))
+	suite.NoError(suite.keeper.ExpireFanTokens(suite.ctx))
+
+	fantoken, err := suite.keeper.GetFanToken(suite.ctx, denom)
+	suite.NoError(err)
+	suite.False(fantoken.Expired)
+
+	// the expiry is reached
+	ctx := suite.ctx.WithBlockHeight(expiryHeight).WithEventManager(sdk.NewEventManager())
+
+	// the state is reverted on failure as in a tx
+	cacheCtx, _ := ctx.CacheContext()
+	err = suite.bk.SendCoins(cacheCtx, holder, recipient, sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(2))))
+	suite.ErrorIs(err, fantokentypes.ErrFanTokenExpired)
+
+	err = suite.keeper.Mint(ctx, owner, holder, sdk.NewCoin(denom, math.NewInt(10)))
+	suite.ErrorIs(err, fantokentypes.ErrFanTokenExpired)
+
+	// the holders can still burn
+	suite.NoError(suite.keeper.Burn(ctx, sdk.NewCoin(denom, math.NewInt(3)), holder))
+	suite.Equal(math.NewInt(5), suite.bk.GetBalance(ctx, holder, denom).Amount)
+
+	// the end blocker marks the fantoken as expired only once
+	suite.NoError(suite.keeper.ExpireFanTokens(ctx))
+	suite.NoError(suite.keeper.ExpireFanTokens(ctx))
+
+	var events int
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == "bitsong.fantoken.v1beta1.EventExpire" {
+			events++
+		}
+	}
+	suite.Equal(1, events)
+
+	fantoken, err = suite.keeper.GetFanToken(ctx, denom)
+	suite.NoError(err)
+	suite.True(fantoken.Expired)
+}
+
+func (suite *KeeperTestSuite) TestExpiryQueue() {
+	expiryTime := suite.ctx.BlockTime().Add(time.Hour)
+	expiryHeight := suite.ctx.BlockHeight() + 10
+
+	// top up the issue fees of the additional fantokens
+	for i := 0; i < 2; i++ {
+		suite.NoError(suite.bk.MintCoins(suite.ctx, fantokentypes.ModuleName, initCoin))
+		suite.NoError(suite.bk.SendCoinsFromModuleToAccount(suite.ctx, fantokentypes.ModuleName, owner, initCoin))
+	}
+
+	byTime, err := suite.keeper.Issue(suite.ctx, name, "tme", uri, maxSupply, owner, owner, fantokentypes.IssueOptions{ExpiryTime: &expiryTime})
+	suite.NoError(err)
+	byHeight, err := suite.keeper.Issue(suite.ctx, name, "hgt", uri, maxSupply, owner, owner, fantokentypes.IssueOptions{ExpiryHeight: expiryHeight})
+	suite.NoError(err)
+	byBoth, err := suite.keeper.Issue(suite.ctx, name, "bth", uri, maxSupply, owner, owner, fantokentypes.IssueOptions{ExpiryHeight: expiryHeight, ExpiryTime: &expiryTime})
+	suite.NoError(err)
+
+	expired := func(ctx sdk.Context, denom string) bool {
+		fantoken, err := suite.keeper.GetFanToken(ctx, denom)
+		suite.NoError(err)
+		return fantoken.Expired
+	}
+
+	// the fantokens expiring at a later height or time are not expired
+	ctx := suite.ctx.WithBlockTime(expiryTime.Add(-time.Second))
+	suite.NoError(suite.keeper.ExpireFanTokens(ctx))
+	suite.False(expired(ctx, byTime))
+	suite.False(expired(ctx, byHeight))
+	suite.False(expired(ctx, byBoth))
+
+	// the first expiry reached expires the fantoken
+	ctx = ctx.WithBlockTime(expiryTime)
+	suite.NoError(suite.keeper.ExpireFanTokens(ctx))
+	suite.True(expired(ctx, byTime))
+	suite.False(expired(ctx, byHeight))
+	suite.True(expired(ctx, byBoth))
+
+	ctx = ctx.WithBlockHeight(expiryHeight).WithEventManager(sdk.NewEventManager())
+	suite.NoError(suite.keeper.ExpireFanTokens(ctx))
+	suite.True(expired(ctx, byHeight))
+	suite.Len(ctx.EventManager().Events(), 1)
+}
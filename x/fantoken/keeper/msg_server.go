@@ -36,7 +36,11 @@ func (m msgServer) Issue(goCtx context.Context, msg *types.MsgIssue) (*types.Msg
 		return nil, err
 	}
 
-	denom, err := m.Keeper.Issue(ctx, msg.Name, msg.Symbol, msg.URI, msg.MaxSupply, minter, authority)
+	denom, err := m.Keeper.Issue(ctx, msg.Name, msg.Symbol, msg.URI, msg.MaxSupply, minter, authority, types.IssueOptions{
+		ClawbackEnabled: msg.ClawbackEnabled,
+		ExpiryHeight:    msg.ExpiryHeight,
+		ExpiryTime:      msg.ExpiryTime,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -180,3 +184,53 @@ func (m msgServer) SetUri(goCtx context.Context, msg *types.MsgSetUri) (*types.M
 		Denom: msg.Denom,
 	}, nil
 }
+
+func (m msgServer) Clawback(goCtx context.Context, msg *types.MsgClawback) (*types.MsgClawbackResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	holder, err := sdk.AccAddressFromBech32(msg.Holder)
+	if err != nil {
+		return nil, err
+	}
+
+	var recipient sdk.AccAddress
+
+	if msg.Recipient != "" {
+		recipient, err = sdk.AccAddressFromBech32(msg.Recipient)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := m.Keeper.Clawback(ctx, sender, holder, recipient, msg.Coin); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgClawbackResponse{
+		Holder:    msg.Holder,
+		Recipient: msg.Recipient,
+		Coin:      msg.Coin,
+	}, nil
+}
+
+func (m msgServer) SetIBCTransferPolicy(goCtx context.Context, msg *types.MsgSetIBCTransferPolicy) (*types.MsgSetIBCTransferPolicyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.Keeper.SetIBCTransferPolicy(ctx, msg.Denom, msg.Policy, msg.AllowedChannels, authority); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetIBCTransferPolicyResponse{
+		Denom: msg.Denom,
+	}, nil
+}
@@ -39,6 +39,26 @@ func (k Keeper) getFanTokenByDenom(ctx sdk.Context, denom string) (fantoken type
 	return fantoken, nil
 }
 
+func (k Keeper) setExpiryQueue(ctx sdk.Context, fantoken *types.FanToken) {
+	store := ctx.KVStore(k.storeKey)
+	if fantoken.ExpiryHeight > 0 {
+		store.Set(types.KeyExpiryHeightQueue(fantoken.ExpiryHeight, fantoken.GetDenom()), []byte{0x01})
+	}
+	if fantoken.ExpiryTime != nil {
+		store.Set(types.KeyExpiryTimeQueue(*fantoken.ExpiryTime, fantoken.GetDenom()), []byte{0x01})
+	}
+}
+
+func (k Keeper) deleteExpiryQueue(ctx sdk.Context, fantoken *types.FanToken) {
+	store := ctx.KVStore(k.storeKey)
+	if fantoken.ExpiryHeight > 0 {
+		store.Delete(types.KeyExpiryHeightQueue(fantoken.ExpiryHeight, fantoken.GetDenom()))
+	}
+	if fantoken.ExpiryTime != nil {
+		store.Delete(types.KeyExpiryTimeQueue(*fantoken.ExpiryTime, fantoken.GetDenom()))
+	}
+}
+
 // reset all indices by the new owner for fantoken query
 func (k Keeper) resetStoreKeyForQueryToken(ctx sdk.Context, denom string, srcOwner, dstOwner sdk.AccAddress) {
 	store := ctx.KVStore(k.storeKey)
@@ -6,15 +6,15 @@ import (
 	"fmt"
 
 	"cosmossdk.io/core/appmodule"
-	"cosmossdk.io/math"
 
-	// simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/gorilla/mux"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/spf13/cobra"
 
 	"github.com/bitsongofficial/go-bitsong/x/fantoken/client/cli"
 	"github.com/bitsongofficial/go-bitsong/x/fantoken/keeper"
+	"github.com/bitsongofficial/go-bitsong/x/fantoken/simulation"
 	"github.com/bitsongofficial/go-bitsong/x/fantoken/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -24,9 +24,10 @@ import (
 )
 
 var (
-	_ module.AppModuleBasic = (*AppModule)(nil)
-	// _ module.AppModuleSimulation = (*AppModule)(nil)
-	_ module.HasGenesis = (*AppModule)(nil)
+	_ module.AppModuleBasic      = (*AppModule)(nil)
+	_ module.AppModuleSimulation = (*AppModule)(nil)
+	_ module.HasProposalContents = (*AppModule)(nil)
+	_ module.HasGenesis          = (*AppModule)(nil)
 
 	_ appmodule.HasBeginBlocker = (*AppModule)(nil)
 	_ appmodule.HasEndBlocker   = (*AppModule)(nil)
@@ -93,15 +94,17 @@ func (AppModuleBasic) RegisterInterfaces(registry codectypes.InterfaceRegistry)
 type AppModule struct {
 	AppModuleBasic
 
-	keeper     keeper.Keeper
-	bankKeeper types.BankKeeper
+	keeper        keeper.Keeper
+	accountKeeper types.AccountKeeper
+	bankKeeper    types.BankKeeper
 }
 
 // NewAppModule creates a new AppModule object
-func NewAppModule(cdc codec.Codec, keeper keeper.Keeper, bk types.BankKeeper) AppModule {
+func NewAppModule(cdc codec.Codec, keeper keeper.Keeper, ak types.AccountKeeper, bk types.BankKeeper) AppModule {
 	return AppModule{
 		AppModuleBasic: AppModuleBasic{cdc: cdc},
 		keeper:         keeper,
+		accountKeeper:  ak,
 		bankKeeper:     bk,
 	}
 }
@@ -158,20 +161,34 @@ func (AppModule) BeginBlock(_ context.Context) error {
 }
 
 // EndBlock returns the end blocker for the fantoken module. It returns no validator updates.
-func (AppModule) EndBlock(_ context.Context) error {
-	return nil
+func (am AppModule) EndBlock(ctx context.Context) error {
+	return EndBlocker(sdk.UnwrapSDKContext(ctx), am.keeper)
+}
+
+// ____________________________________________________________________________
+
+// AppModuleSimulation functions
+
+// GenerateGenesisState creates a randomized GenState of the fantoken module.
+func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
+	simulation.RandomizedGenState(simState)
 }
 
-// GenerateGenesisState creates a randomized GenState of the valset module.
-func (am AppModule) SimulatorGenesisState(simState *module.SimulationState) {
-	ftDefaultGen := types.DefaultGenesisState()
-	ftDefaultGen.Params.IssueFee = sdk.NewCoin("stake", math.NewInt(10000000))
-	ftDefaultGenJson := simState.Cdc.MustMarshalJSON(ftDefaultGen)
-	simState.GenState[types.ModuleName] = ftDefaultGenJson
+// ProposalContents returns all the fantoken content functions used to
+// simulate governance proposals.
+func (AppModule) ProposalContents(simState module.SimulationState) []simtypes.WeightedProposalContent { //nolint:staticcheck // the fees are updated through a legacy proposal
+	return simulation.ProposalContents(simState.BondDenom)
 }
 
-// // GenerateGenesisState creates a randomized GenState of the module.
-// func (a AppModule) GenerateGenesisState(_ *module.SimulationState) {}
+// RegisterStoreDecoder registers a decoder for fantoken module's types
+func (am AppModule) RegisterStoreDecoder(sdr simtypes.StoreDecoderRegistry) {
+	sdr[types.StoreKey] = simulation.NewDecodeStore(am.cdc)
+}
 
-// // RegisterStoreDecoder registers a decoder for module simulation testing
-// func (am AppModule) RegisterStoreDecoder(sdr simtypes.StoreDecoderRegistry) {}
+// WeightedOperations returns the all the fantoken module operations with their respective weights.
+func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
+	return simulation.WeightedOperations(
+		simState.AppParams, simState.Cdc, simState.TxConfig,
+		am.accountKeeper, am.bankKeeper, am.keeper,
+	)
+}
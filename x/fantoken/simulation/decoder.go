@@ -0,0 +1,39 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	gogotypes "github.com/cosmos/gogoproto/types"
+
+	"github.com/bitsongofficial/go-bitsong/x/fantoken/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding fantoken type.
+func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key[:1], types.PrefixFanTokenForDenom):
+			var fantokenA, fantokenB types.FanToken
+			cdc.MustUnmarshal(kvA.Value, &fantokenA)
+			cdc.MustUnmarshal(kvB.Value, &fantokenB)
+			return fmt.Sprintf("%v\n%v", fantokenA, fantokenB)
+
+		case bytes.Equal(kvA.Key[:1], types.PrefixFanTokens):
+			var denomA, denomB gogotypes.StringValue
+			cdc.MustUnmarshal(kvA.Value, &denomA)
+			cdc.MustUnmarshal(kvB.Value, &denomB)
+			return fmt.Sprintf("%v\n%v", denomA.Value, denomB.Value)
+
+		case bytes.Equal(kvA.Key[:1], types.PrefixExpiryHeightQueue),
+			bytes.Equal(kvA.Key[:1], types.PrefixExpiryTimeQueue):
+			return fmt.Sprintf("%X\n%X", kvA.Key[1:], kvB.Key[1:])
+
+		default:
+			panic(fmt.Sprintf("invalid %s key prefix %X", types.ModuleName, kvA.Key[:1]))
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/bitsongofficial/go-bitsong/x/fantoken/types"
+)
+
+// Simulation parameter constants
+const (
+	IssueFee  = "issue_fee"
+	MintFee   = "mint_fee"
+	BurnFee   = "burn_fee"
+	FanTokens = "fan_tokens"
+)
+
+// GenIssueFee randomized IssueFee
+func GenIssueFee(r *rand.Rand, bondDenom string) sdk.Coin {
+	return sdk.NewCoin(bondDenom, math.NewInt(int64(simtypes.RandIntBetween(r, 0, 1_000_000))))
+}
+
+// GenMintFee randomized MintFee
+func GenMintFee(r *rand.Rand, bondDenom string) sdk.Coin {
+	return sdk.NewCoin(bondDenom, math.NewInt(int64(simtypes.RandIntBetween(r, 0, 1_000))))
+}
+
+// GenBurnFee randomized BurnFee
+func GenBurnFee(r *rand.Rand, bondDenom string) sdk.Coin {
+	return sdk.NewCoin(bondDenom, math.NewInt(int64(simtypes.RandIntBetween(r, 0, 1_000))))
+}
+
+// GenFanTokens randomized fantokens issued at genesis by the simulation accounts
+func GenFanTokens(r *rand.Rand, accs []simtypes.Account) []types.FanToken {
+	fantokens := make([]types.FanToken, 0)
+
+	for i, n := 0, r.Intn(5); i < n; i++ {
+		minter, _ := simtypes.RandomAcc(r, accs)
+		authority, _ := simtypes.RandomAcc(r, accs)
+
+		fantoken := types.NewFanToken(
+			simtypes.RandStringOfLength(r, 16),
+			randSymbol(r),
+			randURI(r),
+			randMaxSupply(r),
+			minter.Address,
+			authority.Address,
+			0,
+		)
+		fantoken.ClawbackEnabled = r.Intn(2) == 0
+
+		fantokens = append(fantokens, *fantoken)
+	}
+
+	return fantokens
+}
+
+// RandomizedGenState generates a random GenesisState for fantoken
+func RandomizedGenState(simState *module.SimulationState) {
+	var issueFee sdk.Coin
+	simState.AppParams.GetOrGenerate(IssueFee, &issueFee, simState.Rand, func(r *rand.Rand) { issueFee = GenIssueFee(r, simState.BondDenom) })
+
+	var mintFee sdk.Coin
+	simState.AppParams.GetOrGenerate(MintFee, &mintFee, simState.Rand, func(r *rand.Rand) { mintFee = GenMintFee(r, simState.BondDenom) })
+
+	var burnFee sdk.Coin
+	simState.AppParams.GetOrGenerate(BurnFee, &burnFee, simState.Rand, func(r *rand.Rand) { burnFee = GenBurnFee(r, simState.BondDenom) })
+
+	var fantokens []types.FanToken
+	simState.AppParams.GetOrGenerate(FanTokens, &fantokens, simState.Rand, func(r *rand.Rand) { fantokens = GenFanTokens(r, simState.Accounts) })
+
+	fantokenGenesis := types.NewGenesisState(
+		types.NewParams(issueFee, mintFee, burnFee, sdk.Coin{}),
+		fantokens,
+	)
+
+	bz, err := json.MarshalIndent(&fantokenGenesis, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated fantoken parameters:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&fantokenGenesis)
+}
+
+// randSymbol returns a random symbol accepted by types.ValidateSymbol
+func randSymbol(r *rand.Rand) string {
+	const letters = "abcdefghijklmnopqrstuvwxyz0123456789"
+
+	bz := make([]byte, simtypes.RandIntBetween(r, 3, 12))
+	for i := range bz {
+		bz[i] = letters[r.Intn(len(letters))]
+	}
+	return string(bz)
+}
+
+// randURI returns a random uri, empty half of the time
+func randURI(r *rand.Rand) string {
+	if r.Intn(2) == 0 {
+		return ""
+	}
+	return "ipfs://" + simtypes.RandStringOfLength(r, 46)
+}
+
+// randMaxSupply returns a random positive max supply
+func randMaxSupply(r *rand.Rand) math.Int {
+	return math.NewInt(int64(simtypes.RandIntBetween(r, 1, 1_000_000_000_000)))
+}
@@ -0,0 +1,430 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/bitsongofficial/go-bitsong/x/fantoken/keeper"
+	"github.com/bitsongofficial/go-bitsong/x/fantoken/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgIssue        = "op_weight_msg_issue"
+	OpWeightMsgMint         = "op_weight_msg_mint"
+	OpWeightMsgBurn         = "op_weight_msg_burn"
+	OpWeightMsgDisableMint  = "op_weight_msg_disable_mint"
+	OpWeightMsgSetMinter    = "op_weight_msg_set_minter"
+	OpWeightMsgSetAuthority = "op_weight_msg_set_authority"
+	OpWeightMsgSetUri       = "op_weight_msg_set_uri"
+	OpWeightMsgClawback     = "op_weight_msg_clawback"
+
+	OpWeightMsgSetIBCTransferPolicy = "op_weight_msg_set_ibc_transfer_policy"
+
+	DefaultWeightMsgIssue        = 100
+	DefaultWeightMsgMint         = 100
+	DefaultWeightMsgBurn         = 50
+	DefaultWeightMsgDisableMint  = 5
+	DefaultWeightMsgSetMinter    = 20
+	DefaultWeightMsgSetAuthority = 20
+	DefaultWeightMsgSetUri       = 20
+	DefaultWeightMsgClawback     = 20
+
+	DefaultWeightMsgSetIBCTransferPolicy = 10
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams,
+	_ codec.JSONCodec,
+	txGen client.TxConfig,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+) simulation.WeightedOperations {
+	var (
+		weightMsgIssue        int
+		weightMsgMint         int
+		weightMsgBurn         int
+		weightMsgDisableMint  int
+		weightMsgSetMinter    int
+		weightMsgSetAuthority int
+		weightMsgSetUri       int
+		weightMsgClawback     int
+
+		weightMsgSetIBCTransferPolicy int
+	)
+
+	appParams.GetOrGenerate(OpWeightMsgIssue, &weightMsgIssue, nil, func(_ *rand.Rand) {
+		weightMsgIssue = DefaultWeightMsgIssue
+	})
+	appParams.GetOrGenerate(OpWeightMsgMint, &weightMsgMint, nil, func(_ *rand.Rand) {
+		weightMsgMint = DefaultWeightMsgMint
+	})
+	appParams.GetOrGenerate(OpWeightMsgBurn, &weightMsgBurn, nil, func(_ *rand.Rand) {
+		weightMsgBurn = DefaultWeightMsgBurn
+	})
+	appParams.GetOrGenerate(OpWeightMsgDisableMint, &weightMsgDisableMint, nil, func(_ *rand.Rand) {
+		weightMsgDisableMint = DefaultWeightMsgDisableMint
+	})
+	appParams.GetOrGenerate(OpWeightMsgSetMinter, &weightMsgSetMinter, nil, func(_ *rand.Rand) {
+		weightMsgSetMinter = DefaultWeightMsgSetMinter
+	})
+	appParams.GetOrGenerate(OpWeightMsgSetAuthority, &weightMsgSetAuthority, nil, func(_ *rand.Rand) {
+		weightMsgSetAuthority = DefaultWeightMsgSetAuthority
+	})
+	appParams.GetOrGenerate(OpWeightMsgSetUri, &weightMsgSetUri, nil, func(_ *rand.Rand) {
+		weightMsgSetUri = DefaultWeightMsgSetUri
+	})
+	appParams.GetOrGenerate(OpWeightMsgClawback, &weightMsgClawback, nil, func(_ *rand.Rand) {
+		weightMsgClawback = DefaultWeightMsgClawback
+	})
+	appParams.GetOrGenerate(OpWeightMsgSetIBCTransferPolicy, &weightMsgSetIBCTransferPolicy, nil, func(_ *rand.Rand) {
+		weightMsgSetIBCTransferPolicy = DefaultWeightMsgSetIBCTransferPolicy
+	})
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(weightMsgIssue, SimulateMsgIssue(txGen, ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgMint, SimulateMsgMint(txGen, ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgBurn, SimulateMsgBurn(txGen, ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgDisableMint, SimulateMsgDisableMint(txGen, ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgSetMinter, SimulateMsgSetMinter(txGen, ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgSetAuthority, SimulateMsgSetAuthority(txGen, ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgSetUri, SimulateMsgSetUri(txGen, ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgClawback, SimulateMsgClawback(txGen, ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgSetIBCTransferPolicy, SimulateMsgSetIBCTransferPolicy(txGen, ak, bk, k)),
+	}
+}
+
+// SimulateMsgIssue generates a MsgIssue with random values
+func SimulateMsgIssue(txGen client.TxConfig, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		symbol, name := randSymbol(r), simtypes.RandStringOfLength(r, 16)
+
+		msg := &types.MsgIssue{
+			Symbol:          symbol,
+			Name:            name,
+			MaxSupply:       randMaxSupply(r),
+			Authority:       simAccount.Address.String(),
+			Minter:          simAccount.Address.String(),
+			URI:             randURI(r),
+			ClawbackEnabled: r.Intn(2) == 0,
+			// expiring fantokens are not issued as the bank operations would fail
+			// sending them once expired
+		}
+
+		if k.HasFanToken(ctx, types.GetFantokenDenom(ctx.BlockHeight(), simAccount.Address, symbol, name)) {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "denom already exists"), nil, nil
+		}
+
+		fee := k.GetParamSet(ctx).IssueFee
+		return deliver(r, app, txGen, ak, bk, ctx, simAccount, msg, feeCoins(fee))
+	}
+}
+
+// SimulateMsgMint generates a MsgMint of a random mintable fantoken
+func SimulateMsgMint(txGen client.TxConfig, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgMint{})
+
+		fantoken, ok := randFanToken(r, ctx, k, types.FanToken.GetMintable)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no mintable fantoken"), nil, nil
+		}
+
+		minter, ok := simtypes.FindAccount(accs, fantoken.GetMinter())
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "minter not found"), nil, nil
+		}
+
+		mintable := fantoken.MaxSupply.Sub(bk.GetSupply(ctx, fantoken.Denom).Amount)
+		if !mintable.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "max supply reached"), nil, nil
+		}
+
+		amount, err := simtypes.RandPositiveInt(r, mintable)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, nil
+		}
+
+		recipient, _ := simtypes.RandomAcc(r, accs)
+		msg := types.NewMsgMint(recipient.Address.String(), sdk.NewCoin(fantoken.Denom, amount), minter.Address.String())
+
+		fee := k.GetParamSet(ctx).MintFee
+		return deliver(r, app, txGen, ak, bk, ctx, minter, msg, feeCoins(fee))
+	}
+}
+
+// SimulateMsgBurn generates a MsgBurn of a random amount of fantoken held by a random account
+func SimulateMsgBurn(txGen client.TxConfig, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgBurn{})
+
+		fantoken, ok := randFanToken(r, ctx, k, nil)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no fantoken"), nil, nil
+		}
+
+		// look for an holder of the fantoken starting from a random account
+		offset := r.Intn(len(accs))
+		for i := range accs {
+			holder := accs[(offset+i)%len(accs)]
+
+			balance := bk.SpendableCoins(ctx, holder.Address).AmountOf(fantoken.Denom)
+			if !balance.IsPositive() {
+				continue
+			}
+
+			amount, err := simtypes.RandPositiveInt(r, balance)
+			if err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, nil
+			}
+
+			coin := sdk.NewCoin(fantoken.Denom, amount)
+			msg := types.NewMsgBurn(coin, holder.Address.String())
+
+			fee := k.GetParamSet(ctx).BurnFee
+			return deliver(r, app, txGen, ak, bk, ctx, holder, msg, feeCoins(fee).Add(coin))
+		}
+
+		return simtypes.NoOpMsg(types.ModuleName, msgType, "no fantoken holder"), nil, nil
+	}
+}
+
+// SimulateMsgDisableMint generates a MsgDisableMint of a random mintable fantoken
+func SimulateMsgDisableMint(txGen client.TxConfig, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgDisableMint{})
+
+		fantoken, ok := randFanToken(r, ctx, k, types.FanToken.GetMintable)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no mintable fantoken"), nil, nil
+		}
+
+		minter, ok := simtypes.FindAccount(accs, fantoken.GetMinter())
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "minter not found"), nil, nil
+		}
+
+		msg := types.NewMsgDisableMint(fantoken.Denom, minter.Address.String())
+		return deliver(r, app, txGen, ak, bk, ctx, minter, msg, nil)
+	}
+}
+
+// SimulateMsgSetMinter generates a MsgSetMinter of a random mintable fantoken to a random account
+func SimulateMsgSetMinter(txGen client.TxConfig, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgSetMinter{})
+
+		fantoken, ok := randFanToken(r, ctx, k, types.FanToken.GetMintable)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no mintable fantoken"), nil, nil
+		}
+
+		minter, ok := simtypes.FindAccount(accs, fantoken.GetMinter())
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "minter not found"), nil, nil
+		}
+
+		newMinter, _ := simtypes.RandomAcc(r, accs)
+		msg := types.NewMsgSetMinter(fantoken.Denom, minter.Address.String(), newMinter.Address.String())
+		return deliver(r, app, txGen, ak, bk, ctx, minter, msg, nil)
+	}
+}
+
+// SimulateMsgSetAuthority generates a MsgSetAuthority of a random fantoken to a random account
+func SimulateMsgSetAuthority(txGen client.TxConfig, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgSetAuthority{})
+
+		fantoken, ok := randFanToken(r, ctx, k, hasAuthority)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no fantoken with an authority"), nil, nil
+		}
+
+		authority, ok := simtypes.FindAccount(accs, fantoken.GetAuthority())
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "authority not found"), nil, nil
+		}
+
+		newAuthority, _ := simtypes.RandomAcc(r, accs)
+		msg := types.NewMsgSetAuthority(fantoken.Denom, authority.Address.String(), newAuthority.Address.String())
+		return deliver(r, app, txGen, ak, bk, ctx, authority, msg, nil)
+	}
+}
+
+// SimulateMsgSetUri generates a MsgSetUri of a random fantoken with a random uri
+func SimulateMsgSetUri(txGen client.TxConfig, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgSetUri{})
+
+		fantoken, ok := randFanToken(r, ctx, k, hasAuthority)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no fantoken with an authority"), nil, nil
+		}
+
+		authority, ok := simtypes.FindAccount(accs, fantoken.GetAuthority())
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "authority not found"), nil, nil
+		}
+
+		msg := types.NewMsgSetUri(fantoken.Denom, randURI(r), authority.Address.String())
+		return deliver(r, app, txGen, ak, bk, ctx, authority, msg, nil)
+	}
+}
+
+// SimulateMsgClawback generates a MsgClawback of a random amount of fantoken held by a random
+// account, burning it or sending it to a random recipient
+func SimulateMsgClawback(txGen client.TxConfig, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgClawback{})
+
+		fantoken, ok := randFanToken(r, ctx, k, func(fantoken types.FanToken) bool { return fantoken.ClawbackEnabled })
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no fantoken with clawback"), nil, nil
+		}
+
+		// the clawback is signed either by the minter or by the authority
+		sender, ok := simtypes.FindAccount(accs, fantoken.GetMinter())
+		if !ok || r.Intn(2) == 0 {
+			if authority, found := simtypes.FindAccount(accs, fantoken.GetAuthority()); found {
+				sender, ok = authority, true
+			}
+		}
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "minter and authority not found"), nil, nil
+		}
+
+		// look for an holder of the fantoken starting from a random account
+		offset := r.Intn(len(accs))
+		for i := range accs {
+			holder := accs[(offset+i)%len(accs)]
+
+			balance := bk.SpendableCoins(ctx, holder.Address).AmountOf(fantoken.Denom)
+			if !balance.IsPositive() {
+				continue
+			}
+
+			amount, err := simtypes.RandPositiveInt(r, balance)
+			if err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, nil
+			}
+
+			var recipient string
+			if r.Intn(2) == 0 {
+				rcpt, _ := simtypes.RandomAcc(r, accs)
+				recipient = rcpt.Address.String()
+			}
+
+			msg := types.NewMsgClawback(sender.Address.String(), holder.Address.String(), recipient, sdk.NewCoin(fantoken.Denom, amount))
+			return deliver(r, app, txGen, ak, bk, ctx, sender, msg, nil)
+		}
+
+		return simtypes.NoOpMsg(types.ModuleName, msgType, "no fantoken holder"), nil, nil
+	}
+}
+
+// SimulateMsgSetIBCTransferPolicy generates a MsgSetIBCTransferPolicy of a random fantoken with a
+// random policy
+func SimulateMsgSetIBCTransferPolicy(txGen client.TxConfig, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgSetIBCTransferPolicy{})
+
+		fantoken, ok := randFanToken(r, ctx, k, hasAuthority)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no fantoken with an authority"), nil, nil
+		}
+
+		authority, ok := simtypes.FindAccount(accs, fantoken.GetAuthority())
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "authority not found"), nil, nil
+		}
+
+		policy := types.IBCTransferPolicy(r.Intn(len(types.IBCTransferPolicy_name)))
+
+		var channels []string
+		if policy == types.IBCTransferPolicyAllowlist {
+			for i, n := 0, r.Intn(3); i < n; i++ {
+				channels = append(channels, fmt.Sprintf("channel-%d", i))
+			}
+		}
+
+		msg := types.NewMsgSetIBCTransferPolicy(fantoken.Denom, policy, channels, authority.Address.String())
+		return deliver(r, app, txGen, ak, bk, ctx, authority, msg, nil)
+	}
+}
+
+// deliver signs the msg with the simulation account and delivers it paying random fees
+func deliver(
+	r *rand.Rand, app *baseapp.BaseApp, txGen client.TxConfig, ak types.AccountKeeper, bk types.BankKeeper,
+	ctx sdk.Context, simAccount simtypes.Account, msg sdk.Msg, spent sdk.Coins,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	txCtx := simulation.OperationInput{
+		R:               r,
+		App:             app,
+		TxGen:           txGen,
+		Cdc:             nil,
+		Msg:             msg,
+		CoinsSpentInMsg: spent,
+		Context:         ctx,
+		SimAccount:      simAccount,
+		AccountKeeper:   ak,
+		Bankkeeper:      bk,
+		ModuleName:      types.ModuleName,
+	}
+
+	return simulation.GenAndDeliverTxWithRandFees(txCtx)
+}
+
+// randFanToken returns a random fantoken matching the filter
+func randFanToken(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, filter func(types.FanToken) bool) (types.FanToken, bool) {
+	var fantokens []types.FanToken
+	for _, fantoken := range k.GetFanTokens(ctx, nil) {
+		if filter == nil || filter(fantoken) {
+			fantokens = append(fantokens, fantoken)
+		}
+	}
+
+	if len(fantokens) == 0 {
+		return types.FanToken{}, false
+	}
+	return fantokens[r.Intn(len(fantokens))], true
+}
+
+func hasAuthority(fantoken types.FanToken) bool {
+	return fantoken.MetaData.Authority != ""
+}
+
+// feeCoins returns the module fee as coins, empty when the fee is disabled
+func feeCoins(fee sdk.Coin) sdk.Coins {
+	if !fee.Amount.IsPositive() {
+		return sdk.NewCoins()
+	}
+	return sdk.NewCoins(fee)
+}
@@ -0,0 +1,45 @@
+package simulation
+
+import (
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/bitsongofficial/go-bitsong/x/fantoken/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightSubmitUpdateFeesProposal = "op_weight_submit_update_fees_proposal"
+	DefaultWeightUpdateFeesProposal  = 5
+)
+
+// ProposalContents defines the module weighted proposals' contents
+//
+//nolint:staticcheck // the fees are updated through a legacy proposal
+func ProposalContents(bondDenom string) []simtypes.WeightedProposalContent {
+	return []simtypes.WeightedProposalContent{
+		simulation.NewWeightedProposalContent(
+			OpWeightSubmitUpdateFeesProposal,
+			DefaultWeightUpdateFeesProposal,
+			SimulateUpdateFeesProposalContent(bondDenom),
+		),
+	}
+}
+
+// SimulateUpdateFeesProposalContent returns a random UpdateFeesProposal content
+//
+//nolint:staticcheck // the fees are updated through a legacy proposal
+func SimulateUpdateFeesProposalContent(bondDenom string) simtypes.ContentSimulatorFn {
+	return func(r *rand.Rand, _ sdk.Context, _ []simtypes.Account) simtypes.Content {
+		return types.NewUpdateFeesProposal(
+			simtypes.RandStringOfLength(r, 10),
+			simtypes.RandStringOfLength(r, 100),
+			GenIssueFee(r, bondDenom),
+			GenMintFee(r, bondDenom),
+			GenBurnFee(r, bondDenom),
+		)
+	}
+}
@@ -29,6 +29,8 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgSetAuthority{},
 		&MsgSetMinter{},
 		&MsgSetUri{},
+		&MsgClawback{},
+		&MsgSetIBCTransferPolicy{},
 	)
 
 	registry.RegisterImplementations(
@@ -47,5 +49,7 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgSetAuthority{}, "go-bitsong/fantoken/MsgSetAuthority", nil)
 	cdc.RegisterConcrete(&MsgSetMinter{}, "go-bitsong/fantoken/MsgSetMinter", nil)
 	cdc.RegisterConcrete(&MsgSetUri{}, "go-bitsong/fantoken/MsgSetUri", nil)
+	cdc.RegisterConcrete(&MsgClawback{}, "go-bitsong/fantoken/MsgClawback", nil)
+	cdc.RegisterConcrete(&MsgSetIBCTransferPolicy{}, "go-bitsong/fantoken/MsgSetIBCTransferPolicy", nil)
 	cdc.RegisterConcrete(&UpdateFeesProposal{}, "go-bitsong/fantoken/UpdateFeesProposal", nil)
 }
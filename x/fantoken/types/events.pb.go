@@ -379,6 +379,187 @@ func (m *EventSetUri) GetDenom() string {
 	return ""
 }
 
+type EventClawback struct {
+	Denom  string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	Holder string `protobuf:"bytes,3,opt,name=holder,proto3" json:"holder,omitempty"`
+	// recipient is empty when the reclaimed fantoken has been burned
+	Recipient string `protobuf:"bytes,4,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Coin      string `protobuf:"bytes,5,opt,name=coin,proto3" json:"coin,omitempty"`
+}
+
+func (m *EventClawback) Reset()         { *m = EventClawback{} }
+func (m *EventClawback) String() string { return proto.CompactTextString(m) }
+func (*EventClawback) ProtoMessage()    {}
+func (*EventClawback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4e6474600913d18, []int{7}
+}
+func (m *EventClawback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventClawback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventClawback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventClawback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventClawback.Merge(m, src)
+}
+func (m *EventClawback) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventClawback) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventClawback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventClawback proto.InternalMessageInfo
+
+func (m *EventClawback) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *EventClawback) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *EventClawback) GetHolder() string {
+	if m != nil {
+		return m.Holder
+	}
+	return ""
+}
+
+func (m *EventClawback) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *EventClawback) GetCoin() string {
+	if m != nil {
+		return m.Coin
+	}
+	return ""
+}
+
+type EventExpire struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *EventExpire) Reset()         { *m = EventExpire{} }
+func (m *EventExpire) String() string { return proto.CompactTextString(m) }
+func (*EventExpire) ProtoMessage()    {}
+func (*EventExpire) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4e6474600913d18, []int{8}
+}
+func (m *EventExpire) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventExpire) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventExpire.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventExpire) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventExpire.Merge(m, src)
+}
+func (m *EventExpire) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventExpire) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventExpire.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventExpire proto.InternalMessageInfo
+
+func (m *EventExpire) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+type EventSetIBCTransferPolicy struct {
+	Denom           string            `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Policy          IBCTransferPolicy `protobuf:"varint,2,opt,name=policy,proto3,enum=bitsong.fantoken.v1beta1.IBCTransferPolicy" json:"policy,omitempty"`
+	AllowedChannels []string          `protobuf:"bytes,3,rep,name=allowed_channels,json=allowedChannels,proto3" json:"allowed_channels,omitempty" yaml:"allowed_channels"`
+}
+
+func (m *EventSetIBCTransferPolicy) Reset()         { *m = EventSetIBCTransferPolicy{} }
+func (m *EventSetIBCTransferPolicy) String() string { return proto.CompactTextString(m) }
+func (*EventSetIBCTransferPolicy) ProtoMessage()    {}
+func (*EventSetIBCTransferPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4e6474600913d18, []int{9}
+}
+func (m *EventSetIBCTransferPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSetIBCTransferPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSetIBCTransferPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventSetIBCTransferPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSetIBCTransferPolicy.Merge(m, src)
+}
+func (m *EventSetIBCTransferPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSetIBCTransferPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSetIBCTransferPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSetIBCTransferPolicy proto.InternalMessageInfo
+
+func (m *EventSetIBCTransferPolicy) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *EventSetIBCTransferPolicy) GetPolicy() IBCTransferPolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return IBCTransferPolicyAllowAll
+}
+
+func (m *EventSetIBCTransferPolicy) GetAllowedChannels() []string {
+	if m != nil {
+		return m.AllowedChannels
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*EventIssue)(nil), "bitsong.fantoken.v1beta1.EventIssue")
 	proto.RegisterType((*EventDisableMint)(nil), "bitsong.fantoken.v1beta1.EventDisableMint")
@@ -387,6 +568,9 @@ func init() {
 	proto.RegisterType((*EventSetAuthority)(nil), "bitsong.fantoken.v1beta1.EventSetAuthority")
 	proto.RegisterType((*EventSetMinter)(nil), "bitsong.fantoken.v1beta1.EventSetMinter")
 	proto.RegisterType((*EventSetUri)(nil), "bitsong.fantoken.v1beta1.EventSetUri")
+	proto.RegisterType((*EventClawback)(nil), "bitsong.fantoken.v1beta1.EventClawback")
+	proto.RegisterType((*EventExpire)(nil), "bitsong.fantoken.v1beta1.EventExpire")
+	proto.RegisterType((*EventSetIBCTransferPolicy)(nil), "bitsong.fantoken.v1beta1.EventSetIBCTransferPolicy")
 }
 
 func init() {
@@ -394,33 +578,41 @@ func init() {
 }
 
 var fileDescriptor_c4e6474600913d18 = []byte{
-	// 404 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x4f, 0x8b, 0xd3, 0x40,
-	0x18, 0xc6, 0x1b, 0xab, 0x85, 0xbc, 0xb6, 0x62, 0x43, 0x95, 0x20, 0x92, 0xca, 0x88, 0xd0, 0x8b,
-	0x09, 0xc5, 0xa2, 0x20, 0xf4, 0x60, 0xd1, 0x83, 0x07, 0x41, 0x22, 0x5e, 0xbc, 0x48, 0xfe, 0xbc,
-	0x4d, 0x07, 0x93, 0x99, 0x92, 0x4c, 0x5a, 0xfb, 0x2d, 0x96, 0xfd, 0x12, 0xfb, 0x55, 0xf6, 0xd8,
-	0xe3, 0x9e, 0xca, 0xd2, 0x7e, 0x83, 0x7e, 0x82, 0x25, 0x93, 0xc9, 0x66, 0x5b, 0xda, 0xdb, 0x4c,
-	0x9e, 0xdf, 0xef, 0xcd, 0xc3, 0xf0, 0xc2, 0x3b, 0x9f, 0x8a, 0x8c, 0xb3, 0xc8, 0x99, 0x7a, 0x4c,
-	0xf0, 0x7f, 0xc8, 0x9c, 0xc5, 0xd0, 0x47, 0xe1, 0x0d, 0x1d, 0x5c, 0x20, 0x13, 0x99, 0x3d, 0x4f,
-	0xb9, 0xe0, 0x86, 0xa9, 0x30, 0xbb, 0xc2, 0x6c, 0x85, 0xbd, 0xea, 0x45, 0x3c, 0xe2, 0x12, 0x72,
-	0x8a, 0x53, 0xc9, 0x13, 0x02, 0xf0, 0xad, 0xf0, 0xbf, 0x67, 0x59, 0x8e, 0x46, 0x0f, 0x9e, 0x84,
-	0xc8, 0x78, 0x62, 0x6a, 0x6f, 0xb4, 0x81, 0xee, 0x96, 0x17, 0x32, 0x80, 0xe7, 0x92, 0xf9, 0x4a,
-	0x33, 0xcf, 0x8f, 0xf1, 0x07, 0x65, 0xe2, 0x0c, 0x39, 0x06, 0x5d, 0x92, 0x12, 0x79, 0x0d, 0x7a,
-	0x8a, 0x01, 0x9d, 0x53, 0x64, 0x42, 0x61, 0xf5, 0x07, 0xc3, 0x80, 0xc7, 0x01, 0xa7, 0xcc, 0x7c,
-	0x24, 0x03, 0x79, 0x26, 0x9f, 0x94, 0x3e, 0xc9, 0x53, 0x66, 0xbc, 0x84, 0x56, 0x86, 0x2c, 0xc4,
-	0x54, 0xb9, 0xea, 0x76, 0x52, 0xbc, 0xd2, 0xa0, 0x2b, 0xcd, 0x5f, 0x28, 0xbe, 0xe4, 0x62, 0xc6,
-	0x53, 0x2a, 0x56, 0xa7, 0x3b, 0x1a, 0x63, 0xe8, 0xf0, 0x38, 0xfc, 0xeb, 0x55, 0x58, 0x39, 0x68,
-	0x62, 0xee, 0x37, 0xfd, 0xde, 0xca, 0x4b, 0xe2, 0xcf, 0xe4, 0x20, 0x26, 0x6e, 0x9b, 0xc7, 0x61,
-	0x3d, 0x74, 0x0c, 0x1d, 0x86, 0xcb, 0x07, 0x7a, 0xf3, 0x58, 0x3f, 0x88, 0x89, 0xdb, 0x66, 0xb8,
-	0xbc, 0xd7, 0xc9, 0xa5, 0x06, 0xcf, 0xaa, 0xa6, 0xc5, 0x2b, 0x61, 0x7a, 0xa6, 0xe6, 0x08, 0xa0,
-	0xe8, 0x91, 0x48, 0x46, 0x75, 0x7c, 0xb1, 0xdf, 0xf4, 0xbb, 0x75, 0xc7, 0x32, 0x23, 0xae, 0xce,
-	0xe3, 0x50, 0xcd, 0x1a, 0x01, 0x14, 0xbf, 0x57, 0x56, 0xf3, 0xd8, 0xaa, 0x33, 0xe2, 0xea, 0x0c,
-	0x97, 0xa5, 0x45, 0xde, 0xc2, 0xd3, 0xaa, 0xd3, 0xef, 0x94, 0x9e, 0x2e, 0x34, 0xf9, 0x79, 0xbd,
-	0xb5, 0xb4, 0xf5, 0xd6, 0xd2, 0x6e, 0xb7, 0x96, 0x76, 0xb1, 0xb3, 0x1a, 0xeb, 0x9d, 0xd5, 0xb8,
-	0xd9, 0x59, 0x8d, 0x3f, 0x1f, 0x23, 0x2a, 0x66, 0xb9, 0x6f, 0x07, 0x3c, 0x71, 0xd4, 0xfa, 0xf1,
-	0xe9, 0x94, 0x06, 0xd4, 0x8b, 0x9d, 0x88, 0xbf, 0xaf, 0x16, 0xf7, 0x7f, 0xbd, 0xba, 0x62, 0x35,
-	0xc7, 0xcc, 0x6f, 0xc9, 0x15, 0xfc, 0x70, 0x17, 0x00, 0x00, 0xff, 0xff, 0xf7, 0x2e, 0x76, 0x59,
-	0xdb, 0x02, 0x00, 0x00,
+	// 541 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0xc1, 0x6e, 0xd3, 0x4c,
+	0x10, 0xc7, 0xe3, 0xa6, 0x8d, 0xe4, 0xf9, 0x9a, 0x7e, 0xad, 0x15, 0x4a, 0x28, 0xc8, 0xa9, 0x16,
+	0x21, 0x22, 0x21, 0x6c, 0x15, 0x2a, 0x90, 0x90, 0x72, 0x20, 0xa1, 0x48, 0x3d, 0x20, 0x55, 0x06,
+	0x2e, 0x5c, 0xaa, 0xb5, 0xbd, 0x49, 0x56, 0xdd, 0xec, 0x46, 0xeb, 0x4d, 0xd3, 0x3c, 0x01, 0x57,
+	0xc4, 0x4b, 0xf0, 0x16, 0x9c, 0x39, 0xf6, 0xc8, 0x29, 0x42, 0xc9, 0x1b, 0xe4, 0x09, 0x50, 0xd6,
+	0xeb, 0xba, 0x09, 0xf5, 0x6d, 0x77, 0xe6, 0xf7, 0x9f, 0xfd, 0xdb, 0x33, 0x03, 0x4f, 0x42, 0xaa,
+	0x12, 0xc1, 0x7b, 0x7e, 0x17, 0x73, 0x25, 0x2e, 0x08, 0xf7, 0x2f, 0x8f, 0x42, 0xa2, 0xf0, 0x91,
+	0x4f, 0x2e, 0x09, 0x57, 0x89, 0x37, 0x94, 0x42, 0x09, 0xa7, 0x6e, 0x30, 0x2f, 0xc3, 0x3c, 0x83,
+	0x1d, 0xd4, 0x7a, 0xa2, 0x27, 0x34, 0xe4, 0x2f, 0x4f, 0x29, 0x7f, 0xf0, 0xb4, 0xb0, 0xec, 0x4d,
+	0x01, 0x0d, 0x22, 0x04, 0x70, 0xb2, 0x7c, 0xe8, 0x34, 0x49, 0x46, 0xc4, 0xa9, 0xc1, 0x56, 0x4c,
+	0xb8, 0x18, 0xd4, 0xad, 0x43, 0xab, 0x69, 0x07, 0xe9, 0x05, 0x35, 0x61, 0x57, 0x33, 0xef, 0x68,
+	0x82, 0x43, 0x46, 0x3e, 0x50, 0xae, 0x0a, 0xc8, 0x16, 0xd8, 0x9a, 0xd4, 0xc8, 0x23, 0xb0, 0x25,
+	0x89, 0xe8, 0x90, 0x12, 0xae, 0x0c, 0x96, 0x07, 0x1c, 0x07, 0x36, 0x23, 0x41, 0x79, 0x7d, 0x43,
+	0x27, 0xf4, 0x19, 0xbd, 0x36, 0xf2, 0xf6, 0x48, 0x72, 0x67, 0x1f, 0x2a, 0x09, 0xe1, 0x31, 0x91,
+	0x46, 0x6b, 0x6e, 0x77, 0x0a, 0x7f, 0x58, 0xb0, 0xa7, 0x95, 0x1f, 0x89, 0x7a, 0x3b, 0x52, 0x7d,
+	0x21, 0xa9, 0x9a, 0xdc, 0xed, 0xd1, 0x69, 0x41, 0x55, 0xb0, 0xf8, 0x1c, 0x67, 0x58, 0x5a, 0xa8,
+	0x5d, 0x5f, 0x4c, 0x1b, 0xb5, 0x09, 0x1e, 0xb0, 0x37, 0x68, 0x25, 0x8d, 0x82, 0x6d, 0xc1, 0xe2,
+	0xbc, 0x68, 0x0b, 0xaa, 0x9c, 0x8c, 0x6f, 0xc9, 0xcb, 0xeb, 0xf2, 0x95, 0x34, 0x0a, 0xb6, 0x39,
+	0x19, 0xdf, 0xc8, 0xd1, 0x77, 0x0b, 0x76, 0x32, 0xa7, 0xcb, 0xbf, 0x44, 0x64, 0x81, 0xcd, 0x63,
+	0x80, 0xa5, 0x8f, 0x81, 0x66, 0x8c, 0xc7, 0x7b, 0x8b, 0x69, 0x63, 0x2f, 0xf7, 0x98, 0xe6, 0x50,
+	0x60, 0x0b, 0x16, 0x9b, 0x5a, 0xc7, 0x00, 0xcb, 0xe7, 0x8d, 0xaa, 0xbc, 0xae, 0xca, 0x73, 0x28,
+	0xb0, 0x39, 0x19, 0xa7, 0x2a, 0xf4, 0x18, 0xfe, 0xcb, 0x3c, 0x7d, 0x96, 0xb4, 0xa0, 0xb7, 0x5f,
+	0x2d, 0xa8, 0x6a, 0xaa, 0xc3, 0xf0, 0x38, 0xc4, 0xd1, 0x45, 0x81, 0xf1, 0xbc, 0x6f, 0x1b, 0x2b,
+	0x7d, 0xdb, 0x87, 0x4a, 0x5f, 0xb0, 0x38, 0xb3, 0x15, 0x98, 0xdb, 0xea, 0x98, 0x6c, 0x16, 0x8d,
+	0xc9, 0xd6, 0xad, 0x6e, 0x67, 0x76, 0x4f, 0xae, 0x86, 0x54, 0x16, 0x0d, 0xed, 0x4f, 0x0b, 0x1e,
+	0x64, 0x1f, 0x75, 0xda, 0xee, 0x7c, 0x92, 0x98, 0x27, 0x5d, 0x22, 0xcf, 0x04, 0xa3, 0x51, 0xd1,
+	0x68, 0x74, 0xa0, 0x32, 0xd4, 0x79, 0x6d, 0x7d, 0xe7, 0xc5, 0x33, 0xaf, 0x68, 0xed, 0xbc, 0x7f,
+	0x4a, 0x06, 0x46, 0xea, 0xbc, 0x87, 0x5d, 0xcc, 0x98, 0x18, 0x93, 0xf8, 0x3c, 0xea, 0x63, 0xce,
+	0x09, 0x4b, 0xea, 0xe5, 0xc3, 0x72, 0xd3, 0x6e, 0x3f, 0x5c, 0x4c, 0x1b, 0xf7, 0xd3, 0x46, 0xac,
+	0x13, 0x28, 0xf8, 0xdf, 0x84, 0x3a, 0x26, 0xd2, 0x3e, 0xfb, 0x35, 0x73, 0xad, 0xeb, 0x99, 0x6b,
+	0xfd, 0x99, 0xb9, 0xd6, 0xb7, 0xb9, 0x5b, 0xba, 0x9e, 0xbb, 0xa5, 0xdf, 0x73, 0xb7, 0xf4, 0xe5,
+	0x55, 0x8f, 0xaa, 0xfe, 0x28, 0xf4, 0x22, 0x31, 0xf0, 0x8d, 0x41, 0xd1, 0xed, 0xd2, 0x88, 0x62,
+	0xe6, 0xf7, 0xc4, 0xf3, 0x6c, 0xf5, 0xaf, 0xf2, 0xe5, 0x57, 0x93, 0x21, 0x49, 0xc2, 0x8a, 0x5e,
+	0xf9, 0x97, 0x7f, 0x07, 0x00, 0x5f, 0xd8, 0x54, 0x0f, 0x74, 0x04, 0x00, 0x00,
 }
 
 func (m *EventIssue) Marshal() (dAtA []byte, err error) {
@@ -675,6 +867,138 @@ func (m *EventSetUri) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventClawback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventClawback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventClawback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Coin) > 0 {
+		i -= len(m.Coin)
+		copy(dAtA[i:], m.Coin)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Coin)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventExpire) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventExpire) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventExpire) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSetIBCTransferPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSetIBCTransferPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSetIBCTransferPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedChannels) > 0 {
+		for iNdEx := len(m.AllowedChannels) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedChannels[iNdEx])
+			copy(dAtA[i:], m.AllowedChannels[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.AllowedChannels[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Policy != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Policy))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -801,20 +1125,84 @@ func (m *EventSetUri) Size() (n int) {
 	return n
 }
 
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *EventClawback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Coin)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
 }
-func (m *EventIssue) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
+
+func (m *EventExpire) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventSetIBCTransferPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Policy != 0 {
+		n += 1 + sovEvents(uint64(m.Policy))
+	}
+	if len(m.AllowedChannels) > 0 {
+		for _, s := range m.AllowedChannels {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventIssue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
 				return ErrIntOverflowEvents
 			}
 			if iNdEx >= l {
@@ -908,19 +1296,539 @@ func (m *EventDisableMint) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventDisableMint: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventDisableMint: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDisableMint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDisableMint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventBurn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBurn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBurn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSetAuthority) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSetAuthority: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSetAuthority: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldAuthority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldAuthority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewAuthority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewAuthority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSetMinter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSetMinter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSetMinter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldMinter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldMinter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewMinter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -948,7 +1856,7 @@ func (m *EventDisableMint) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.NewMinter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -971,7 +1879,7 @@ func (m *EventDisableMint) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMint) Unmarshal(dAtA []byte) error {
+func (m *EventSetUri) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -994,47 +1902,15 @@ func (m *EventMint) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMint: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSetUri: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMint: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSetUri: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1062,7 +1938,7 @@ func (m *EventMint) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Coin = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1085,7 +1961,7 @@ func (m *EventMint) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventBurn) Unmarshal(dAtA []byte) error {
+func (m *EventClawback) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1108,15 +1984,15 @@ func (m *EventBurn) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventBurn: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventClawback: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventBurn: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventClawback: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1144,11 +2020,11 @@ func (m *EventBurn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1176,61 +2052,11 @@ func (m *EventBurn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Coin = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventSetAuthority) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventSetAuthority: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSetAuthority: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1258,11 +2084,11 @@ func (m *EventSetAuthority) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Holder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OldAuthority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1290,11 +2116,11 @@ func (m *EventSetAuthority) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OldAuthority = string(dAtA[iNdEx:postIndex])
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewAuthority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1322,7 +2148,7 @@ func (m *EventSetAuthority) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewAuthority = string(dAtA[iNdEx:postIndex])
+			m.Coin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1345,7 +2171,7 @@ func (m *EventSetAuthority) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSetMinter) Unmarshal(dAtA []byte) error {
+func (m *EventExpire) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1368,10 +2194,10 @@ func (m *EventSetMinter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSetMinter: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventExpire: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSetMinter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventExpire: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1406,70 +2232,6 @@ func (m *EventSetMinter) Unmarshal(dAtA []byte) error {
 			}
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OldMinter", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OldMinter = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewMinter", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NewMinter = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -1491,7 +2253,7 @@ func (m *EventSetMinter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSetUri) Unmarshal(dAtA []byte) error {
+func (m *EventSetIBCTransferPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1514,10 +2276,10 @@ func (m *EventSetUri) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSetUri: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSetIBCTransferPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSetUri: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSetIBCTransferPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1552,6 +2314,57 @@ func (m *EventSetUri) Unmarshal(dAtA []byte) error {
 			}
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			m.Policy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Policy |= IBCTransferPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedChannels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedChannels = append(m.AllowedChannels, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
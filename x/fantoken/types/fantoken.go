@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	"cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -73,6 +75,49 @@ func (ft FanToken) GetMetaData() Metadata {
 	return ft.MetaData
 }
 
+// IssueOptions are the optional settings of a fantoken, which are set at issue time
+// and cannot change for the whole life of the fantoken
+type IssueOptions struct {
+	// ClawbackEnabled allows the minter or the authority to burn or reclaim the fantoken from any holder
+	ClawbackEnabled bool
+
+	// ExpiryHeight is the block height from which the fantoken cannot be transferred anymore, 0 if none
+	ExpiryHeight int64
+
+	// ExpiryTime is the block time from which the fantoken cannot be transferred anymore, nil if none
+	ExpiryTime *time.Time
+}
+
+// HasExpiry returns true if the fantoken has an expiry height or time
+func (ft FanToken) HasExpiry() bool {
+	return ft.ExpiryHeight > 0 || ft.ExpiryTime != nil
+}
+
+// IsExpiredAt returns true if the fantoken expiry has been reached at the given block height and time
+func (ft FanToken) IsExpiredAt(height int64, blockTime time.Time) bool {
+	if ft.ExpiryHeight > 0 && height >= ft.ExpiryHeight {
+		return true
+	}
+
+	return ft.ExpiryTime != nil && !blockTime.Before(*ft.ExpiryTime)
+}
+
+// IsIBCTransferAllowed returns true if the fantoken can be sent over the given source channel
+func (ft FanToken) IsIBCTransferAllowed(channel string) bool {
+	switch ft.IBCTransferPolicy {
+	case IBCTransferPolicyAllowAll:
+		return true
+	case IBCTransferPolicyAllowlist:
+		for _, allowed := range ft.IBCAllowedChannels {
+			if allowed == channel {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
 func (ft FanToken) String() string {
 	bz, _ := yaml.Marshal(ft)
 	return string(bz)
@@ -85,6 +130,14 @@ func (ft FanToken) Validate() error {
 		}
 	}
 
+	if ft.ExpiryHeight < 0 {
+		return errors.Wrapf(ErrInvalidExpiry, "the expiry height must not be negative, got %d", ft.ExpiryHeight)
+	}
+
+	if err := ValidateIBCTransferPolicy(ft.IBCTransferPolicy, ft.IBCAllowedChannels); err != nil {
+		return err
+	}
+
 	return ft.MetaData.Validate()
 }
 
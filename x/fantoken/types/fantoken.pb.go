@@ -9,15 +9,19 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "github.com/gogo/protobuf/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -25,6 +29,38 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// IBCTransferPolicy defines which IBC channels a fantoken can be sent over
+type IBCTransferPolicy int32
+
+const (
+	// the fantoken can be sent over any channel
+	IBCTransferPolicyAllowAll IBCTransferPolicy = 0
+	// the fantoken cannot be sent over IBC
+	IBCTransferPolicyDenyAll IBCTransferPolicy = 1
+	// the fantoken can be sent only over the allowed channels
+	IBCTransferPolicyAllowlist IBCTransferPolicy = 2
+)
+
+var IBCTransferPolicy_name = map[int32]string{
+	0: "IBC_TRANSFER_POLICY_ALLOW_ALL",
+	1: "IBC_TRANSFER_POLICY_DENY_ALL",
+	2: "IBC_TRANSFER_POLICY_ALLOWLIST",
+}
+
+var IBCTransferPolicy_value = map[string]int32{
+	"IBC_TRANSFER_POLICY_ALLOW_ALL": 0,
+	"IBC_TRANSFER_POLICY_DENY_ALL":  1,
+	"IBC_TRANSFER_POLICY_ALLOWLIST": 2,
+}
+
+func (x IBCTransferPolicy) String() string {
+	return proto.EnumName(IBCTransferPolicy_name, int32(x))
+}
+
+func (IBCTransferPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_af4dfaf3dfee0855, []int{0}
+}
+
 type Metadata struct {
 	// name defines the name of the fantoken (eg: Kitty Punk)
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -78,6 +114,23 @@ type FanToken struct {
 	// sdk.AccAddress allowed to mint new fantoken
 	Minter   string   `protobuf:"bytes,3,opt,name=minter,proto3" json:"minter,omitempty"`
 	MetaData Metadata `protobuf:"bytes,4,opt,name=meta_data,json=metaData,proto3" json:"meta_data" yaml:"meta_data"`
+	// clawback_enabled allows the minter or the authority to burn or reclaim
+	// the fantoken from any holder. It is set at issue time and cannot change.
+	ClawbackEnabled bool `protobuf:"varint,5,opt,name=clawback_enabled,json=clawbackEnabled,proto3" json:"clawback_enabled,omitempty" yaml:"clawback_enabled"`
+	// expiry_height is the block height from which the fantoken cannot be
+	// transferred anymore, 0 if it never expires
+	ExpiryHeight int64 `protobuf:"varint,6,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty" yaml:"expiry_height"`
+	// expiry_time is the block time from which the fantoken cannot be
+	// transferred anymore, nil if it never expires
+	ExpiryTime *time.Time `protobuf:"bytes,7,opt,name=expiry_time,json=expiryTime,proto3,stdtime" json:"expiry_time,omitempty" yaml:"expiry_time"`
+	// expired is set by the end blocker once the expiry has been reached
+	Expired bool `protobuf:"varint,8,opt,name=expired,proto3" json:"expired,omitempty"`
+	// ibc_transfer_policy defines which IBC channels the fantoken can be sent
+	// over, set by the authority
+	IBCTransferPolicy IBCTransferPolicy `protobuf:"varint,9,opt,name=ibc_transfer_policy,json=ibcTransferPolicy,proto3,enum=bitsong.fantoken.v1beta1.IBCTransferPolicy" json:"ibc_transfer_policy,omitempty" yaml:"ibc_transfer_policy"`
+	// ibc_allowed_channels are the source channels allowed by the allowlist
+	// policy
+	IBCAllowedChannels []string `protobuf:"bytes,10,rep,name=ibc_allowed_channels,json=ibcAllowedChannels,proto3" json:"ibc_allowed_channels,omitempty" yaml:"ibc_allowed_channels"`
 }
 
 func (m *FanToken) Reset()      { *m = FanToken{} }
@@ -113,6 +166,7 @@ func (m *FanToken) XXX_DiscardUnknown() {
 var xxx_messageInfo_FanToken proto.InternalMessageInfo
 
 func init() {
+	proto.RegisterEnum("bitsong.fantoken.v1beta1.IBCTransferPolicy", IBCTransferPolicy_name, IBCTransferPolicy_value)
 	proto.RegisterType((*Metadata)(nil), "bitsong.fantoken.v1beta1.Metadata")
 	proto.RegisterType((*FanToken)(nil), "bitsong.fantoken.v1beta1.FanToken")
 }
@@ -122,33 +176,55 @@ func init() {
 }
 
 var fileDescriptor_af4dfaf3dfee0855 = []byte{
-	// 404 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x52, 0xb1, 0x6e, 0xd4, 0x40,
-	0x10, 0xb5, 0xb9, 0x10, 0xee, 0x96, 0x06, 0x56, 0x01, 0x99, 0x08, 0xad, 0x91, 0x1b, 0x68, 0xb0,
-	0x95, 0x20, 0x51, 0xa4, 0x3c, 0x21, 0xa4, 0x14, 0x14, 0x98, 0x50, 0x40, 0x13, 0x8d, 0xef, 0xf6,
-	0x7c, 0xab, 0x78, 0x77, 0x2c, 0xef, 0x1a, 0x9d, 0xff, 0x80, 0x92, 0x92, 0x32, 0x9f, 0x73, 0x65,
-	0x4a, 0x44, 0x61, 0x81, 0xef, 0x07, 0x50, 0xbe, 0x00, 0xad, 0xbd, 0x77, 0x57, 0xa5, 0x7b, 0xf3,
-	0x34, 0x6f, 0xdf, 0x9b, 0xd9, 0x21, 0x2f, 0x33, 0x61, 0x34, 0xaa, 0x3c, 0x59, 0x80, 0x32, 0x78,
-	0xc5, 0x55, 0xf2, 0xed, 0x24, 0xe3, 0x06, 0x4e, 0x76, 0x44, 0x5c, 0x56, 0x68, 0x90, 0x06, 0xae,
-	0x31, 0xde, 0xf1, 0xae, 0xf1, 0x98, 0xcd, 0x50, 0x4b, 0xd4, 0x49, 0x06, 0x9a, 0xef, 0xd4, 0x33,
-	0x14, 0x4e, 0x79, 0x7c, 0x94, 0x63, 0x8e, 0x3d, 0x4c, 0x2c, 0x1a, 0xd8, 0x08, 0xc9, 0xf8, 0x03,
-	0x37, 0x30, 0x07, 0x03, 0x94, 0x92, 0x03, 0x05, 0x92, 0x07, 0xfe, 0x0b, 0xff, 0xd5, 0x24, 0xed,
-	0x31, 0x7d, 0x4a, 0x0e, 0x75, 0x23, 0x33, 0x2c, 0x82, 0x7b, 0x3d, 0xeb, 0x2a, 0xfa, 0x8c, 0x8c,
-	0xea, 0x4a, 0x04, 0x23, 0x4b, 0x4e, 0x1f, 0x74, 0x6d, 0x38, 0xfa, 0x9c, 0x9e, 0xa7, 0x96, 0xa3,
-	0xcf, 0xc9, 0x04, 0x6a, 0xb3, 0xc4, 0x4a, 0x98, 0x26, 0x38, 0xe8, 0x55, 0x7b, 0x22, 0xfa, 0xe7,
-	0x93, 0xf1, 0x7b, 0x50, 0x17, 0x36, 0x3b, 0x3d, 0x22, 0xf7, 0xe7, 0x5c, 0xa1, 0x74, 0x96, 0x43,
-	0x41, 0x3f, 0x12, 0x22, 0x61, 0x75, 0xa9, 0xeb, 0xb2, 0x2c, 0x9a, 0xc1, 0x77, 0x7a, 0xba, 0x6e,
-	0x43, 0xef, 0x77, 0x1b, 0x3e, 0x19, 0xa6, 0xd4, 0xf3, 0xab, 0x58, 0x60, 0x22, 0xc1, 0x2c, 0xe3,
-	0x73, 0x65, 0x6e, 0xdb, 0xf0, 0x71, 0x03, 0xb2, 0x38, 0x8b, 0xf6, 0xc2, 0x28, 0x9d, 0x48, 0x58,
-	0x7d, 0xea, 0xb1, 0x1d, 0x43, 0x0a, 0x65, 0x78, 0x35, 0x24, 0x4e, 0x5d, 0x45, 0xbf, 0x90, 0x89,
-	0xe4, 0x06, 0x2e, 0xed, 0xfc, 0x7d, 0xd6, 0x87, 0xa7, 0x51, 0x7c, 0xd7, 0x8a, 0xe3, 0xed, 0xa6,
-	0xa6, 0x81, 0x4d, 0x73, 0xdb, 0x86, 0x8f, 0x9c, 0xe9, 0xf6, 0x89, 0x28, 0x1d, 0x5b, 0xfc, 0x0e,
-	0x0c, 0x9c, 0x8d, 0xbf, 0x5f, 0x87, 0xde, 0xcf, 0xeb, 0xd0, 0x9b, 0x5e, 0xac, 0xff, 0x32, 0x6f,
-	0xdd, 0x31, 0xff, 0xa6, 0x63, 0xfe, 0x9f, 0x8e, 0xf9, 0x3f, 0x36, 0xcc, 0xbb, 0xd9, 0x30, 0xef,
-	0xd7, 0x86, 0x79, 0x5f, 0xdf, 0xe6, 0xc2, 0x2c, 0xeb, 0x2c, 0x9e, 0xa1, 0x4c, 0x9c, 0x33, 0x2e,
-	0x16, 0x62, 0x26, 0xa0, 0x48, 0x72, 0x7c, 0xbd, 0x3d, 0x8c, 0xd5, 0xfe, 0x34, 0x4c, 0x53, 0x72,
-	0x9d, 0x1d, 0xf6, 0x1f, 0xf8, 0xe6, 0x7f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x67, 0xe2, 0xbe, 0x01,
-	0x3b, 0x02, 0x00, 0x00,
+	// 763 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0xcd, 0x6e, 0xeb, 0x44,
+	0x14, 0xb6, 0x6f, 0xfa, 0x93, 0xcc, 0xe5, 0x27, 0x1d, 0x02, 0xf8, 0xfa, 0xf6, 0x7a, 0x2c, 0x6f,
+	0x88, 0x40, 0xd8, 0xba, 0xbd, 0x82, 0x45, 0x25, 0x10, 0x71, 0xda, 0x8a, 0x48, 0xa1, 0x2d, 0x6e,
+	0x50, 0x55, 0x36, 0xd6, 0xd8, 0x99, 0x38, 0xa3, 0xda, 0x9e, 0x28, 0x9e, 0xd0, 0xe4, 0x0d, 0xaa,
+	0xae, 0xba, 0x64, 0x53, 0xa9, 0x12, 0x2f, 0xd3, 0x65, 0x97, 0x88, 0x85, 0x81, 0x54, 0xe2, 0x01,
+	0xf2, 0x04, 0x68, 0x6c, 0xa7, 0x51, 0x9b, 0xf6, 0x6e, 0xac, 0xf3, 0x7d, 0xe7, 0x7c, 0xe7, 0x6f,
+	0x8e, 0x0c, 0xbe, 0xf0, 0x28, 0x4f, 0x58, 0x1c, 0x58, 0x3d, 0x1c, 0x73, 0x76, 0x4a, 0x62, 0xeb,
+	0xb7, 0xb7, 0x1e, 0xe1, 0xf8, 0xed, 0x3d, 0x61, 0x0e, 0x86, 0x8c, 0x33, 0xa8, 0x14, 0x81, 0xe6,
+	0x3d, 0x5f, 0x04, 0xaa, 0x9a, 0xcf, 0x92, 0x88, 0x25, 0x96, 0x87, 0x13, 0x72, 0xaf, 0xf6, 0x19,
+	0x2d, 0x94, 0x6a, 0x2d, 0x60, 0x01, 0xcb, 0x4c, 0x4b, 0x58, 0x05, 0x8b, 0x02, 0xc6, 0x82, 0x90,
+	0x58, 0x19, 0xf2, 0x46, 0x3d, 0x8b, 0xd3, 0x88, 0x24, 0x1c, 0x47, 0x83, 0x3c, 0xc0, 0x60, 0xa0,
+	0xfc, 0x13, 0xe1, 0xb8, 0x8b, 0x39, 0x86, 0x10, 0xac, 0xc4, 0x38, 0x22, 0x8a, 0xac, 0xcb, 0xf5,
+	0x8a, 0x93, 0xd9, 0xf0, 0x33, 0xb0, 0x96, 0x4c, 0x22, 0x8f, 0x85, 0xca, 0x8b, 0x8c, 0x2d, 0x10,
+	0x7c, 0x05, 0x4a, 0xa3, 0x21, 0x55, 0x4a, 0x82, 0xb4, 0xd7, 0xa7, 0x29, 0x2a, 0xfd, 0xe2, 0xb4,
+	0x1c, 0xc1, 0xc1, 0x4d, 0x50, 0xc1, 0x23, 0xde, 0x67, 0x43, 0xca, 0x27, 0xca, 0x4a, 0xa6, 0x5a,
+	0x10, 0xc6, 0x7f, 0xab, 0xa0, 0xbc, 0x87, 0xe3, 0x8e, 0x18, 0x0e, 0xd6, 0xc0, 0x6a, 0x97, 0xc4,
+	0x2c, 0x2a, 0x4a, 0xe6, 0x00, 0xfe, 0x0c, 0x40, 0x84, 0xc7, 0x6e, 0x32, 0x1a, 0x0c, 0xc2, 0x49,
+	0x5e, 0xd7, 0xde, 0xba, 0x49, 0x91, 0xf4, 0x57, 0x8a, 0x3e, 0xcd, 0xd7, 0x90, 0x74, 0x4f, 0x4d,
+	0xca, 0xac, 0x08, 0xf3, 0xbe, 0xd9, 0x8a, 0xf9, 0x2c, 0x45, 0x1b, 0x13, 0x1c, 0x85, 0xdb, 0xc6,
+	0x42, 0x68, 0x38, 0x95, 0x08, 0x8f, 0x8f, 0x32, 0x5b, 0x8c, 0x11, 0xd1, 0x98, 0x93, 0x61, 0xde,
+	0xb1, 0x53, 0x20, 0x78, 0x02, 0x2a, 0x11, 0xe1, 0xd8, 0x15, 0xf3, 0x67, 0xbd, 0xbe, 0xdc, 0x32,
+	0xcc, 0xe7, 0xde, 0xc0, 0x9c, 0x6f, 0xca, 0x56, 0x44, 0x37, 0xb3, 0x14, 0x55, 0x8b, 0xa2, 0xf3,
+	0x14, 0x86, 0x53, 0x16, 0xf6, 0x8e, 0xd8, 0xe6, 0x1e, 0xa8, 0xfa, 0x21, 0x3e, 0xf3, 0xb0, 0x7f,
+	0xea, 0x92, 0x18, 0x7b, 0x21, 0xe9, 0x2a, 0xab, 0xba, 0x5c, 0x2f, 0xdb, 0xaf, 0x67, 0x29, 0xfa,
+	0x3c, 0x57, 0x3e, 0x8e, 0x30, 0x9c, 0x8f, 0xe7, 0xd4, 0x6e, 0xce, 0xc0, 0xef, 0xc0, 0x87, 0x64,
+	0x3c, 0xa0, 0xc3, 0x89, 0xdb, 0x27, 0x34, 0xe8, 0x73, 0x65, 0x4d, 0x97, 0xeb, 0x25, 0x5b, 0x99,
+	0xa5, 0xa8, 0x96, 0x27, 0x79, 0xe0, 0x36, 0x9c, 0x0f, 0x72, 0xfc, 0x63, 0x06, 0xe1, 0x31, 0x78,
+	0x59, 0xf8, 0xc5, 0xd3, 0x2b, 0xeb, 0xd9, 0x8c, 0xaa, 0x99, 0xdf, 0x85, 0x39, 0xbf, 0x0b, 0xb3,
+	0x33, 0xbf, 0x0b, 0x5b, 0x9d, 0xa5, 0x08, 0x3e, 0x48, 0x2c, 0x84, 0xc6, 0xe5, 0xdf, 0x48, 0x76,
+	0x40, 0xce, 0x88, 0x60, 0xa8, 0x80, 0xf5, 0x0c, 0x91, 0xae, 0x52, 0x16, 0x63, 0x39, 0x73, 0x08,
+	0x2f, 0x65, 0xf0, 0x09, 0xf5, 0x7c, 0x97, 0x0f, 0x71, 0x9c, 0xf4, 0xc8, 0xd0, 0x1d, 0xb0, 0x90,
+	0xfa, 0x13, 0xa5, 0xa2, 0xcb, 0xf5, 0x8f, 0xb6, 0xbe, 0x7a, 0x7e, 0xbf, 0x2d, 0xbb, 0xd9, 0x29,
+	0x34, 0x87, 0x99, 0xc4, 0x7e, 0x37, 0x4d, 0xd1, 0xc6, 0x12, 0x3d, 0x4b, 0x91, 0x9a, 0x77, 0xf8,
+	0x44, 0x19, 0xc3, 0xd9, 0xa0, 0x9e, 0xff, 0x50, 0x00, 0x03, 0x50, 0x13, 0xa1, 0x38, 0x0c, 0xd9,
+	0x19, 0xe9, 0xba, 0x7e, 0x1f, 0xc7, 0x31, 0x09, 0x13, 0x05, 0xe8, 0xa5, 0x7a, 0xc5, 0xfe, 0x66,
+	0x9a, 0x22, 0xd8, 0xb2, 0x9b, 0x8d, 0xdc, 0xdd, 0x2c, 0xbc, 0xb3, 0x14, 0xbd, 0x5e, 0x94, 0x79,
+	0xac, 0x35, 0x1c, 0x48, 0x3d, 0xff, 0x91, 0x64, 0xbb, 0x7c, 0x7e, 0x8d, 0xa4, 0xdf, 0xaf, 0x91,
+	0xf4, 0x65, 0x2a, 0x83, 0xe5, 0xce, 0xe1, 0x0f, 0xe0, 0x4d, 0xcb, 0x6e, 0xba, 0x1d, 0xa7, 0xb1,
+	0x7f, 0xb4, 0xb7, 0xeb, 0xb8, 0x87, 0x07, 0xed, 0x56, 0xf3, 0xc4, 0x6d, 0xb4, 0xdb, 0x07, 0xc7,
+	0xe2, 0x5b, 0x95, 0xd4, 0x37, 0x17, 0x57, 0xfa, 0xab, 0x25, 0x65, 0x56, 0xa8, 0x11, 0x86, 0xf0,
+	0x7b, 0xb0, 0xf9, 0x54, 0x86, 0x9d, 0xdd, 0xfd, 0x2c, 0x4d, 0x55, 0x56, 0x37, 0x2f, 0xae, 0x74,
+	0x65, 0x29, 0xc1, 0x0e, 0x89, 0x45, 0x12, 0xd8, 0x78, 0x4f, 0x07, 0xed, 0xd6, 0x51, 0xa7, 0xfa,
+	0x42, 0xd5, 0x2e, 0xae, 0x74, 0xf5, 0xe9, 0x0e, 0x42, 0x9a, 0x70, 0x75, 0xe5, 0xfc, 0x0f, 0x4d,
+	0xb2, 0x3b, 0x37, 0xff, 0x6a, 0xd2, 0xcd, 0x54, 0x93, 0x6f, 0xa7, 0x9a, 0xfc, 0xcf, 0x54, 0x93,
+	0x2f, 0xef, 0x34, 0xe9, 0xf6, 0x4e, 0x93, 0xfe, 0xbc, 0xd3, 0xa4, 0x5f, 0xbf, 0x0d, 0x28, 0xef,
+	0x8f, 0x3c, 0xd3, 0x67, 0x91, 0x55, 0x3c, 0x38, 0xeb, 0xf5, 0xa8, 0x4f, 0x71, 0x68, 0x05, 0xec,
+	0xeb, 0xf9, 0x0f, 0x71, 0xbc, 0xf8, 0x25, 0xf2, 0xc9, 0x80, 0x24, 0xde, 0x5a, 0x76, 0x92, 0xef,
+	0xfe, 0x1f, 0x00, 0x4f, 0x71, 0x4e, 0x1f, 0x33, 0x05, 0x00, 0x00,
 }
 
 func (m *Metadata) Marshal() (dAtA []byte, err error) {
@@ -222,6 +298,55 @@ func (m *FanToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.IBCAllowedChannels) > 0 {
+		for iNdEx := len(m.IBCAllowedChannels) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.IBCAllowedChannels[iNdEx])
+			copy(dAtA[i:], m.IBCAllowedChannels[iNdEx])
+			i = encodeVarintFantoken(dAtA, i, uint64(len(m.IBCAllowedChannels[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.IBCTransferPolicy != 0 {
+		i = encodeVarintFantoken(dAtA, i, uint64(m.IBCTransferPolicy))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Expired {
+		i--
+		if m.Expired {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ExpiryTime != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpiryTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpiryTime):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintFantoken(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintFantoken(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ClawbackEnabled {
+		i--
+		if m.ClawbackEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
 	{
 		size, err := m.MetaData.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -313,6 +438,28 @@ func (m *FanToken) Size() (n int) {
 	}
 	l = m.MetaData.Size()
 	n += 1 + l + sovFantoken(uint64(l))
+	if m.ClawbackEnabled {
+		n += 2
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovFantoken(uint64(m.ExpiryHeight))
+	}
+	if m.ExpiryTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpiryTime)
+		n += 1 + l + sovFantoken(uint64(l))
+	}
+	if m.Expired {
+		n += 2
+	}
+	if m.IBCTransferPolicy != 0 {
+		n += 1 + sovFantoken(uint64(m.IBCTransferPolicy))
+	}
+	if len(m.IBCAllowedChannels) > 0 {
+		for _, s := range m.IBCAllowedChannels {
+			l = len(s)
+			n += 1 + l + sovFantoken(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -660,6 +807,152 @@ func (m *FanToken) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClawbackEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFantoken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ClawbackEnabled = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFantoken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFantoken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFantoken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFantoken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiryTime == nil {
+				m.ExpiryTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpiryTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expired", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFantoken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Expired = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IBCTransferPolicy", wireType)
+			}
+			m.IBCTransferPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFantoken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IBCTransferPolicy |= IBCTransferPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IBCAllowedChannels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFantoken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFantoken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFantoken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IBCAllowedChannels = append(m.IBCAllowedChannels, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFantoken(dAtA[iNdEx:])
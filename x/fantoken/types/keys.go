@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -21,6 +23,12 @@ var (
 
 	// PrefixFanTokens defines a prefix for the fan tokens
 	PrefixFanTokens = []byte{0x02}
+
+	// PrefixExpiryHeightQueue defines a prefix for the fan tokens waiting to expire at a block height
+	PrefixExpiryHeightQueue = []byte{0x03}
+
+	// PrefixExpiryTimeQueue defines a prefix for the fan tokens waiting to expire at a block time
+	PrefixExpiryTimeQueue = []byte{0x04}
 )
 
 // KeyDenom returns the key of the token with the specified denom
@@ -32,3 +40,23 @@ func KeyDenom(denom string) []byte {
 func KeyFanTokens(owner sdk.AccAddress, denom string) []byte {
 	return append(append(PrefixFanTokens, owner.Bytes()...), []byte(denom)...)
 }
+
+// ExpiryHeightQueuePrefix returns the prefix of the fan tokens waiting to expire at the specified height
+func ExpiryHeightQueuePrefix(height int64) []byte {
+	return append(append([]byte{}, PrefixExpiryHeightQueue...), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// KeyExpiryHeightQueue returns the key of the fan token with the specified denom waiting to expire at the specified height
+func KeyExpiryHeightQueue(height int64, denom string) []byte {
+	return append(ExpiryHeightQueuePrefix(height), []byte(denom)...)
+}
+
+// ExpiryTimeQueuePrefix returns the prefix of the fan tokens waiting to expire at the specified time
+func ExpiryTimeQueuePrefix(expiry time.Time) []byte {
+	return append(append([]byte{}, PrefixExpiryTimeQueue...), sdk.FormatTimeBytes(expiry)...)
+}
+
+// KeyExpiryTimeQueue returns the key of the fan token with the specified denom waiting to expire at the specified time
+func KeyExpiryTimeQueue(expiry time.Time, denom string) []byte {
+	return append(ExpiryTimeQueuePrefix(expiry), []byte(denom)...)
+}
@@ -20,6 +20,9 @@ const (
 	TypeMsgSetAuthority = "set_authority"
 	TypeMsgSetMinter    = "set_minter"
 	TypeMsgSetUri       = "set_uri"
+	TypeMsgClawback     = "clawback"
+
+	TypeMsgSetIBCTransferPolicy = "set_ibc_transfer_policy"
 )
 
 var (
@@ -30,6 +33,8 @@ var (
 	_ sdk.Msg = &MsgSetAuthority{}
 	_ sdk.Msg = &MsgSetMinter{}
 	_ sdk.Msg = &MsgSetUri{}
+	_ sdk.Msg = &MsgClawback{}
+	_ sdk.Msg = &MsgSetIBCTransferPolicy{}
 )
 
 // NewMsgIssue - construct token issue msg.
@@ -62,8 +67,10 @@ func (msg MsgIssue) ValidateBasic() error {
 	}
 
 	fantoken := &FanToken{
-		MaxSupply: msg.MaxSupply,
-		Minter:    minter.String(),
+		MaxSupply:    msg.MaxSupply,
+		Minter:       minter.String(),
+		ExpiryHeight: msg.ExpiryHeight,
+		ExpiryTime:   msg.ExpiryTime,
 		MetaData: Metadata{
 			Name:      msg.Name,
 			Symbol:    msg.Symbol,
@@ -404,3 +411,111 @@ func (msg MsgSetUri) ValidateBasic() error {
 
 	return ValidateDenom(msg.Denom)
 }
+
+// NewMsgClawback creates a MsgClawback
+func NewMsgClawback(sender, holder, recipient string, coin sdk.Coin) *MsgClawback {
+	return &MsgClawback{
+		Sender:    sender,
+		Holder:    holder,
+		Recipient: recipient,
+		Coin:      coin,
+	}
+}
+
+// Route implements Msg
+func (msg MsgClawback) Route() string { return MsgRoute }
+
+// Type implements Msg
+func (msg MsgClawback) Type() string { return TypeMsgClawback }
+
+// GetSignBytes implements Msg
+func (msg MsgClawback) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners implements Msg
+func (msg MsgClawback) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgClawback) ValidateBasic() error {
+	// check the sender
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+
+	// check the holder
+	if _, err := sdk.AccAddressFromBech32(msg.Holder); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid holder address (%s)", err)
+	}
+
+	// check the recipient
+	if len(msg.Recipient) > 0 {
+		if _, err := sdk.AccAddressFromBech32(msg.Recipient); err != nil {
+			return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address (%s)", err)
+		}
+	}
+
+	if err := ValidateAmount(msg.Coin.Amount); err != nil {
+		return err
+	}
+
+	return ValidateDenom(msg.Coin.Denom)
+}
+
+// NewMsgSetIBCTransferPolicy creates a MsgSetIBCTransferPolicy
+func NewMsgSetIBCTransferPolicy(denom string, policy IBCTransferPolicy, allowedChannels []string, authority string) *MsgSetIBCTransferPolicy {
+	return &MsgSetIBCTransferPolicy{
+		Authority:       authority,
+		Denom:           denom,
+		Policy:          policy,
+		AllowedChannels: allowedChannels,
+	}
+}
+
+// Route implements Msg
+func (msg MsgSetIBCTransferPolicy) Route() string { return MsgRoute }
+
+// Type implements Msg
+func (msg MsgSetIBCTransferPolicy) Type() string { return TypeMsgSetIBCTransferPolicy }
+
+// GetSignBytes implements Msg
+func (msg MsgSetIBCTransferPolicy) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners implements Msg
+func (msg MsgSetIBCTransferPolicy) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgSetIBCTransferPolicy) ValidateBasic() error {
+	// check the authority
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+
+	if err := ValidateIBCTransferPolicy(msg.Policy, msg.AllowedChannels); err != nil {
+		return err
+	}
+
+	return ValidateDenom(msg.Denom)
+}
@@ -5,12 +5,15 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "github.com/gogo/protobuf/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -18,12 +21,14 @@ import (
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -310,529 +315,2493 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
-func init() {
-	proto.RegisterType((*QueryFanTokenRequest)(nil), "bitsong.fantoken.v1beta1.QueryFanTokenRequest")
-	proto.RegisterType((*QueryFanTokenResponse)(nil), "bitsong.fantoken.v1beta1.QueryFanTokenResponse")
-	proto.RegisterType((*QueryFanTokensRequest)(nil), "bitsong.fantoken.v1beta1.QueryFanTokensRequest")
-	proto.RegisterType((*QueryFanTokensResponse)(nil), "bitsong.fantoken.v1beta1.QueryFanTokensResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "bitsong.fantoken.v1beta1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "bitsong.fantoken.v1beta1.QueryParamsResponse")
+// QueryIBCTransferPolicyRequest is request type for the
+// Query/IBCTransferPolicy RPC method
+type QueryIBCTransferPolicyRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("bitsong/fantoken/v1beta1/query.proto", fileDescriptor_e961b51f12bacf3d)
+func (m *QueryIBCTransferPolicyRequest) Reset()         { *m = QueryIBCTransferPolicyRequest{} }
+func (m *QueryIBCTransferPolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIBCTransferPolicyRequest) ProtoMessage()    {}
+func (*QueryIBCTransferPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e961b51f12bacf3d, []int{6}
 }
-
-var fileDescriptor_e961b51f12bacf3d = []byte{
-	// 524 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xcf, 0x6b, 0x13, 0x41,
-	0x18, 0xcd, 0xb4, 0x36, 0x34, 0xe3, 0x6d, 0x8c, 0x12, 0x42, 0x59, 0xc3, 0x6a, 0x4d, 0xd5, 0x76,
-	0xc6, 0x56, 0xf0, 0x28, 0xd2, 0x43, 0xbd, 0xc6, 0xa0, 0x08, 0xde, 0x66, 0xe3, 0x64, 0x3b, 0xd8,
-	0xcc, 0xb7, 0xcd, 0x4c, 0xc4, 0x20, 0xbd, 0xf8, 0x0f, 0x28, 0x78, 0xd4, 0x9b, 0xff, 0x4c, 0x8f,
-	0x05, 0x41, 0x3c, 0x89, 0x24, 0xfe, 0x21, 0x92, 0xf9, 0xb1, 0x69, 0x42, 0x97, 0xec, 0x29, 0x93,
-	0x99, 0xf7, 0xde, 0xf7, 0xbe, 0xef, 0xcd, 0x2c, 0xbe, 0x9b, 0x48, 0xa3, 0x41, 0xa5, 0xac, 0xcf,
-	0x95, 0x81, 0x77, 0x42, 0xb1, 0xf7, 0xfb, 0x89, 0x30, 0x7c, 0x9f, 0x9d, 0x8e, 0xc4, 0x70, 0x4c,
-	0xb3, 0x21, 0x18, 0x20, 0x0d, 0x8f, 0xa2, 0x01, 0x45, 0x3d, 0xaa, 0x19, 0xf5, 0x40, 0x0f, 0x40,
-	0xb3, 0x84, 0x6b, 0x91, 0x53, 0x7b, 0x20, 0x95, 0x63, 0x36, 0x1f, 0x5c, 0x3e, 0xb7, 0x92, 0x39,
-	0x2a, 0xe3, 0xa9, 0x54, 0xdc, 0x48, 0x08, 0xd8, 0x7a, 0x0a, 0x29, 0xd8, 0x25, 0x9b, 0xad, 0xfc,
-	0xee, 0x56, 0x0a, 0x90, 0x9e, 0x08, 0xc6, 0x33, 0xc9, 0xb8, 0x52, 0x60, 0x2c, 0x45, 0xfb, 0xd3,
-	0x76, 0xa1, 0xff, 0xdc, 0xaa, 0x03, 0x6e, 0x17, 0x02, 0x33, 0x3e, 0xe4, 0x03, 0xaf, 0x17, 0xef,
-	0xe2, 0xfa, 0x8b, 0x99, 0xcb, 0x23, 0xae, 0x5e, 0xce, 0x50, 0x5d, 0x71, 0x3a, 0x12, 0xda, 0x90,
-	0x3a, 0xde, 0x78, 0x2b, 0x14, 0x0c, 0x1a, 0xa8, 0x85, 0x76, 0x6a, 0x5d, 0xf7, 0x27, 0x7e, 0x8d,
-	0x6f, 0x2e, 0xa1, 0x75, 0x06, 0x4a, 0x0b, 0xf2, 0x14, 0x6f, 0x86, 0x3a, 0x96, 0x71, 0xfd, 0x20,
-	0xa6, 0x45, 0x33, 0xa4, 0x39, 0x3b, 0xe7, 0xc4, 0x67, 0x4b, 0xc2, 0x3a, 0xf8, 0xd8, 0xc2, 0x35,
-	0x3e, 0x32, 0xc7, 0x30, 0x94, 0x66, 0xec, 0xbd, 0xcc, 0x37, 0xc8, 0x11, 0xc6, 0xf3, 0xa9, 0x36,
-	0xd6, 0x6c, 0xe1, 0x7b, 0xd4, 0x45, 0x40, 0x67, 0x11, 0x50, 0x97, 0x6a, 0xa8, 0xdc, 0xe1, 0xa9,
-	0xf0, 0xca, 0xdd, 0x4b, 0xcc, 0xf8, 0x07, 0xc2, 0xb7, 0x96, 0xeb, 0xfb, 0xce, 0x9e, 0xe1, 0x5a,
-	0x70, 0xa9, 0x1b, 0xa8, 0xb5, 0x5e, 0xb2, 0xb5, 0x39, 0x89, 0x3c, 0xbf, 0xc2, 0x64, 0x7b, 0xa5,
-	0x49, 0x57, 0x7e, 0xc1, 0x65, 0x1d, 0x13, 0x6b, 0xb2, 0x63, 0x03, 0xf4, 0x7d, 0xc4, 0xaf, 0xf0,
-	0x8d, 0x85, 0xdd, 0x3c, 0x91, 0xaa, 0x0b, 0xda, 0xe7, 0xd1, 0x2a, 0x36, 0xed, 0x98, 0x87, 0xd7,
-	0xce, 0xff, 0xdc, 0xae, 0x74, 0x3d, 0xeb, 0xe0, 0xd7, 0x3a, 0xde, 0xb0, 0xba, 0xe4, 0x3b, 0xc2,
-	0x9b, 0xa1, 0x2f, 0x42, 0x8b, 0x65, 0xae, 0xba, 0x47, 0x4d, 0x56, 0x1a, 0xef, 0x7c, 0xc7, 0xec,
-	0xd3, 0xcf, 0x7f, 0x5f, 0xd7, 0xee, 0x93, 0x36, 0x2b, 0xbc, 0xc0, 0xf6, 0x2e, 0xb2, 0x8f, 0xf6,
-	0xe7, 0x8c, 0x7c, 0x43, 0xb8, 0x96, 0xc7, 0x46, 0xca, 0xd6, 0x0b, 0xe3, 0x6b, 0x3e, 0x2a, 0x4f,
-	0xf0, 0x0e, 0x1f, 0x5a, 0x87, 0xdb, 0xe4, 0x0e, 0x5b, 0xf9, 0x16, 0x35, 0xf9, 0x8c, 0x70, 0xd5,
-	0xcd, 0x97, 0xec, 0xae, 0xa8, 0xb4, 0x10, 0x6b, 0x73, 0xaf, 0x24, 0xda, 0x9b, 0xda, 0xb1, 0xa6,
-	0x62, 0xd2, 0x62, 0x2b, 0xde, 0xfd, 0x61, 0xe7, 0x7c, 0x12, 0xa1, 0x8b, 0x49, 0x84, 0xfe, 0x4e,
-	0x22, 0xf4, 0x65, 0x1a, 0x55, 0x2e, 0xa6, 0x51, 0xe5, 0xf7, 0x34, 0xaa, 0xbc, 0x79, 0x92, 0x4a,
-	0x73, 0x3c, 0x4a, 0x68, 0x0f, 0x06, 0x41, 0x05, 0xfa, 0x7d, 0xd9, 0x93, 0xfc, 0x84, 0xa5, 0xb0,
-	0x17, 0x84, 0x3f, 0xcc, 0xa5, 0xcd, 0x38, 0x13, 0x3a, 0xa9, 0xda, 0x4f, 0xc9, 0xe3, 0xff, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0xb5, 0x3f, 0x7e, 0x32, 0x5c, 0x05, 0x00, 0x00,
+func (m *QueryIBCTransferPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIBCTransferPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIBCTransferPolicyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIBCTransferPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIBCTransferPolicyRequest.Merge(m, src)
+}
+func (m *QueryIBCTransferPolicyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIBCTransferPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIBCTransferPolicyRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryIBCTransferPolicyRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// FanToken returns fantoken with fantoken name
-	FanToken(ctx context.Context, in *QueryFanTokenRequest, opts ...grpc.CallOption) (*QueryFanTokenResponse, error)
-	// FanTokens returns the fantoken list
-	FanTokens(ctx context.Context, in *QueryFanTokensRequest, opts ...grpc.CallOption) (*QueryFanTokensResponse, error)
-	// Params queries the fantoken parameters
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+func (m *QueryIBCTransferPolicyRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// QueryIBCTransferPolicyResponse is response type for the
+// Query/IBCTransferPolicy RPC method
+type QueryIBCTransferPolicyResponse struct {
+	Policy          IBCTransferPolicy `protobuf:"varint,1,opt,name=policy,proto3,enum=bitsong.fantoken.v1beta1.IBCTransferPolicy" json:"policy,omitempty"`
+	AllowedChannels []string          `protobuf:"bytes,2,rep,name=allowed_channels,json=allowedChannels,proto3" json:"allowed_channels,omitempty" yaml:"allowed_channels"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryIBCTransferPolicyResponse) Reset()         { *m = QueryIBCTransferPolicyResponse{} }
+func (m *QueryIBCTransferPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIBCTransferPolicyResponse) ProtoMessage()    {}
+func (*QueryIBCTransferPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e961b51f12bacf3d, []int{7}
 }
-
-func (c *queryClient) FanToken(ctx context.Context, in *QueryFanTokenRequest, opts ...grpc.CallOption) (*QueryFanTokenResponse, error) {
-	out := new(QueryFanTokenResponse)
-	err := c.cc.Invoke(ctx, "/bitsong.fantoken.v1beta1.Query/FanToken", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryIBCTransferPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIBCTransferPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIBCTransferPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryIBCTransferPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIBCTransferPolicyResponse.Merge(m, src)
+}
+func (m *QueryIBCTransferPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIBCTransferPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIBCTransferPolicyResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) FanTokens(ctx context.Context, in *QueryFanTokensRequest, opts ...grpc.CallOption) (*QueryFanTokensResponse, error) {
-	out := new(QueryFanTokensResponse)
-	err := c.cc.Invoke(ctx, "/bitsong.fantoken.v1beta1.Query/FanTokens", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryIBCTransferPolicyResponse proto.InternalMessageInfo
+
+func (m *QueryIBCTransferPolicyResponse) GetPolicy() IBCTransferPolicy {
+	if m != nil {
+		return m.Policy
 	}
-	return out, nil
+	return IBCTransferPolicyAllowAll
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/bitsong.fantoken.v1beta1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryIBCTransferPolicyResponse) GetAllowedChannels() []string {
+	if m != nil {
+		return m.AllowedChannels
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// FanToken returns fantoken with fantoken name
-	FanToken(context.Context, *QueryFanTokenRequest) (*QueryFanTokenResponse, error)
-	// FanTokens returns the fantoken list
-	FanTokens(context.Context, *QueryFanTokensRequest) (*QueryFanTokensResponse, error)
-	// Params queries the fantoken parameters
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+// QueryIssuePreviewRequest is request type for the Query/IssuePreview RPC
+// method
+type QueryIssuePreviewRequest struct {
+	Symbol    string                `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Name      string                `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	MaxSupply cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=max_supply,json=maxSupply,proto3,customtype=cosmossdk.io/math.Int" json:"max_supply" yaml:"max_supply"`
+	Authority string                `protobuf:"bytes,4,opt,name=authority,proto3" json:"authority,omitempty"`
+	Minter    string                `protobuf:"bytes,5,opt,name=minter,proto3" json:"minter,omitempty"`
+	URI       string                `protobuf:"bytes,6,opt,name=uri,proto3" json:"uri,omitempty"`
+	// height at which the issue is expected to be included, the denom depends on
+	// it. Defaults to the next block height when zero
+	Height int64 `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+	// clawback_enabled which allows the minter or the authority to burn or
+	// reclaim the fan token from any holder
+	ClawbackEnabled bool `protobuf:"varint,8,opt,name=clawback_enabled,json=clawbackEnabled,proto3" json:"clawback_enabled,omitempty"`
+	// expiry_height which is the block height from which the fan token cannot be
+	// transferred anymore. Optional, 0 if it never expires
+	ExpiryHeight int64 `protobuf:"varint,9,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+	// expiry_time which is the block time from which the fan token cannot be
+	// transferred anymore. Optional, nil if it never expires
+	ExpiryTime *time.Time `protobuf:"bytes,10,opt,name=expiry_time,json=expiryTime,proto3,stdtime" json:"expiry_time,omitempty"`
+}
+
+func (m *QueryIssuePreviewRequest) Reset()         { *m = QueryIssuePreviewRequest{} }
+func (m *QueryIssuePreviewRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIssuePreviewRequest) ProtoMessage()    {}
+func (*QueryIssuePreviewRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e961b51f12bacf3d, []int{8}
+}
+func (m *QueryIssuePreviewRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryIssuePreviewRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIssuePreviewRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func (*UnimplementedQueryServer) FanToken(ctx context.Context, req *QueryFanTokenRequest) (*QueryFanTokenResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FanToken not implemented")
+func (m *QueryIssuePreviewRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIssuePreviewRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) FanTokens(ctx context.Context, req *QueryFanTokensRequest) (*QueryFanTokensResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FanTokens not implemented")
+func (m *QueryIssuePreviewRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryIssuePreviewRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIssuePreviewRequest.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_QueryIssuePreviewRequest proto.InternalMessageInfo
 
-func _Query_FanToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFanTokenRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).FanToken(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/bitsong.fantoken.v1beta1.Query/FanToken",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).FanToken(ctx, req.(*QueryFanTokenRequest))
+func (m *QueryIssuePreviewRequest) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_FanTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFanTokensRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).FanTokens(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/bitsong.fantoken.v1beta1.Query/FanTokens",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).FanTokens(ctx, req.(*QueryFanTokensRequest))
+func (m *QueryIssuePreviewRequest) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/bitsong.fantoken.v1beta1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryIssuePreviewRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "bitsong.fantoken.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "FanToken",
-			Handler:    _Query_FanToken_Handler,
-		},
-		{
-			MethodName: "FanTokens",
-			Handler:    _Query_FanTokens_Handler,
-		},
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "bitsong/fantoken/v1beta1/query.proto",
+func (m *QueryIssuePreviewRequest) GetMinter() string {
+	if m != nil {
+		return m.Minter
+	}
+	return ""
 }
 
-func (m *QueryFanTokenRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryIssuePreviewRequest) GetURI() string {
+	if m != nil {
+		return m.URI
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryFanTokenRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryIssuePreviewRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
 }
 
-func (m *QueryFanTokenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryIssuePreviewRequest) GetClawbackEnabled() bool {
+	if m != nil {
+		return m.ClawbackEnabled
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *QueryFanTokenResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryIssuePreviewRequest) GetExpiryHeight() int64 {
+	if m != nil {
+		return m.ExpiryHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryFanTokenResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryIssuePreviewRequest) GetExpiryTime() *time.Time {
+	if m != nil {
+		return m.ExpiryTime
+	}
+	return nil
 }
 
-func (m *QueryFanTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Fantoken != nil {
-		{
-			size, err := m.Fantoken.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// QueryIssuePreviewResponse is response type for the Query/IssuePreview RPC
+// method
+type QueryIssuePreviewResponse struct {
+	Denom string      `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Fee   types1.Coin `protobuf:"bytes,2,opt,name=fee,proto3" json:"fee"`
+	// error is empty when the issue would succeed
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (m *QueryFanTokensRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryIssuePreviewResponse) Reset()         { *m = QueryIssuePreviewResponse{} }
+func (m *QueryIssuePreviewResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIssuePreviewResponse) ProtoMessage()    {}
+func (*QueryIssuePreviewResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e961b51f12bacf3d, []int{9}
 }
-
-func (m *QueryFanTokensRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryIssuePreviewResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryFanTokensRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryIssuePreviewResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIssuePreviewResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryFanTokensResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryIssuePreviewResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIssuePreviewResponse.Merge(m, src)
 }
-
-func (m *QueryFanTokensResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryIssuePreviewResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIssuePreviewResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIssuePreviewResponse.DiscardUnknown(m)
 }
 
-func (m *QueryFanTokensResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Fantokens) > 0 {
-		for iNdEx := len(m.Fantokens) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Fantokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+var xxx_messageInfo_QueryIssuePreviewResponse proto.InternalMessageInfo
+
+func (m *QueryIssuePreviewResponse) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryIssuePreviewResponse) GetFee() types1.Coin {
+	if m != nil {
+		return m.Fee
 	}
-	return dAtA[:n], nil
+	return types1.Coin{}
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryIssuePreviewResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// QueryMintPreviewRequest is request type for the Query/MintPreview RPC method
+type QueryMintPreviewRequest struct {
+	Recipient string      `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Coin      types1.Coin `protobuf:"bytes,2,opt,name=coin,proto3" json:"coin"`
+	Minter    string      `protobuf:"bytes,3,opt,name=minter,proto3" json:"minter,omitempty"`
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryMintPreviewRequest) Reset()         { *m = QueryMintPreviewRequest{} }
+func (m *QueryMintPreviewRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMintPreviewRequest) ProtoMessage()    {}
+func (*QueryMintPreviewRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e961b51f12bacf3d, []int{10}
 }
-
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryMintPreviewRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryMintPreviewRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintPreviewRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryMintPreviewRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintPreviewRequest.Merge(m, src)
+}
+func (m *QueryMintPreviewRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMintPreviewRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintPreviewRequest.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryMintPreviewRequest proto.InternalMessageInfo
+
+func (m *QueryMintPreviewRequest) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *QueryFanTokenRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+
+func (m *QueryMintPreviewRequest) GetCoin() types1.Coin {
+	if m != nil {
+		return m.Coin
 	}
-	return n
+	return types1.Coin{}
 }
 
-func (m *QueryFanTokenResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Fantoken != nil {
-		l = m.Fantoken.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryMintPreviewRequest) GetMinter() string {
+	if m != nil {
+		return m.Minter
 	}
-	return n
+	return ""
 }
 
-func (m *QueryFanTokensRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+// QueryMintPreviewResponse is response type for the Query/MintPreview RPC
+// method
+type QueryMintPreviewResponse struct {
+	Fee types1.Coin `protobuf:"bytes,1,opt,name=fee,proto3" json:"fee"`
+	// error is empty when the mint would succeed
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (m *QueryFanTokensResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Fantokens) > 0 {
-		for _, e := range m.Fantokens {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryMintPreviewResponse) Reset()         { *m = QueryMintPreviewResponse{} }
+func (m *QueryMintPreviewResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMintPreviewResponse) ProtoMessage()    {}
+func (*QueryMintPreviewResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e961b51f12bacf3d, []int{11}
+}
+func (m *QueryMintPreviewResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMintPreviewResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintPreviewResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+}
+func (m *QueryMintPreviewResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintPreviewResponse.Merge(m, src)
+}
+func (m *QueryMintPreviewResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMintPreviewResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintPreviewResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryMintPreviewResponse proto.InternalMessageInfo
+
+func (m *QueryMintPreviewResponse) GetFee() types1.Coin {
+	if m != nil {
+		return m.Fee
 	}
-	var l int
-	_ = l
-	return n
+	return types1.Coin{}
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryMintPreviewResponse) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return ""
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+// QueryBurnPreviewRequest is request type for the Query/BurnPreview RPC method
+type QueryBurnPreviewRequest struct {
+	Coin   types1.Coin `protobuf:"bytes,1,opt,name=coin,proto3" json:"coin"`
+	Sender string      `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryBurnPreviewRequest) Reset()         { *m = QueryBurnPreviewRequest{} }
+func (m *QueryBurnPreviewRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBurnPreviewRequest) ProtoMessage()    {}
+func (*QueryBurnPreviewRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e961b51f12bacf3d, []int{12}
 }
-func (m *QueryFanTokenRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
+func (m *QueryBurnPreviewRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBurnPreviewRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurnPreviewRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBurnPreviewRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurnPreviewRequest.Merge(m, src)
+}
+func (m *QueryBurnPreviewRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBurnPreviewRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurnPreviewRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBurnPreviewRequest proto.InternalMessageInfo
+
+func (m *QueryBurnPreviewRequest) GetCoin() types1.Coin {
+	if m != nil {
+		return m.Coin
+	}
+	return types1.Coin{}
+}
+
+func (m *QueryBurnPreviewRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+// QueryBurnPreviewResponse is response type for the Query/BurnPreview RPC
+// method
+type QueryBurnPreviewResponse struct {
+	Fee types1.Coin `protobuf:"bytes,1,opt,name=fee,proto3" json:"fee"`
+	// error is empty when the burn would succeed
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *QueryBurnPreviewResponse) Reset()         { *m = QueryBurnPreviewResponse{} }
+func (m *QueryBurnPreviewResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBurnPreviewResponse) ProtoMessage()    {}
+func (*QueryBurnPreviewResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e961b51f12bacf3d, []int{13}
+}
+func (m *QueryBurnPreviewResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBurnPreviewResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurnPreviewResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBurnPreviewResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurnPreviewResponse.Merge(m, src)
+}
+func (m *QueryBurnPreviewResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBurnPreviewResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurnPreviewResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBurnPreviewResponse proto.InternalMessageInfo
+
+func (m *QueryBurnPreviewResponse) GetFee() types1.Coin {
+	if m != nil {
+		return m.Fee
+	}
+	return types1.Coin{}
+}
+
+func (m *QueryBurnPreviewResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*QueryFanTokenRequest)(nil), "bitsong.fantoken.v1beta1.QueryFanTokenRequest")
+	proto.RegisterType((*QueryFanTokenResponse)(nil), "bitsong.fantoken.v1beta1.QueryFanTokenResponse")
+	proto.RegisterType((*QueryFanTokensRequest)(nil), "bitsong.fantoken.v1beta1.QueryFanTokensRequest")
+	proto.RegisterType((*QueryFanTokensResponse)(nil), "bitsong.fantoken.v1beta1.QueryFanTokensResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "bitsong.fantoken.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "bitsong.fantoken.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryIBCTransferPolicyRequest)(nil), "bitsong.fantoken.v1beta1.QueryIBCTransferPolicyRequest")
+	proto.RegisterType((*QueryIBCTransferPolicyResponse)(nil), "bitsong.fantoken.v1beta1.QueryIBCTransferPolicyResponse")
+	proto.RegisterType((*QueryIssuePreviewRequest)(nil), "bitsong.fantoken.v1beta1.QueryIssuePreviewRequest")
+	proto.RegisterType((*QueryIssuePreviewResponse)(nil), "bitsong.fantoken.v1beta1.QueryIssuePreviewResponse")
+	proto.RegisterType((*QueryMintPreviewRequest)(nil), "bitsong.fantoken.v1beta1.QueryMintPreviewRequest")
+	proto.RegisterType((*QueryMintPreviewResponse)(nil), "bitsong.fantoken.v1beta1.QueryMintPreviewResponse")
+	proto.RegisterType((*QueryBurnPreviewRequest)(nil), "bitsong.fantoken.v1beta1.QueryBurnPreviewRequest")
+	proto.RegisterType((*QueryBurnPreviewResponse)(nil), "bitsong.fantoken.v1beta1.QueryBurnPreviewResponse")
+}
+
+func init() {
+	proto.RegisterFile("bitsong/fantoken/v1beta1/query.proto", fileDescriptor_e961b51f12bacf3d)
+}
+
+var fileDescriptor_e961b51f12bacf3d = []byte{
+	// 1109 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xcf, 0xc6, 0xa9, 0x1b, 0xbf, 0xf6, 0xfb, 0x6d, 0x3b, 0xa4, 0xe9, 0xc6, 0x04, 0xdb, 0xda,
+	0xd2, 0xc6, 0xa5, 0xed, 0x2e, 0x71, 0x54, 0x8a, 0x90, 0x40, 0xe0, 0x88, 0x40, 0x0e, 0x48, 0xe9,
+	0x92, 0x0a, 0x89, 0x8b, 0x35, 0xbb, 0x19, 0xdb, 0xa3, 0xec, 0xce, 0x6c, 0x77, 0xd6, 0x4d, 0x0c,
+	0xea, 0x05, 0x71, 0xa7, 0x12, 0x42, 0x1c, 0xe0, 0x06, 0x07, 0x2e, 0xfc, 0x19, 0x48, 0x3d, 0x56,
+	0xe2, 0x82, 0x38, 0x04, 0x94, 0xf0, 0x17, 0xf4, 0x2f, 0x40, 0x3b, 0x33, 0xeb, 0xf8, 0x47, 0x8c,
+	0x6d, 0xc4, 0xc9, 0x3b, 0x6f, 0xdf, 0xe7, 0xbd, 0xcf, 0x67, 0xde, 0xf3, 0x7b, 0x0b, 0xaf, 0x7a,
+	0x34, 0x11, 0x9c, 0xb5, 0x9c, 0x26, 0x66, 0x09, 0xdf, 0x27, 0xcc, 0x79, 0xbc, 0xee, 0x91, 0x04,
+	0xaf, 0x3b, 0x8f, 0x3a, 0x24, 0xee, 0xda, 0x51, 0xcc, 0x13, 0x8e, 0x4c, 0xed, 0x65, 0x67, 0x5e,
+	0xb6, 0xf6, 0x2a, 0x96, 0x7c, 0x2e, 0x42, 0x2e, 0x1c, 0x0f, 0x0b, 0xd2, 0x83, 0xfa, 0x9c, 0x32,
+	0x85, 0x2c, 0xbe, 0xd6, 0xff, 0x5e, 0x86, 0xec, 0x79, 0x45, 0xb8, 0x45, 0x19, 0x4e, 0x28, 0xcf,
+	0x7c, 0x97, 0x5a, 0xbc, 0xc5, 0xe5, 0xa3, 0x93, 0x3e, 0x69, 0xeb, 0x6a, 0x8b, 0xf3, 0x56, 0x40,
+	0x1c, 0x1c, 0x51, 0x07, 0x33, 0xc6, 0x13, 0x09, 0x11, 0xfa, 0x6d, 0x59, 0xbf, 0x95, 0x27, 0xaf,
+	0xd3, 0x74, 0x12, 0x1a, 0x12, 0x91, 0xe0, 0x30, 0xd2, 0x0e, 0x6b, 0x63, 0x05, 0xf6, 0xb4, 0x28,
+	0xc7, 0x1b, 0x63, 0x1d, 0x23, 0x1c, 0xe3, 0x50, 0x27, 0xb4, 0xee, 0xc0, 0xd2, 0x83, 0x54, 0xc6,
+	0x16, 0x66, 0xbb, 0xa9, 0x97, 0x4b, 0x1e, 0x75, 0x88, 0x48, 0xd0, 0x12, 0x9c, 0xdb, 0x23, 0x8c,
+	0x87, 0xa6, 0x51, 0x31, 0xaa, 0x05, 0x57, 0x1d, 0xac, 0x4f, 0xe0, 0xea, 0x90, 0xb7, 0x88, 0x38,
+	0x13, 0x04, 0xbd, 0x03, 0x8b, 0x59, 0x1e, 0x89, 0xb8, 0x50, 0xb3, 0xec, 0x71, 0x97, 0x6c, 0xf7,
+	0xd0, 0x3d, 0x8c, 0xf5, 0x64, 0x28, 0xb0, 0xc8, 0x78, 0xac, 0x42, 0x01, 0x77, 0x92, 0x36, 0x8f,
+	0x69, 0xd2, 0xd5, 0x5c, 0x4e, 0x0d, 0x68, 0x0b, 0xe0, 0xf4, 0xda, 0xcd, 0x79, 0x99, 0xf8, 0xa6,
+	0xad, 0x6a, 0x64, 0xa7, 0x35, 0xb2, 0x55, 0xd9, 0xb3, 0xcc, 0x3b, 0xb8, 0x45, 0x74, 0x64, 0xb7,
+	0x0f, 0x69, 0xfd, 0x60, 0xc0, 0xf2, 0x70, 0x7e, 0xad, 0xec, 0x5d, 0x28, 0x64, 0x2c, 0x85, 0x69,
+	0x54, 0x72, 0x53, 0x4a, 0x3b, 0x05, 0xa1, 0x0f, 0xce, 0x20, 0xb9, 0x36, 0x91, 0xa4, 0x4a, 0x3f,
+	0xc0, 0x72, 0x09, 0x90, 0x24, 0xb9, 0x23, 0x0b, 0xa8, 0x75, 0x58, 0x0f, 0xe1, 0xa5, 0x01, 0x6b,
+	0xaf, 0x22, 0x79, 0x55, 0x68, 0x5d, 0x8f, 0xca, 0x78, 0xd2, 0x0a, 0x59, 0x5f, 0x78, 0x76, 0x54,
+	0x9e, 0x73, 0x35, 0xca, 0xba, 0x07, 0xaf, 0xc8, 0xb0, 0xdb, 0xf5, 0xcd, 0xdd, 0x18, 0x33, 0xd1,
+	0x24, 0xf1, 0x0e, 0x0f, 0xa8, 0xdf, 0xfd, 0xe7, 0x0e, 0xf9, 0xd9, 0x80, 0xd2, 0x38, 0x9c, 0x66,
+	0xb6, 0x09, 0xf9, 0x48, 0x5a, 0x24, 0xf2, 0xff, 0xb5, 0xdb, 0xe3, 0x99, 0x8d, 0x06, 0xd1, 0x50,
+	0xb4, 0x05, 0x97, 0x71, 0x10, 0xf0, 0x03, 0xb2, 0xd7, 0xf0, 0xdb, 0x98, 0x31, 0x12, 0x08, 0x73,
+	0xbe, 0x92, 0xab, 0x16, 0xea, 0x2f, 0xbf, 0x38, 0x2a, 0x5f, 0xeb, 0xe2, 0x30, 0x78, 0xcb, 0x1a,
+	0xf6, 0xb0, 0xdc, 0x4b, 0xda, 0xb4, 0x99, 0x59, 0xbe, 0xc9, 0x81, 0xa9, 0xf8, 0x0a, 0xd1, 0x21,
+	0x3b, 0x31, 0x79, 0x4c, 0xc9, 0x41, 0x26, 0x71, 0x19, 0xf2, 0xa2, 0x1b, 0x7a, 0x3c, 0xd0, 0x1a,
+	0xf5, 0x09, 0x21, 0x58, 0x60, 0x38, 0x24, 0xb2, 0x96, 0x05, 0x57, 0x3e, 0xa3, 0x07, 0x00, 0x21,
+	0x3e, 0x6c, 0x88, 0x4e, 0x14, 0x05, 0x5d, 0x33, 0x97, 0xbe, 0xa9, 0xd7, 0xd2, 0x1b, 0xfd, 0xfd,
+	0xa8, 0x7c, 0x55, 0x15, 0x5b, 0xec, 0xed, 0xdb, 0x94, 0x3b, 0x21, 0x4e, 0xda, 0xf6, 0x36, 0x4b,
+	0x5e, 0x1c, 0x95, 0xaf, 0x28, 0x9e, 0xa7, 0x40, 0xcb, 0x2d, 0x84, 0xf8, 0xf0, 0x63, 0xf9, 0x3c,
+	0xd8, 0xfb, 0x0b, 0xc3, 0xbd, 0xbf, 0x0c, 0xf9, 0x90, 0xb2, 0x84, 0xc4, 0xe6, 0x39, 0x45, 0x4e,
+	0x9d, 0xd0, 0x0a, 0xe4, 0x3a, 0x31, 0x35, 0xf3, 0x92, 0xc1, 0xf9, 0xe3, 0xa3, 0x72, 0xee, 0xa1,
+	0xbb, 0xed, 0xa6, 0xb6, 0x14, 0xd2, 0x26, 0xb4, 0xd5, 0x4e, 0xcc, 0xf3, 0x15, 0xa3, 0x9a, 0x73,
+	0xf5, 0x09, 0xdd, 0x82, 0xcb, 0x7e, 0x80, 0x0f, 0x3c, 0xec, 0xef, 0x37, 0x08, 0xc3, 0x5e, 0x40,
+	0xf6, 0xcc, 0xc5, 0x8a, 0x51, 0x5d, 0x74, 0x2f, 0x65, 0xf6, 0xf7, 0x95, 0x19, 0x5d, 0x87, 0xff,
+	0x91, 0xc3, 0x88, 0xc6, 0xdd, 0x86, 0x8e, 0x54, 0x90, 0x91, 0x2e, 0x2a, 0xe3, 0x87, 0x2a, 0xde,
+	0x7b, 0x70, 0x41, 0x3b, 0xa5, 0xe3, 0xcb, 0x04, 0xd9, 0x80, 0x45, 0x5b, 0xcd, 0x36, 0x3b, 0x9b,
+	0x6d, 0xf6, 0x6e, 0x36, 0xdb, 0xea, 0x0b, 0x4f, 0xff, 0x28, 0x1b, 0x2e, 0x28, 0x50, 0x6a, 0xb6,
+	0x3e, 0x83, 0x95, 0x33, 0xca, 0xa2, 0x3b, 0xe8, 0xcc, 0xd6, 0x43, 0xeb, 0x90, 0x6b, 0x12, 0xa2,
+	0xff, 0x60, 0x2b, 0x03, 0x7f, 0xb0, 0xac, 0x9f, 0x36, 0x39, 0x65, 0xba, 0xcf, 0x53, 0xdf, 0x34,
+	0x10, 0x89, 0x63, 0x1e, 0xab, 0x7a, 0xb9, 0xea, 0x60, 0x7d, 0x69, 0xc0, 0x35, 0x99, 0xfc, 0x23,
+	0xca, 0x92, 0xa1, 0x96, 0x58, 0x85, 0x42, 0x4c, 0x7c, 0x1a, 0x51, 0xc2, 0x92, 0x6c, 0x1e, 0xf5,
+	0x0c, 0x68, 0x03, 0x16, 0xd2, 0x65, 0x31, 0x2d, 0x07, 0xe9, 0xdc, 0x57, 0xc8, 0x5c, 0x7f, 0x21,
+	0x2d, 0x1f, 0xcc, 0x51, 0x16, 0xfa, 0x06, 0xb4, 0x56, 0xe3, 0xdf, 0x68, 0x9d, 0xef, 0xd7, 0xda,
+	0xd4, 0x52, 0xeb, 0x9d, 0x98, 0x0d, 0x49, 0xcd, 0xc4, 0x18, 0x33, 0x8a, 0x11, 0x84, 0xed, 0x91,
+	0x2c, 0x8d, 0x3e, 0xf5, 0xc4, 0x0c, 0xe4, 0xf9, 0x8f, 0xc5, 0xd4, 0xbe, 0x2d, 0xc0, 0x39, 0x99,
+	0x05, 0x7d, 0x6f, 0xc0, 0x62, 0x36, 0x8b, 0x91, 0x3d, 0x7e, 0xc0, 0x9c, 0xb5, 0xfb, 0x8a, 0xce,
+	0xd4, 0xfe, 0x4a, 0x80, 0xe5, 0x7c, 0xf1, 0xeb, 0x5f, 0x5f, 0xcf, 0xdf, 0x42, 0x6b, 0xce, 0xd8,
+	0xa5, 0x2b, 0x5b, 0xd4, 0xf9, 0x5c, 0xfe, 0x3c, 0x41, 0xdf, 0x19, 0x50, 0xc8, 0xa2, 0x08, 0x34,
+	0x6d, 0xbe, 0x6c, 0xe4, 0x17, 0x5f, 0x9f, 0x1e, 0xa0, 0x19, 0xde, 0x96, 0x0c, 0x6f, 0xa0, 0xeb,
+	0xce, 0xc4, 0xef, 0x07, 0x81, 0xbe, 0x32, 0x20, 0xaf, 0x76, 0x02, 0xba, 0x33, 0x21, 0xd3, 0xc0,
+	0x2a, 0x2a, 0xde, 0x9d, 0xd2, 0x5b, 0x93, 0xaa, 0x4a, 0x52, 0x16, 0xaa, 0x38, 0x13, 0xbe, 0x55,
+	0xd0, 0x2f, 0x06, 0x5c, 0x19, 0xd9, 0x05, 0xe8, 0xfe, 0x84, 0x74, 0xe3, 0x56, 0x57, 0xf1, 0xcd,
+	0xd9, 0x81, 0x9a, 0xf2, 0xdb, 0x92, 0xf2, 0x7d, 0x74, 0x6f, 0x3c, 0x65, 0xea, 0xf9, 0x8d, 0x44,
+	0xa3, 0x1b, 0x6a, 0x5b, 0xf5, 0xea, 0xfe, 0x93, 0x01, 0x17, 0xfb, 0x27, 0x1a, 0xaa, 0x4d, 0x62,
+	0x32, 0xba, 0x95, 0x8a, 0x1b, 0x33, 0x61, 0xa6, 0x6f, 0xd1, 0x48, 0x41, 0x1c, 0x9a, 0xe2, 0xd1,
+	0x8f, 0x06, 0x5c, 0xe8, 0x9b, 0x3c, 0x68, 0x7d, 0x42, 0xd6, 0xd1, 0x59, 0x59, 0xac, 0xcd, 0x02,
+	0xd1, 0x3c, 0x6d, 0xc9, 0xb3, 0x8a, 0x6e, 0x4e, 0xe6, 0x99, 0x8e, 0x49, 0x49, 0xb3, 0x6f, 0xa6,
+	0x4c, 0xa4, 0x39, 0x3a, 0xe7, 0x8a, 0xb5, 0x59, 0x20, 0xb3, 0xd3, 0xf4, 0x3a, 0x31, 0xab, 0xef,
+	0x3c, 0x3b, 0x2e, 0x19, 0xcf, 0x8f, 0x4b, 0xc6, 0x9f, 0xc7, 0x25, 0xe3, 0xe9, 0x49, 0x69, 0xee,
+	0xf9, 0x49, 0x69, 0xee, 0xb7, 0x93, 0xd2, 0xdc, 0xa7, 0x6f, 0xb4, 0x68, 0xd2, 0xee, 0x78, 0xb6,
+	0xcf, 0xc3, 0x2c, 0x16, 0x6f, 0x36, 0xa9, 0x4f, 0x71, 0xe0, 0xb4, 0xf8, 0xdd, 0x2c, 0xfc, 0xe1,
+	0x69, 0x82, 0xa4, 0x1b, 0x11, 0xe1, 0xe5, 0xe5, 0x1a, 0xdd, 0xf8, 0x7b, 0x00, 0x95, 0x99, 0x82,
+	0x44, 0xf2, 0x0c, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// FanToken returns fantoken with fantoken name
+	FanToken(ctx context.Context, in *QueryFanTokenRequest, opts ...grpc.CallOption) (*QueryFanTokenResponse, error)
+	// FanTokens returns the fantoken list
+	FanTokens(ctx context.Context, in *QueryFanTokensRequest, opts ...grpc.CallOption) (*QueryFanTokensResponse, error)
+	// Params queries the fantoken parameters
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// IBCTransferPolicy returns which IBC channels a fantoken can be sent over
+	IBCTransferPolicy(ctx context.Context, in *QueryIBCTransferPolicyRequest, opts ...grpc.CallOption) (*QueryIBCTransferPolicyResponse, error)
+	// IssuePreview dry-runs an issue against the current state and returns the
+	// predicted denom, the fee charged and the error the issue would fail with
+	IssuePreview(ctx context.Context, in *QueryIssuePreviewRequest, opts ...grpc.CallOption) (*QueryIssuePreviewResponse, error)
+	// MintPreview dry-runs a mint against the current state
+	MintPreview(ctx context.Context, in *QueryMintPreviewRequest, opts ...grpc.CallOption) (*QueryMintPreviewResponse, error)
+	// BurnPreview dry-runs a burn against the current state
+	BurnPreview(ctx context.Context, in *QueryBurnPreviewRequest, opts ...grpc.CallOption) (*QueryBurnPreviewResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) FanToken(ctx context.Context, in *QueryFanTokenRequest, opts ...grpc.CallOption) (*QueryFanTokenResponse, error) {
+	out := new(QueryFanTokenResponse)
+	err := c.cc.Invoke(ctx, "/bitsong.fantoken.v1beta1.Query/FanToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FanTokens(ctx context.Context, in *QueryFanTokensRequest, opts ...grpc.CallOption) (*QueryFanTokensResponse, error) {
+	out := new(QueryFanTokensResponse)
+	err := c.cc.Invoke(ctx, "/bitsong.fantoken.v1beta1.Query/FanTokens", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/bitsong.fantoken.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IBCTransferPolicy(ctx context.Context, in *QueryIBCTransferPolicyRequest, opts ...grpc.CallOption) (*QueryIBCTransferPolicyResponse, error) {
+	out := new(QueryIBCTransferPolicyResponse)
+	err := c.cc.Invoke(ctx, "/bitsong.fantoken.v1beta1.Query/IBCTransferPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IssuePreview(ctx context.Context, in *QueryIssuePreviewRequest, opts ...grpc.CallOption) (*QueryIssuePreviewResponse, error) {
+	out := new(QueryIssuePreviewResponse)
+	err := c.cc.Invoke(ctx, "/bitsong.fantoken.v1beta1.Query/IssuePreview", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MintPreview(ctx context.Context, in *QueryMintPreviewRequest, opts ...grpc.CallOption) (*QueryMintPreviewResponse, error) {
+	out := new(QueryMintPreviewResponse)
+	err := c.cc.Invoke(ctx, "/bitsong.fantoken.v1beta1.Query/MintPreview", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BurnPreview(ctx context.Context, in *QueryBurnPreviewRequest, opts ...grpc.CallOption) (*QueryBurnPreviewResponse, error) {
+	out := new(QueryBurnPreviewResponse)
+	err := c.cc.Invoke(ctx, "/bitsong.fantoken.v1beta1.Query/BurnPreview", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// FanToken returns fantoken with fantoken name
+	FanToken(context.Context, *QueryFanTokenRequest) (*QueryFanTokenResponse, error)
+	// FanTokens returns the fantoken list
+	FanTokens(context.Context, *QueryFanTokensRequest) (*QueryFanTokensResponse, error)
+	// Params queries the fantoken parameters
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// IBCTransferPolicy returns which IBC channels a fantoken can be sent over
+	IBCTransferPolicy(context.Context, *QueryIBCTransferPolicyRequest) (*QueryIBCTransferPolicyResponse, error)
+	// IssuePreview dry-runs an issue against the current state and returns the
+	// predicted denom, the fee charged and the error the issue would fail with
+	IssuePreview(context.Context, *QueryIssuePreviewRequest) (*QueryIssuePreviewResponse, error)
+	// MintPreview dry-runs a mint against the current state
+	MintPreview(context.Context, *QueryMintPreviewRequest) (*QueryMintPreviewResponse, error)
+	// BurnPreview dry-runs a burn against the current state
+	BurnPreview(context.Context, *QueryBurnPreviewRequest) (*QueryBurnPreviewResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) FanToken(ctx context.Context, req *QueryFanTokenRequest) (*QueryFanTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FanToken not implemented")
+}
+func (*UnimplementedQueryServer) FanTokens(ctx context.Context, req *QueryFanTokensRequest) (*QueryFanTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FanTokens not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) IBCTransferPolicy(ctx context.Context, req *QueryIBCTransferPolicyRequest) (*QueryIBCTransferPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IBCTransferPolicy not implemented")
+}
+func (*UnimplementedQueryServer) IssuePreview(ctx context.Context, req *QueryIssuePreviewRequest) (*QueryIssuePreviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssuePreview not implemented")
+}
+func (*UnimplementedQueryServer) MintPreview(ctx context.Context, req *QueryMintPreviewRequest) (*QueryMintPreviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintPreview not implemented")
+}
+func (*UnimplementedQueryServer) BurnPreview(ctx context.Context, req *QueryBurnPreviewRequest) (*QueryBurnPreviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BurnPreview not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_FanToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFanTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FanToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitsong.fantoken.v1beta1.Query/FanToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FanToken(ctx, req.(*QueryFanTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FanTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFanTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FanTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitsong.fantoken.v1beta1.Query/FanTokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FanTokens(ctx, req.(*QueryFanTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitsong.fantoken.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IBCTransferPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIBCTransferPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IBCTransferPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitsong.fantoken.v1beta1.Query/IBCTransferPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IBCTransferPolicy(ctx, req.(*QueryIBCTransferPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IssuePreview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIssuePreviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IssuePreview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitsong.fantoken.v1beta1.Query/IssuePreview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IssuePreview(ctx, req.(*QueryIssuePreviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MintPreview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMintPreviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MintPreview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitsong.fantoken.v1beta1.Query/MintPreview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MintPreview(ctx, req.(*QueryMintPreviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BurnPreview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBurnPreviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BurnPreview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitsong.fantoken.v1beta1.Query/BurnPreview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BurnPreview(ctx, req.(*QueryBurnPreviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "bitsong.fantoken.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "FanToken",
+			Handler:    _Query_FanToken_Handler,
+		},
+		{
+			MethodName: "FanTokens",
+			Handler:    _Query_FanTokens_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "IBCTransferPolicy",
+			Handler:    _Query_IBCTransferPolicy_Handler,
+		},
+		{
+			MethodName: "IssuePreview",
+			Handler:    _Query_IssuePreview_Handler,
+		},
+		{
+			MethodName: "MintPreview",
+			Handler:    _Query_MintPreview_Handler,
+		},
+		{
+			MethodName: "BurnPreview",
+			Handler:    _Query_BurnPreview_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "bitsong/fantoken/v1beta1/query.proto",
+}
+
+func (m *QueryFanTokenRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFanTokenRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFanTokenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFanTokenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFanTokenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFanTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Fantoken != nil {
+		{
+			size, err := m.Fantoken.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFanTokensRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFanTokensRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFanTokensRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFanTokensResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFanTokensResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFanTokensResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Fantokens) > 0 {
+		for iNdEx := len(m.Fantokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fantokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIBCTransferPolicyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIBCTransferPolicyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIBCTransferPolicyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIBCTransferPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIBCTransferPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIBCTransferPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedChannels) > 0 {
+		for iNdEx := len(m.AllowedChannels) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedChannels[iNdEx])
+			copy(dAtA[i:], m.AllowedChannels[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.AllowedChannels[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Policy != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Policy))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIssuePreviewRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIssuePreviewRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIssuePreviewRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiryTime != nil {
+		n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpiryTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpiryTime):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintQuery(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.ClawbackEnabled {
+		i--
+		if m.ClawbackEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.URI) > 0 {
+		i -= len(m.URI)
+		copy(dAtA[i:], m.URI)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.URI)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Minter) > 0 {
+		i -= len(m.Minter)
+		copy(dAtA[i:], m.Minter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Minter)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.MaxSupply.Size()
+		i -= size
+		if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIssuePreviewResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIssuePreviewResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIssuePreviewResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMintPreviewRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMintPreviewRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMintPreviewRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Minter) > 0 {
+		i -= len(m.Minter)
+		copy(dAtA[i:], m.Minter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Minter)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Coin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMintPreviewResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMintPreviewResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMintPreviewResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBurnPreviewRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnPreviewRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnPreviewRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Coin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBurnPreviewResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnPreviewResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnPreviewResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryFanTokenRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFanTokenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Fantoken != nil {
+		l = m.Fantoken.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFanTokensRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFanTokensResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Fantokens) > 0 {
+		for _, e := range m.Fantokens {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryIBCTransferPolicyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIBCTransferPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Policy != 0 {
+		n += 1 + sovQuery(uint64(m.Policy))
+	}
+	if len(m.AllowedChannels) > 0 {
+		for _, s := range m.AllowedChannels {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryIssuePreviewRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.MaxSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Minter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.URI)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.ClawbackEnabled {
+		n += 2
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ExpiryHeight))
+	}
+	if m.ExpiryTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpiryTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIssuePreviewResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Fee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMintPreviewRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Coin.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Minter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMintPreviewResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Fee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBurnPreviewRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Coin.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBurnPreviewResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Fee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryFanTokenRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFanTokenRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFanTokenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFanTokenResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFanTokenResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFanTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fantoken", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Fantoken == nil {
+				m.Fantoken = &FanToken{}
+			}
+			if err := m.Fantoken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFanTokensRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFanTokensRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFanTokensRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFanTokensResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFanTokensResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFanTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fantokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fantokens = append(m.Fantokens, &FanToken{})
+			if err := m.Fantokens[len(m.Fantokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIBCTransferPolicyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIBCTransferPolicyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIBCTransferPolicyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIBCTransferPolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIBCTransferPolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIBCTransferPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			m.Policy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Policy |= IBCTransferPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedChannels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedChannels = append(m.AllowedChannels, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIssuePreviewRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
 		var wire uint64
@@ -849,21 +2818,273 @@ func (m *QueryFanTokenRequest) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFanTokenRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFanTokenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIssuePreviewRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIssuePreviewRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URI", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URI = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClawbackEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ClawbackEnabled = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -873,23 +3094,27 @@ func (m *QueryFanTokenRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			if m.ExpiryTime == nil {
+				m.ExpiryTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpiryTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -912,7 +3137,7 @@ func (m *QueryFanTokenRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFanTokenResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryIssuePreviewResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -935,15 +3160,47 @@ func (m *QueryFanTokenResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFanTokenResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIssuePreviewResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFanTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIssuePreviewResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fantoken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -970,13 +3227,42 @@ func (m *QueryFanTokenResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Fantoken == nil {
-				m.Fantoken = &FanToken{}
-			}
-			if err := m.Fantoken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -998,7 +3284,7 @@ func (m *QueryFanTokenResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFanTokensRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryMintPreviewRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1021,15 +3307,15 @@ func (m *QueryFanTokensRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFanTokensRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMintPreviewRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFanTokensRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMintPreviewRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1057,11 +3343,11 @@ func (m *QueryFanTokensRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1088,13 +3374,42 @@ func (m *QueryFanTokensRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1116,7 +3431,7 @@ func (m *QueryFanTokensRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFanTokensResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMintPreviewResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1139,15 +3454,15 @@ func (m *QueryFanTokensResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFanTokensResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMintPreviewResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFanTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMintPreviewResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fantokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1174,16 +3489,15 @@ func (m *QueryFanTokensResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fantokens = append(m.Fantokens, &FanToken{})
-			if err := m.Fantokens[len(m.Fantokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1193,27 +3507,23 @@ func (m *QueryFanTokensResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1236,7 +3546,7 @@ func (m *QueryFanTokensResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBurnPreviewRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1259,12 +3569,77 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBurnPreviewRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBurnPreviewRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1286,7 +3661,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBurnPreviewResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1309,15 +3684,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBurnPreviewResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBurnPreviewResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1344,10 +3719,42 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
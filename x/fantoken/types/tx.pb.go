@@ -7,10 +7,13 @@ import (
 	context "context"
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
-	types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "github.com/gogo/protobuf/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -18,12 +21,14 @@ import (
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -50,6 +55,16 @@ type MsgIssue struct {
 	// URI which is the current uri of the fan token. It is a string can change
 	// during the fan token lifecycle thanks to the MsgEdit
 	URI string `protobuf:"bytes,6,opt,name=uri,proto3" json:"uri,omitempty"`
+	// clawback_enabled which allows the minter or the authority to burn or
+	// reclaim the fan token from any holder. It cannot change for the whole life
+	// of the fan token
+	ClawbackEnabled bool `protobuf:"varint,7,opt,name=clawback_enabled,json=clawbackEnabled,proto3" json:"clawback_enabled,omitempty" yaml:"clawback_enabled"`
+	// expiry_height which is the block height from which the fan token cannot be
+	// transferred anymore. Optional, 0 if it never expires
+	ExpiryHeight int64 `protobuf:"varint,8,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty" yaml:"expiry_height"`
+	// expiry_time which is the block time from which the fan token cannot be
+	// transferred anymore. Optional, nil if it never expires
+	ExpiryTime *time.Time `protobuf:"bytes,9,opt,name=expiry_time,json=expiryTime,proto3,stdtime" json:"expiry_time,omitempty" yaml:"expiry_time"`
 }
 
 func (m *MsgIssue) Reset()         { *m = MsgIssue{} }
@@ -204,8 +219,8 @@ var xxx_messageInfo_MsgDisableMintResponse proto.InternalMessageInfo
 type MsgMint struct {
 	Recipient string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
 	// coin mean the amount + denom, eg: 10000ftFADJID34MCDM
-	Coin   types.Coin `protobuf:"bytes,2,opt,name=coin,proto3" json:"coin" yaml:"coin"`
-	Minter string     `protobuf:"bytes,3,opt,name=minter,proto3" json:"minter,omitempty"`
+	Coin   types1.Coin `protobuf:"bytes,2,opt,name=coin,proto3" json:"coin" yaml:"coin"`
+	Minter string      `protobuf:"bytes,3,opt,name=minter,proto3" json:"minter,omitempty"`
 }
 
 func (m *MsgMint) Reset()         { *m = MsgMint{} }
@@ -243,8 +258,8 @@ var xxx_messageInfo_MsgMint proto.InternalMessageInfo
 
 // MsgMintResponse defines the MsgMint response type
 type MsgMintResponse struct {
-	Recipient string     `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
-	Coin      types.Coin `protobuf:"bytes,2,opt,name=coin,proto3" json:"coin" yaml:"coin"`
+	Recipient string      `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Coin      types1.Coin `protobuf:"bytes,2,opt,name=coin,proto3" json:"coin" yaml:"coin"`
 }
 
 func (m *MsgMintResponse) Reset()         { *m = MsgMintResponse{} }
@@ -283,8 +298,8 @@ var xxx_messageInfo_MsgMintResponse proto.InternalMessageInfo
 // MsgBurn defines a message for burning some fan tokens
 type MsgBurn struct {
 	// coin mean the amount + denom, eg: 10000ftFADJID34MCDM
-	Coin   types.Coin `protobuf:"bytes,1,opt,name=coin,proto3" json:"coin" yaml:"coin"`
-	Sender string     `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	Coin   types1.Coin `protobuf:"bytes,1,opt,name=coin,proto3" json:"coin" yaml:"coin"`
+	Sender string      `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
 }
 
 func (m *MsgBurn) Reset()         { *m = MsgBurn{} }
@@ -322,8 +337,8 @@ var xxx_messageInfo_MsgBurn proto.InternalMessageInfo
 
 // MsgBurnResponse defines the MsgBurn response type
 type MsgBurnResponse struct {
-	Sender string     `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	Coin   types.Coin `protobuf:"bytes,2,opt,name=coin,proto3" json:"coin" yaml:"coin"`
+	Sender string      `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Coin   types1.Coin `protobuf:"bytes,2,opt,name=coin,proto3" json:"coin" yaml:"coin"`
 }
 
 func (m *MsgBurnResponse) Reset()         { *m = MsgBurnResponse{} }
@@ -607,6 +622,178 @@ func (m *MsgSetUriResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSetUriResponse proto.InternalMessageInfo
 
+// MsgClawback defines a message for burning or reclaiming some fan tokens from
+// a holder
+type MsgClawback struct {
+	// sender, the minter or the authority of the fan token
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// holder, the account the fan tokens are taken from
+	Holder string `protobuf:"bytes,2,opt,name=holder,proto3" json:"holder,omitempty"`
+	// recipient, the account receiving the fan tokens. If empty the fan tokens
+	// are burned
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// coin mean the amount + denom, eg: 10000ftFADJID34MCDM
+	Coin types1.Coin `protobuf:"bytes,4,opt,name=coin,proto3" json:"coin" yaml:"coin"`
+}
+
+func (m *MsgClawback) Reset()         { *m = MsgClawback{} }
+func (m *MsgClawback) String() string { return proto.CompactTextString(m) }
+func (*MsgClawback) ProtoMessage()    {}
+func (*MsgClawback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d1955b4a1569b3cf, []int{14}
+}
+func (m *MsgClawback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClawback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClawback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClawback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClawback.Merge(m, src)
+}
+func (m *MsgClawback) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClawback) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClawback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClawback proto.InternalMessageInfo
+
+// MsgClawbackResponse defines the MsgClawback response type
+type MsgClawbackResponse struct {
+	Holder    string      `protobuf:"bytes,1,opt,name=holder,proto3" json:"holder,omitempty"`
+	Recipient string      `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Coin      types1.Coin `protobuf:"bytes,3,opt,name=coin,proto3" json:"coin" yaml:"coin"`
+}
+
+func (m *MsgClawbackResponse) Reset()         { *m = MsgClawbackResponse{} }
+func (m *MsgClawbackResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClawbackResponse) ProtoMessage()    {}
+func (*MsgClawbackResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d1955b4a1569b3cf, []int{15}
+}
+func (m *MsgClawbackResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClawbackResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClawbackResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClawbackResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClawbackResponse.Merge(m, src)
+}
+func (m *MsgClawbackResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClawbackResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClawbackResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClawbackResponse proto.InternalMessageInfo
+
+// MsgSetIBCTransferPolicy defines a message for setting which IBC channels a
+// fan token can be sent over
+type MsgSetIBCTransferPolicy struct {
+	// authority, the actual metadata authority
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// denom the fan token denom
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// policy, allow all, deny all or allowlist
+	Policy IBCTransferPolicy `protobuf:"varint,3,opt,name=policy,proto3,enum=bitsong.fantoken.v1beta1.IBCTransferPolicy" json:"policy,omitempty"`
+	// allowed_channels, the source channels allowed by the allowlist policy
+	AllowedChannels []string `protobuf:"bytes,4,rep,name=allowed_channels,json=allowedChannels,proto3" json:"allowed_channels,omitempty" yaml:"allowed_channels"`
+}
+
+func (m *MsgSetIBCTransferPolicy) Reset()         { *m = MsgSetIBCTransferPolicy{} }
+func (m *MsgSetIBCTransferPolicy) String() string { return proto.CompactTextString(m) }
+func (*MsgSetIBCTransferPolicy) ProtoMessage()    {}
+func (*MsgSetIBCTransferPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d1955b4a1569b3cf, []int{16}
+}
+func (m *MsgSetIBCTransferPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetIBCTransferPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetIBCTransferPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetIBCTransferPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetIBCTransferPolicy.Merge(m, src)
+}
+func (m *MsgSetIBCTransferPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetIBCTransferPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetIBCTransferPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetIBCTransferPolicy proto.InternalMessageInfo
+
+// MsgSetIBCTransferPolicyResponse defines the MsgSetIBCTransferPolicy response
+// type
+type MsgSetIBCTransferPolicyResponse struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *MsgSetIBCTransferPolicyResponse) Reset()         { *m = MsgSetIBCTransferPolicyResponse{} }
+func (m *MsgSetIBCTransferPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetIBCTransferPolicyResponse) ProtoMessage()    {}
+func (*MsgSetIBCTransferPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d1955b4a1569b3cf, []int{17}
+}
+func (m *MsgSetIBCTransferPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetIBCTransferPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetIBCTransferPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetIBCTransferPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetIBCTransferPolicyResponse.Merge(m, src)
+}
+func (m *MsgSetIBCTransferPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetIBCTransferPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetIBCTransferPolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetIBCTransferPolicyResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgIssue)(nil), "bitsong.fantoken.v1beta1.MsgIssue")
 	proto.RegisterType((*MsgIssueResponse)(nil), "bitsong.fantoken.v1beta1.MsgIssueResponse")
@@ -622,64 +809,91 @@ func init() {
 	proto.RegisterType((*MsgSetAuthorityResponse)(nil), "bitsong.fantoken.v1beta1.MsgSetAuthorityResponse")
 	proto.RegisterType((*MsgSetUri)(nil), "bitsong.fantoken.v1beta1.MsgSetUri")
 	proto.RegisterType((*MsgSetUriResponse)(nil), "bitsong.fantoken.v1beta1.MsgSetUriResponse")
+	proto.RegisterType((*MsgClawback)(nil), "bitsong.fantoken.v1beta1.MsgClawback")
+	proto.RegisterType((*MsgClawbackResponse)(nil), "bitsong.fantoken.v1beta1.MsgClawbackResponse")
+	proto.RegisterType((*MsgSetIBCTransferPolicy)(nil), "bitsong.fantoken.v1beta1.MsgSetIBCTransferPolicy")
+	proto.RegisterType((*MsgSetIBCTransferPolicyResponse)(nil), "bitsong.fantoken.v1beta1.MsgSetIBCTransferPolicyResponse")
 }
 
 func init() { proto.RegisterFile("bitsong/fantoken/v1beta1/tx.proto", fileDescriptor_d1955b4a1569b3cf) }
 
 var fileDescriptor_d1955b4a1569b3cf = []byte{
-	// 829 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
-	0x10, 0x16, 0xad, 0x9f, 0x94, 0x63, 0xa5, 0x4d, 0x58, 0x25, 0x65, 0x08, 0x83, 0x6a, 0xb6, 0x40,
-	0x10, 0xb7, 0x28, 0x59, 0xbb, 0x41, 0x0f, 0x01, 0x52, 0xa0, 0x6a, 0x2f, 0x3e, 0xe8, 0x50, 0x3a,
-	0x46, 0x01, 0x5f, 0x0c, 0x4a, 0x5a, 0xd3, 0x8b, 0x90, 0xbb, 0x02, 0x77, 0x55, 0x4b, 0xe7, 0xbe,
-	0x40, 0x8e, 0x7d, 0x80, 0xf6, 0xd8, 0xf7, 0xf0, 0xd1, 0xc7, 0xc2, 0x07, 0xa1, 0x95, 0xdf, 0x40,
-	0x4f, 0x50, 0x70, 0x97, 0x5a, 0x52, 0x86, 0x2a, 0x09, 0xe8, 0x4f, 0x6e, 0xcb, 0x99, 0xef, 0x9b,
-	0xf9, 0x86, 0x3b, 0x3b, 0xbb, 0xf0, 0xb4, 0x47, 0x04, 0x67, 0x34, 0xf2, 0xcf, 0x43, 0x2a, 0xd8,
-	0x1b, 0x4c, 0xfd, 0x1f, 0x0f, 0x7a, 0x58, 0x84, 0x07, 0xbe, 0x18, 0x7b, 0xc3, 0x94, 0x09, 0x66,
-	0xd9, 0x39, 0xc4, 0x5b, 0x40, 0xbc, 0x1c, 0xe2, 0xb8, 0x7d, 0xc6, 0x13, 0xc6, 0xfd, 0x5e, 0xc8,
-	0xb1, 0xe6, 0xf5, 0x19, 0xa1, 0x8a, 0xe9, 0xb4, 0x22, 0x16, 0x31, 0xb9, 0xf4, 0xb3, 0x55, 0x6e,
-	0xdd, 0x8b, 0x18, 0x8b, 0x62, 0xec, 0x87, 0x43, 0xe2, 0x87, 0x94, 0x32, 0x11, 0x0a, 0xc2, 0x28,
-	0x57, 0x5e, 0x74, 0x63, 0xc0, 0x7b, 0x5d, 0x1e, 0x1d, 0x71, 0x3e, 0xc2, 0xd6, 0x63, 0x68, 0xf0,
-	0x49, 0xd2, 0x63, 0xb1, 0x6d, 0x7c, 0x6c, 0x3c, 0x37, 0x83, 0xfc, 0xcb, 0xb2, 0xa0, 0x46, 0xc3,
-	0x04, 0xdb, 0x3b, 0xd2, 0x2a, 0xd7, 0xd6, 0xf7, 0x00, 0x49, 0x38, 0x3e, 0xe3, 0xa3, 0xe1, 0x30,
-	0x9e, 0xd8, 0xd5, 0xcc, 0xd3, 0x39, 0xbc, 0x9a, 0xb6, 0x2b, 0x37, 0xd3, 0xf6, 0x23, 0x25, 0x94,
-	0x0f, 0xde, 0x78, 0x84, 0xf9, 0x49, 0x28, 0x2e, 0xbc, 0x23, 0x2a, 0xe6, 0xd3, 0xf6, 0xc3, 0x49,
-	0x98, 0xc4, 0x2f, 0x51, 0x41, 0x44, 0x81, 0x99, 0x84, 0xe3, 0x63, 0xb9, 0xb6, 0xf6, 0xc0, 0x0c,
-	0x47, 0xe2, 0x82, 0xa5, 0x44, 0x4c, 0xec, 0x9a, 0xcc, 0x55, 0x18, 0x32, 0x71, 0x09, 0xa1, 0x02,
-	0xa7, 0x76, 0x5d, 0x89, 0x53, 0x5f, 0xd6, 0x13, 0xa8, 0x8e, 0x52, 0x62, 0x37, 0xa4, 0x82, 0x7b,
-	0xb3, 0x69, 0xbb, 0x7a, 0x12, 0x1c, 0x05, 0x99, 0x0d, 0xbd, 0x84, 0x07, 0x8b, 0xda, 0x02, 0xcc,
-	0x87, 0x8c, 0x72, 0x6c, 0x3d, 0x83, 0xfa, 0x00, 0x53, 0x96, 0xa8, 0x12, 0x3b, 0x0f, 0xe6, 0xd3,
-	0x76, 0x53, 0xa9, 0x92, 0x66, 0x14, 0x28, 0x37, 0xfa, 0x1a, 0xde, 0xef, 0xf2, 0xe8, 0x3b, 0xc2,
-	0xc3, 0x5e, 0x8c, 0xbb, 0x84, 0x0a, 0xab, 0xb5, 0xc4, 0xcc, 0x71, 0x25, 0x59, 0x3b, 0x65, 0x59,
-	0xc8, 0x83, 0xc7, 0xcb, 0x7c, 0xad, 0x60, 0x65, 0x1c, 0xf4, 0x93, 0x01, 0xf7, 0xba, 0x3c, 0x92,
-	0x99, 0xf6, 0xc0, 0x4c, 0x71, 0x9f, 0x0c, 0x09, 0xa6, 0x22, 0x47, 0x15, 0x06, 0xab, 0x03, 0xb5,
-	0x6c, 0xd3, 0x65, 0xbe, 0xdd, 0xc3, 0x27, 0x9e, 0xfa, 0xd9, 0x5e, 0xd6, 0x15, 0x8b, 0x56, 0xf1,
-	0xbe, 0x65, 0x84, 0x76, 0x3e, 0xcc, 0xb6, 0x63, 0x3e, 0x6d, 0xef, 0xaa, 0xfa, 0x32, 0x12, 0x0a,
-	0x24, 0xb7, 0xa4, 0xba, 0xba, 0xa4, 0x9a, 0xc3, 0x07, 0xb9, 0x08, 0x2d, 0xf7, 0x3f, 0x17, 0x83,
-	0xb0, 0xac, 0xbc, 0x33, 0x4a, 0xa9, 0x0e, 0x67, 0xfc, 0xb3, 0xda, 0x38, 0xa6, 0x83, 0x62, 0x47,
-	0xd4, 0x17, 0x4a, 0x64, 0x6d, 0x59, 0x1a, 0x5d, 0x5b, 0x01, 0x35, 0xca, 0xd0, 0x7f, 0xa5, 0xaa,
-	0xb7, 0x06, 0x34, 0xbb, 0x3c, 0x3a, 0xc6, 0xa2, 0xab, 0x1a, 0x75, 0x75, 0xff, 0xbc, 0x00, 0x60,
-	0xf1, 0xe0, 0xac, 0xdc, 0x43, 0x9d, 0x47, 0xc5, 0x51, 0x29, 0x7c, 0x28, 0x30, 0x59, 0x3c, 0xc8,
-	0x63, 0xbd, 0x00, 0xa0, 0xf8, 0xf2, 0xac, 0xbc, 0x87, 0x65, 0x56, 0xe1, 0x43, 0x81, 0x49, 0xf1,
-	0xa5, 0x62, 0xa1, 0x9f, 0x0d, 0x68, 0x95, 0x25, 0xad, 0x6f, 0xc9, 0xff, 0x55, 0xda, 0xaf, 0x86,
-	0xdc, 0x9d, 0x63, 0x2c, 0xbe, 0xd1, 0x27, 0x7e, 0xb5, 0xaa, 0x57, 0x70, 0x3f, 0xcb, 0x5c, 0x4c,
-	0x0a, 0x25, 0xcc, 0x9e, 0x4f, 0xdb, 0xad, 0x42, 0x98, 0x76, 0xa3, 0xa0, 0xc9, 0xe2, 0x41, 0x11,
-	0xf4, 0x15, 0xdc, 0xcf, 0x24, 0x14, 0xf4, 0xea, 0x5d, 0xfa, 0x92, 0x1b, 0x05, 0x4d, 0x8a, 0x2f,
-	0x35, 0x1d, 0xfd, 0x66, 0xc0, 0x47, 0x77, 0x74, 0x6e, 0xf8, 0x8b, 0xef, 0x56, 0xef, 0x29, 0x98,
-	0x4a, 0xee, 0x49, 0x4a, 0x96, 0x07, 0xac, 0x71, 0x77, 0xc0, 0x6a, 0xf9, 0x3b, 0x65, 0xf9, 0xf9,
-	0x78, 0xad, 0xae, 0x18, 0xaf, 0xfb, 0xf0, 0x50, 0xc7, 0x5e, 0xff, 0x13, 0x0e, 0x7f, 0xa9, 0x43,
-	0xb5, 0xcb, 0x23, 0xeb, 0x07, 0xa8, 0xab, 0xab, 0x06, 0x79, 0x7f, 0x77, 0xcd, 0x79, 0x8b, 0x91,
-	0xed, 0x7c, 0xba, 0x19, 0xa3, 0xd3, 0xbe, 0x86, 0x9a, 0x1c, 0x9d, 0x4f, 0xd7, 0x72, 0x32, 0x88,
-	0xb3, 0xbf, 0x11, 0x52, 0x8e, 0x2a, 0xc7, 0xd2, 0xfa, 0xa8, 0x19, 0x64, 0x43, 0xd4, 0xa5, 0xa9,
-	0x43, 0x60, 0xb7, 0x7c, 0xaf, 0x3c, 0x5f, 0xcb, 0x2c, 0x21, 0x9d, 0x2f, 0xb6, 0x45, 0xea, 0x54,
-	0x7d, 0x30, 0x8b, 0x01, 0xf4, 0x6c, 0x2d, 0x5d, 0xe3, 0x1c, 0x6f, 0x3b, 0x9c, 0x4e, 0x12, 0x43,
-	0x73, 0xe9, 0xdc, 0xee, 0x6f, 0xe2, 0x6b, 0xa8, 0x73, 0xb0, 0x35, 0x54, 0x67, 0x3b, 0x85, 0x46,
-	0xde, 0xce, 0x9f, 0x6c, 0x22, 0x9f, 0xa4, 0xc4, 0xf9, 0x6c, 0x0b, 0xd0, 0x22, 0x76, 0xe7, 0xf5,
-	0xd5, 0x9f, 0x6e, 0xe5, 0x6a, 0xe6, 0x1a, 0xd7, 0x33, 0xd7, 0xf8, 0x63, 0xe6, 0x1a, 0x6f, 0x6f,
-	0xdd, 0xca, 0xf5, 0xad, 0x5b, 0xf9, 0xfd, 0xd6, 0xad, 0x9c, 0x7e, 0x15, 0x11, 0x71, 0x31, 0xea,
-	0x79, 0x7d, 0x96, 0xf8, 0x79, 0x50, 0x76, 0x7e, 0x4e, 0xfa, 0x24, 0x8c, 0xfd, 0x88, 0x7d, 0xbe,
-	0x78, 0xda, 0x8d, 0x8b, 0xc7, 0x9d, 0x98, 0x0c, 0x31, 0xef, 0x35, 0xe4, 0x53, 0xeb, 0xcb, 0xbf,
-	0x02, 0x00, 0x00, 0xff, 0xff, 0xca, 0xd7, 0xbb, 0x03, 0xfd, 0x09, 0x00, 0x00,
+	// 1196 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0x66, 0x1d, 0x27, 0x7e, 0x76, 0x9b, 0x76, 0x9b, 0xb6, 0xdb, 0xa5, 0xf2, 0xa6, 0x83,
+	0x28, 0x4e, 0xab, 0xee, 0x92, 0x50, 0x81, 0xb0, 0xd4, 0x03, 0x0e, 0x20, 0x22, 0x64, 0x09, 0xb6,
+	0xa9, 0x2a, 0xf5, 0x62, 0xd6, 0xf6, 0x64, 0x3d, 0x64, 0x77, 0xc6, 0xda, 0x59, 0x37, 0xf1, 0x0d,
+	0x21, 0xee, 0xe4, 0xc0, 0x81, 0x2b, 0x77, 0x90, 0x7a, 0xe5, 0x3f, 0x88, 0x38, 0xf5, 0x88, 0x38,
+	0x18, 0x48, 0x0e, 0x95, 0x38, 0xfa, 0x2f, 0x40, 0xbb, 0x3b, 0xde, 0x5d, 0x27, 0xb1, 0x1d, 0x5a,
+	0x7e, 0x9c, 0xbc, 0xf3, 0xe6, 0xfb, 0xde, 0xfb, 0xde, 0xbc, 0x99, 0x37, 0x63, 0xb8, 0xd5, 0x24,
+	0x01, 0x67, 0xd4, 0x31, 0x77, 0x6c, 0x1a, 0xb0, 0x5d, 0x4c, 0xcd, 0xa7, 0xeb, 0x4d, 0x1c, 0xd8,
+	0xeb, 0x66, 0xb0, 0x6f, 0x74, 0x7d, 0x16, 0x30, 0x45, 0x15, 0x10, 0x63, 0x04, 0x31, 0x04, 0x44,
+	0x2b, 0xb7, 0x18, 0xf7, 0x18, 0x37, 0x9b, 0x36, 0xc7, 0x09, 0xaf, 0xc5, 0x08, 0x8d, 0x99, 0xda,
+	0x8a, 0xc3, 0x1c, 0x16, 0x7d, 0x9a, 0xe1, 0x97, 0xb0, 0xde, 0x74, 0x18, 0x73, 0x5c, 0x6c, 0xda,
+	0x5d, 0x62, 0xda, 0x94, 0xb2, 0xc0, 0x0e, 0x08, 0xa3, 0x5c, 0xcc, 0x5e, 0x17, 0x3e, 0x3d, 0xee,
+	0x98, 0x4f, 0xd7, 0xc3, 0x1f, 0x31, 0xa1, 0x0b, 0x5a, 0x34, 0x6a, 0xf6, 0x76, 0xcc, 0x80, 0x78,
+	0x98, 0x07, 0xb6, 0xd7, 0x15, 0x80, 0x37, 0x27, 0xa6, 0x92, 0x08, 0x8f, 0x80, 0xe8, 0x67, 0x19,
+	0x96, 0xea, 0xdc, 0xd9, 0xe2, 0xbc, 0x87, 0x95, 0x6b, 0x90, 0xe7, 0x7d, 0xaf, 0xc9, 0x5c, 0x55,
+	0x5a, 0x95, 0x2a, 0x05, 0x4b, 0x8c, 0x14, 0x05, 0x72, 0xd4, 0xf6, 0xb0, 0x3a, 0x1f, 0x59, 0xa3,
+	0x6f, 0xe5, 0x33, 0x00, 0xcf, 0xde, 0x6f, 0xf0, 0x5e, 0xb7, 0xeb, 0xf6, 0x55, 0x39, 0x9c, 0xa9,
+	0x6d, 0x1c, 0x0e, 0xf4, 0xb9, 0x5f, 0x07, 0xfa, 0xd5, 0x58, 0x37, 0x6f, 0xef, 0x1a, 0x84, 0x99,
+	0x9e, 0x1d, 0x74, 0x8c, 0x2d, 0x1a, 0x0c, 0x07, 0xfa, 0xe5, 0xbe, 0xed, 0xb9, 0x55, 0x94, 0x12,
+	0x91, 0x55, 0xf0, 0xec, 0xfd, 0x87, 0xd1, 0xb7, 0x72, 0x13, 0x0a, 0x76, 0x2f, 0xe8, 0x30, 0x9f,
+	0x04, 0x7d, 0x35, 0x17, 0xc5, 0x4a, 0x0d, 0xa1, 0x38, 0x8f, 0xd0, 0x00, 0xfb, 0xea, 0x42, 0x2c,
+	0x2e, 0x1e, 0x29, 0x37, 0x40, 0xee, 0xf9, 0x44, 0xcd, 0x47, 0x0a, 0x16, 0x8f, 0x06, 0xba, 0xfc,
+	0xc8, 0xda, 0xb2, 0x42, 0x9b, 0xf2, 0x11, 0x5c, 0x6a, 0xb9, 0xf6, 0x5e, 0xd3, 0x6e, 0xed, 0x36,
+	0x30, 0xb5, 0x9b, 0x2e, 0x6e, 0xab, 0x8b, 0xab, 0x52, 0x65, 0xa9, 0xf6, 0xda, 0x70, 0xa0, 0x5f,
+	0x8f, 0xc5, 0x9c, 0x44, 0x20, 0x6b, 0x79, 0x64, 0xfa, 0x30, 0xb6, 0x28, 0x0f, 0xe0, 0x02, 0xde,
+	0xef, 0x12, 0xbf, 0xdf, 0xe8, 0x60, 0xe2, 0x74, 0x02, 0x75, 0x69, 0x55, 0xaa, 0xc8, 0x35, 0x75,
+	0x38, 0xd0, 0x57, 0x62, 0x27, 0x63, 0xd3, 0xc8, 0x2a, 0xc5, 0xe3, 0x8f, 0xa3, 0xa1, 0xf2, 0x18,
+	0x8a, 0x62, 0x3e, 0x2c, 0x93, 0x5a, 0x58, 0x95, 0x2a, 0xc5, 0x0d, 0xcd, 0x88, 0x6b, 0x68, 0x8c,
+	0x6a, 0x68, 0x6c, 0x8f, 0x6a, 0x58, 0xd3, 0x86, 0x03, 0x5d, 0x19, 0x73, 0x1c, 0x12, 0xd1, 0xc1,
+	0x6f, 0xba, 0x64, 0x41, 0x6c, 0x09, 0xc1, 0xd5, 0x8b, 0x5f, 0xbd, 0x78, 0x76, 0x27, 0x5d, 0x22,
+	0x54, 0x85, 0x4b, 0xa3, 0x5a, 0x5a, 0x98, 0x77, 0x19, 0xe5, 0x58, 0xb9, 0x0d, 0x0b, 0x6d, 0x4c,
+	0x99, 0x17, 0x97, 0xb4, 0x76, 0x69, 0x38, 0xd0, 0x4b, 0xb1, 0xeb, 0xc8, 0x8c, 0xac, 0x78, 0x1a,
+	0x7d, 0x02, 0x17, 0xeb, 0xdc, 0xf9, 0x80, 0xf0, 0x30, 0xe5, 0x3a, 0xa1, 0x81, 0xb2, 0x32, 0xc6,
+	0x14, 0xb8, 0x4c, 0x19, 0xe6, 0xb3, 0x65, 0xa8, 0x16, 0x43, 0x2d, 0x62, 0x80, 0x0c, 0xb8, 0x36,
+	0xee, 0x2c, 0x91, 0x73, 0xa6, 0x53, 0x74, 0x20, 0xc1, 0x62, 0x9d, 0x3b, 0x51, 0xd8, 0x9b, 0x50,
+	0xf0, 0x71, 0x8b, 0x74, 0x09, 0xa6, 0x81, 0x40, 0xa5, 0x06, 0xa5, 0x06, 0xb9, 0xf0, 0x50, 0x45,
+	0xc1, 0x8b, 0x1b, 0x37, 0x8c, 0x78, 0xa7, 0x19, 0xe1, 0xa9, 0x1b, 0x1d, 0x45, 0x63, 0x93, 0x11,
+	0x5a, 0xbb, 0x12, 0xee, 0xc5, 0xe1, 0x40, 0x2f, 0x8a, 0x2a, 0x33, 0x42, 0x91, 0x15, 0x71, 0x33,
+	0x29, 0xc8, 0x93, 0x53, 0xe0, 0xb0, 0x2c, 0x14, 0x25, 0xda, 0xff, 0x75, 0x65, 0xc8, 0x8f, 0x96,
+	0xa1, 0xd6, 0xf3, 0x69, 0xe2, 0x4e, 0x7a, 0xb5, 0x44, 0x39, 0xa6, 0xed, 0xb4, 0x56, 0xf1, 0x48,
+	0x24, 0x1a, 0x0f, 0x90, 0x07, 0xcb, 0x22, 0x66, 0x92, 0x68, 0xca, 0x93, 0xb2, 0xbc, 0x7f, 0x24,
+	0xc5, 0xef, 0x25, 0x28, 0xd5, 0xb9, 0xf3, 0x10, 0x07, 0xf5, 0xf8, 0xfc, 0x9e, 0xbd, 0xcd, 0xee,
+	0x03, 0x30, 0xb7, 0xdd, 0xc8, 0x6e, 0xb5, 0xda, 0xd5, 0xb4, 0x83, 0xa4, 0x73, 0xc8, 0x2a, 0x30,
+	0xb7, 0x2d, 0x7c, 0xdd, 0x07, 0xa0, 0x78, 0xaf, 0x91, 0xad, 0x6e, 0x96, 0x95, 0xce, 0x21, 0xab,
+	0x40, 0xf1, 0x5e, 0xcc, 0xaa, 0x2e, 0x87, 0xcb, 0x91, 0x09, 0x87, 0xbe, 0x93, 0x60, 0x25, 0xab,
+	0x71, 0xfa, 0xee, 0xfd, 0x2f, 0xb5, 0xa2, 0x9f, 0xa4, 0xa8, 0x5c, 0x0f, 0x71, 0xf0, 0x7e, 0xd2,
+	0x19, 0xcf, 0x56, 0xf5, 0x00, 0x2e, 0x84, 0x91, 0xd3, 0x8e, 0x1a, 0x0b, 0xcb, 0x34, 0xad, 0xb1,
+	0x69, 0x64, 0x95, 0x98, 0xdb, 0x4e, 0x9d, 0x3e, 0x80, 0x0b, 0xa1, 0x84, 0x94, 0x2e, 0x9f, 0xa4,
+	0x8f, 0x4d, 0x23, 0xab, 0x44, 0xf1, 0x5e, 0x42, 0xaf, 0x2a, 0xe1, 0x9a, 0x8e, 0x0b, 0x40, 0x3f,
+	0x4a, 0x70, 0xfd, 0x84, 0xf6, 0x19, 0x2b, 0xfb, 0xbf, 0xe6, 0x80, 0xbe, 0x80, 0x42, 0x2c, 0xf7,
+	0x91, 0x4f, 0xc6, 0x2f, 0x27, 0xe9, 0xe4, 0xe5, 0x94, 0xc8, 0x9f, 0xcf, 0xca, 0x17, 0x57, 0x93,
+	0x7c, 0xfa, 0x6a, 0x3a, 0xd5, 0xba, 0xd7, 0xe0, 0x72, 0x12, 0x6b, 0x46, 0xb3, 0xfc, 0x41, 0x82,
+	0x62, 0x9d, 0x3b, 0x9b, 0xe2, 0x92, 0x9a, 0x78, 0x5a, 0xaf, 0x41, 0xbe, 0xc3, 0xdc, 0xcc, 0xe9,
+	0x8f, 0x47, 0xe3, 0x6d, 0x4c, 0x9e, 0xd4, 0xc6, 0x72, 0x2f, 0x7f, 0xc6, 0xc7, 0xfb, 0xcb, 0x37,
+	0x12, 0x5c, 0xc9, 0xc8, 0xcd, 0x36, 0x19, 0x21, 0x4f, 0x9a, 0x2c, 0x6f, 0x7e, 0x92, 0x3c, 0xf9,
+	0x15, 0x5a, 0xd0, 0x9f, 0xc9, 0x3e, 0xdc, 0xaa, 0x6d, 0x6e, 0xfb, 0x36, 0xe5, 0x3b, 0xd8, 0xff,
+	0x94, 0xb9, 0xa4, 0xd5, 0x7f, 0xa9, 0x32, 0x6f, 0x42, 0xbe, 0x1b, 0xb1, 0x23, 0x55, 0x17, 0x37,
+	0xee, 0x1a, 0x93, 0x5e, 0x89, 0xc6, 0xa9, 0x80, 0x96, 0xa0, 0x86, 0x6f, 0x15, 0xdb, 0x75, 0xd9,
+	0x1e, 0x6e, 0x37, 0x5a, 0x1d, 0x9b, 0x52, 0xec, 0x72, 0x35, 0xb7, 0x2a, 0x57, 0x0a, 0xd9, 0xb7,
+	0xca, 0x49, 0x04, 0xb2, 0x96, 0x85, 0x69, 0x53, 0x58, 0x4e, 0x6d, 0xac, 0x77, 0x41, 0x9f, 0x90,
+	0xeb, 0xf4, 0x6d, 0xb6, 0xf1, 0xed, 0x22, 0xc8, 0x75, 0xee, 0x28, 0x8f, 0x61, 0x21, 0x7e, 0x1d,
+	0xa2, 0xc9, 0x69, 0x8d, 0x5e, 0x1d, 0xda, 0x9d, 0xd9, 0x98, 0x24, 0xec, 0x36, 0xe4, 0xa2, 0x0b,
+	0xff, 0xd6, 0x54, 0x4e, 0x08, 0xd1, 0xd6, 0x66, 0x42, 0xb2, 0x5e, 0xa3, 0xfb, 0x73, 0xba, 0xd7,
+	0x10, 0xa2, 0xad, 0xcd, 0x84, 0x24, 0x5e, 0x09, 0x14, 0xb3, 0x4f, 0xa3, 0xca, 0x54, 0x66, 0x06,
+	0xa9, 0xbd, 0x75, 0x5e, 0x64, 0x12, 0xaa, 0x05, 0x85, 0xf4, 0x72, 0xbc, 0x3d, 0x95, 0x9e, 0xe0,
+	0x34, 0xe3, 0x7c, 0xb8, 0x24, 0x88, 0x0b, 0xa5, 0xb1, 0x2b, 0x64, 0x6d, 0x16, 0x3f, 0x81, 0x6a,
+	0xeb, 0xe7, 0x86, 0x26, 0xd1, 0x9e, 0x40, 0x5e, 0x74, 0xd1, 0xd7, 0x67, 0x91, 0x1f, 0xf9, 0x44,
+	0xbb, 0x7b, 0x0e, 0x50, 0xe2, 0xfb, 0x73, 0x58, 0x4a, 0x3a, 0xe1, 0x1b, 0x53, 0x89, 0x23, 0x98,
+	0x76, 0xef, 0x5c, 0xb0, 0x24, 0xc2, 0xd7, 0x12, 0xac, 0x9c, 0xd9, 0x2b, 0x66, 0xae, 0xc4, 0x29,
+	0x8a, 0xf6, 0xde, 0xdf, 0xa6, 0x8c, 0x64, 0x68, 0x0b, 0x5f, 0xbe, 0x78, 0x76, 0x47, 0xaa, 0x6d,
+	0x1f, 0xfe, 0x51, 0x9e, 0x3b, 0x3c, 0x2a, 0x4b, 0xcf, 0x8f, 0xca, 0xd2, 0xef, 0x47, 0x65, 0xe9,
+	0xe0, 0xb8, 0x3c, 0xf7, 0xfc, 0xb8, 0x3c, 0xf7, 0xcb, 0x71, 0x79, 0xee, 0xc9, 0x3b, 0x0e, 0x09,
+	0x3a, 0xbd, 0xa6, 0xd1, 0x62, 0x9e, 0x29, 0x22, 0xb1, 0x9d, 0x1d, 0xd2, 0x22, 0xb6, 0x6b, 0x3a,
+	0xec, 0x9e, 0x30, 0x99, 0xfb, 0xe9, 0xff, 0xc2, 0xa0, 0xdf, 0xc5, 0xbc, 0x99, 0x8f, 0xfe, 0x85,
+	0xbc, 0xfd, 0xd7, 0x00, 0x97, 0x63, 0x8d, 0xf0, 0x03, 0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -705,6 +919,12 @@ type MsgClient interface {
 	SetMinter(ctx context.Context, in *MsgSetMinter, opts ...grpc.CallOption) (*MsgSetMinterResponse, error)
 	SetAuthority(ctx context.Context, in *MsgSetAuthority, opts ...grpc.CallOption) (*MsgSetAuthorityResponse, error)
 	SetUri(ctx context.Context, in *MsgSetUri, opts ...grpc.CallOption) (*MsgSetUriResponse, error)
+	// Clawback defines a method for burning or reclaiming some fan tokens from
+	// any holder, if enabled at issue time
+	Clawback(ctx context.Context, in *MsgClawback, opts ...grpc.CallOption) (*MsgClawbackResponse, error)
+	// SetIBCTransferPolicy defines a method for setting which IBC channels a fan
+	// token can be sent over
+	SetIBCTransferPolicy(ctx context.Context, in *MsgSetIBCTransferPolicy, opts ...grpc.CallOption) (*MsgSetIBCTransferPolicyResponse, error)
 }
 
 type msgClient struct {
@@ -778,6 +998,24 @@ func (c *msgClient) SetUri(ctx context.Context, in *MsgSetUri, opts ...grpc.Call
 	return out, nil
 }
 
+func (c *msgClient) Clawback(ctx context.Context, in *MsgClawback, opts ...grpc.CallOption) (*MsgClawbackResponse, error) {
+	out := new(MsgClawbackResponse)
+	err := c.cc.Invoke(ctx, "/bitsong.fantoken.v1beta1.Msg/Clawback", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetIBCTransferPolicy(ctx context.Context, in *MsgSetIBCTransferPolicy, opts ...grpc.CallOption) (*MsgSetIBCTransferPolicyResponse, error) {
+	out := new(MsgSetIBCTransferPolicyResponse)
+	err := c.cc.Invoke(ctx, "/bitsong.fantoken.v1beta1.Msg/SetIBCTransferPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Issue defines a method for issuing a new fan token
@@ -791,6 +1029,12 @@ type MsgServer interface {
 	SetMinter(context.Context, *MsgSetMinter) (*MsgSetMinterResponse, error)
 	SetAuthority(context.Context, *MsgSetAuthority) (*MsgSetAuthorityResponse, error)
 	SetUri(context.Context, *MsgSetUri) (*MsgSetUriResponse, error)
+	// Clawback defines a method for burning or reclaiming some fan tokens from
+	// any holder, if enabled at issue time
+	Clawback(context.Context, *MsgClawback) (*MsgClawbackResponse, error)
+	// SetIBCTransferPolicy defines a method for setting which IBC channels a fan
+	// token can be sent over
+	SetIBCTransferPolicy(context.Context, *MsgSetIBCTransferPolicy) (*MsgSetIBCTransferPolicyResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -818,6 +1062,12 @@ func (*UnimplementedMsgServer) SetAuthority(ctx context.Context, req *MsgSetAuth
 func (*UnimplementedMsgServer) SetUri(ctx context.Context, req *MsgSetUri) (*MsgSetUriResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetUri not implemented")
 }
+func (*UnimplementedMsgServer) Clawback(ctx context.Context, req *MsgClawback) (*MsgClawbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Clawback not implemented")
+}
+func (*UnimplementedMsgServer) SetIBCTransferPolicy(ctx context.Context, req *MsgSetIBCTransferPolicy) (*MsgSetIBCTransferPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetIBCTransferPolicy not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -949,6 +1199,42 @@ func _Msg_SetUri_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_Clawback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClawback)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Clawback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitsong.fantoken.v1beta1.Msg/Clawback",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Clawback(ctx, req.(*MsgClawback))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetIBCTransferPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetIBCTransferPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetIBCTransferPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitsong.fantoken.v1beta1.Msg/SetIBCTransferPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetIBCTransferPolicy(ctx, req.(*MsgSetIBCTransferPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "bitsong.fantoken.v1beta1.Msg",
@@ -982,6 +1268,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetUri",
 			Handler:    _Msg_SetUri_Handler,
 		},
+		{
+			MethodName: "Clawback",
+			Handler:    _Msg_Clawback_Handler,
+		},
+		{
+			MethodName: "SetIBCTransferPolicy",
+			Handler:    _Msg_SetIBCTransferPolicy_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "bitsong/fantoken/v1beta1/tx.proto",
@@ -1007,6 +1301,31 @@ func (m *MsgIssue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExpiryTime != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpiryTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpiryTime):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintTx(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ClawbackEnabled {
+		i--
+		if m.ClawbackEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
 	if len(m.URI) > 0 {
 		i -= len(m.URI)
 		copy(dAtA[i:], m.URI)
@@ -1569,92 +1888,284 @@ func (m *MsgSetUriResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgClawback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgIssue) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgClawback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClawback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Symbol)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Coin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = m.MaxSupply.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Minter)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.URI)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgIssueResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgClawbackResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgDisableMint) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Minter)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgClawbackResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDisableMintResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgClawbackResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
-}
-
-func (m *MsgMint) Size() (n int) {
+	{
+		size, err := m.Coin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetIBCTransferPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetIBCTransferPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetIBCTransferPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedChannels) > 0 {
+		for iNdEx := len(m.AllowedChannels) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedChannels[iNdEx])
+			copy(dAtA[i:], m.AllowedChannels[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.AllowedChannels[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Policy != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Policy))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetIBCTransferPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetIBCTransferPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetIBCTransferPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgIssue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.MaxSupply.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Minter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.URI)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ClawbackEnabled {
+		n += 2
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovTx(uint64(m.ExpiryHeight))
+	}
+	if m.ExpiryTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpiryTime)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgIssueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDisableMint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Minter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDisableMintResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgMint) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1836,6 +2347,87 @@ func (m *MsgSetUriResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgClawback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Coin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgClawbackResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Coin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetIBCTransferPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Policy != 0 {
+		n += 1 + sovTx(uint64(m.Policy))
+	}
+	if len(m.AllowedChannels) > 0 {
+		for _, s := range m.AllowedChannels {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetIBCTransferPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2003,9 +2595,508 @@ func (m *MsgIssue) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Minter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Minter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URI", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URI = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClawbackEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ClawbackEnabled = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiryTime == nil {
+				m.ExpiryTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpiryTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgIssueResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgIssueResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgIssueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDisableMint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDisableMint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDisableMint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDisableMintResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDisableMintResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDisableMintResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2015,27 +3106,28 @@ func (m *MsgIssue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Minter = string(dAtA[iNdEx:postIndex])
+			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URI", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Minter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2063,7 +3155,7 @@ func (m *MsgIssue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URI = string(dAtA[iNdEx:postIndex])
+			m.Minter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2086,7 +3178,7 @@ func (m *MsgIssue) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgIssueResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgMintResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2109,15 +3201,15 @@ func (m *MsgIssueResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgIssueResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMintResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgIssueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMintResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2145,7 +3237,40 @@ func (m *MsgIssueResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2168,7 +3293,7 @@ func (m *MsgIssueResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDisableMint) Unmarshal(dAtA []byte) error {
+func (m *MsgBurn) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2191,17 +3316,17 @@ func (m *MsgDisableMint) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDisableMint: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBurn: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDisableMint: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBurn: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2211,27 +3336,28 @@ func (m *MsgDisableMint) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Minter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2259,7 +3385,7 @@ func (m *MsgDisableMint) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Minter = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2282,7 +3408,7 @@ func (m *MsgDisableMint) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDisableMintResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgBurnResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2305,15 +3431,15 @@ func (m *MsgDisableMintResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDisableMintResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBurnResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDisableMintResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBurnResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2341,7 +3467,40 @@ func (m *MsgDisableMintResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2364,7 +3523,7 @@ func (m *MsgDisableMintResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMint) Unmarshal(dAtA []byte) error {
+func (m *MsgSetMinter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2387,15 +3546,15 @@ func (m *MsgMint) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMint: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetMinter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMint: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetMinter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2423,13 +3582,13 @@ func (m *MsgMint) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldMinter", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2439,28 +3598,27 @@ func (m *MsgMint) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.OldMinter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Minter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewMinter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2488,7 +3646,7 @@ func (m *MsgMint) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Minter = string(dAtA[iNdEx:postIndex])
+			m.NewMinter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2511,7 +3669,7 @@ func (m *MsgMint) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMintResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetMinterResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2534,15 +3692,15 @@ func (m *MsgMintResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMintResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetMinterResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMintResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetMinterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2570,13 +3728,13 @@ func (m *MsgMintResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldMinter", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2586,24 +3744,55 @@ func (m *MsgMintResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.OldMinter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewMinter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.NewMinter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2626,7 +3815,7 @@ func (m *MsgMintResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBurn) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAuthority) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2649,17 +3838,49 @@ func (m *MsgBurn) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBurn: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAuthority: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBurn: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAuthority: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldAuthority", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2669,28 +3890,27 @@ func (m *MsgBurn) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.OldAuthority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewAuthority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2718,7 +3938,7 @@ func (m *MsgBurn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.NewAuthority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2741,7 +3961,7 @@ func (m *MsgBurn) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBurnResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAuthorityResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2764,15 +3984,15 @@ func (m *MsgBurnResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBurnResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAuthorityResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBurnResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAuthorityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2800,13 +4020,13 @@ func (m *MsgBurnResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldAuthority", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2816,24 +4036,55 @@ func (m *MsgBurnResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.OldAuthority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewAuthority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.NewAuthority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2856,7 +4107,7 @@ func (m *MsgBurnResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetMinter) Unmarshal(dAtA []byte) error {
+func (m *MsgSetUri) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2879,15 +4130,15 @@ func (m *MsgSetMinter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetMinter: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetUri: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetMinter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetUri: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2915,11 +4166,11 @@ func (m *MsgSetMinter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OldMinter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2947,11 +4198,11 @@ func (m *MsgSetMinter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OldMinter = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewMinter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URI", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2979,7 +4230,7 @@ func (m *MsgSetMinter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewMinter = string(dAtA[iNdEx:postIndex])
+			m.URI = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3002,7 +4253,7 @@ func (m *MsgSetMinter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetMinterResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetUriResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3025,10 +4276,10 @@ func (m *MsgSetMinterResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetMinterResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetUriResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetMinterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetUriResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3063,70 +4314,6 @@ func (m *MsgSetMinterResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OldMinter", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OldMinter = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewMinter", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NewMinter = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3148,7 +4335,7 @@ func (m *MsgSetMinterResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetAuthority) Unmarshal(dAtA []byte) error {
+func (m *MsgClawback) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3171,15 +4358,15 @@ func (m *MsgSetAuthority) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetAuthority: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClawback: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetAuthority: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClawback: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3207,11 +4394,11 @@ func (m *MsgSetAuthority) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OldAuthority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3239,11 +4426,11 @@ func (m *MsgSetAuthority) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OldAuthority = string(dAtA[iNdEx:postIndex])
+			m.Holder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewAuthority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3271,7 +4458,40 @@ func (m *MsgSetAuthority) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewAuthority = string(dAtA[iNdEx:postIndex])
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3294,7 +4514,7 @@ func (m *MsgSetAuthority) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetAuthorityResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgClawbackResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3317,15 +4537,15 @@ func (m *MsgSetAuthorityResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetAuthorityResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClawbackResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetAuthorityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClawbackResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3353,11 +4573,11 @@ func (m *MsgSetAuthorityResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Holder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OldAuthority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3385,13 +4605,13 @@ func (m *MsgSetAuthorityResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OldAuthority = string(dAtA[iNdEx:postIndex])
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewAuthority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3401,23 +4621,24 @@ func (m *MsgSetAuthorityResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewAuthority = string(dAtA[iNdEx:postIndex])
+			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3440,7 +4661,7 @@ func (m *MsgSetAuthorityResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetUri) Unmarshal(dAtA []byte) error {
+func (m *MsgSetIBCTransferPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3463,10 +4684,10 @@ func (m *MsgSetUri) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetUri: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetIBCTransferPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetUri: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetIBCTransferPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3534,8 +4755,27 @@ func (m *MsgSetUri) Unmarshal(dAtA []byte) error {
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			m.Policy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Policy |= IBCTransferPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URI", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedChannels", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3563,7 +4803,7 @@ func (m *MsgSetUri) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URI = string(dAtA[iNdEx:postIndex])
+			m.AllowedChannels = append(m.AllowedChannels, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3586,7 +4826,7 @@ func (m *MsgSetUri) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetUriResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetIBCTransferPolicyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3609,10 +4849,10 @@ func (m *MsgSetUriResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetUriResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetIBCTransferPolicyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetUriResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetIBCTransferPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
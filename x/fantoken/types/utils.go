@@ -7,7 +7,10 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// DenomPrefix is the prefix of the fan token denoms
+const DenomPrefix = "ft"
+
 func GetFantokenDenom(height int64, minter sdk.AccAddress, symbol, name string) string {
 	bz := []byte(fmt.Sprintf("%d%s%s%s", height, minter.String(), symbol, name))
-	return "ft" + tmcrypto.AddressHash(bz).String()
+	return DenomPrefix + tmcrypto.AddressHash(bz).String()
 }
@@ -8,6 +8,7 @@ import (
 	"cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 )
 
 const (
@@ -32,7 +33,7 @@ var (
 
 // ValidateDenom checks if the given denom is valid
 func ValidateDenom(denom string) error {
-	if !strings.HasPrefix(denom, "ft") {
+	if !strings.HasPrefix(denom, DenomPrefix) {
 		return errors.Wrapf(ErrInvalidDenom, "invalid denom: %s, denom starts with ft", denom)
 	}
 
@@ -93,3 +94,31 @@ func ValidateFees(issueFee, mintFee, burnFee sdk.Coin) error {
 
 	return nil
 }
+
+// ValidateIBCTransferPolicy checks if the given IBC transfer policy is valid
+func ValidateIBCTransferPolicy(policy IBCTransferPolicy, channels []string) error {
+	if _, ok := IBCTransferPolicy_name[int32(policy)]; !ok {
+		return errors.Wrapf(ErrInvalidIBCTransferPolicy, "unknown policy %d", policy)
+	}
+
+	if policy != IBCTransferPolicyAllowlist {
+		if len(channels) > 0 {
+			return errors.Wrapf(ErrInvalidIBCTransferPolicy, "the allowed channels must be empty for the %s policy", policy)
+		}
+		return nil
+	}
+
+	seen := make(map[string]bool, len(channels))
+	for _, channel := range channels {
+		if err := host.ChannelIdentifierValidator(channel); err != nil {
+			return errors.Wrapf(ErrInvalidIBCTransferPolicy, "invalid channel %s (%s)", channel, err)
+		}
+
+		if seen[channel] {
+			return errors.Wrapf(ErrInvalidIBCTransferPolicy, "duplicated channel %s", channel)
+		}
+		seen[channel] = true
+	}
+
+	return nil
+}
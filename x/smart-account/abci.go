@@ -0,0 +1,18 @@
+package authenticator
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bitsongofficial/go-bitsong/x/smart-account/keeper"
+	"github.com/bitsongofficial/go-bitsong/x/smart-account/types"
+)
+
+// EndBlocker removes the authenticators whose expiry has passed from their accounts.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	k.RemoveExpiredAuthenticators(ctx)
+}
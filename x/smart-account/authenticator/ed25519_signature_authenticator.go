@@ -0,0 +1,98 @@
+package authenticator
+
+import (
+	"fmt"
+
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Compile time type assertion for the SignatureData using the
+// Ed25519SignatureVerification struct
+var _ Authenticator = &Ed25519SignatureVerification{}
+
+const (
+	// Ed25519SignatureVerificationType represents a type of authenticator specifically designed for
+	// ed25519 signature verification.
+	Ed25519SignatureVerificationType = "Ed25519SignatureVerification"
+)
+
+// ed25519 signature authenticator
+type Ed25519SignatureVerification struct {
+	ak     authante.AccountKeeper
+	PubKey cryptotypes.PubKey
+}
+
+func (esva Ed25519SignatureVerification) Type() string {
+	return Ed25519SignatureVerificationType
+}
+
+func (esva Ed25519SignatureVerification) StaticGas() uint64 {
+	// using 0 gas here. The gas is consumed in Authenticate()
+	return 0
+}
+
+// NewEd25519SignatureVerification creates a new Ed25519SignatureVerification
+func NewEd25519SignatureVerification(ak authante.AccountKeeper) Ed25519SignatureVerification {
+	return Ed25519SignatureVerification{ak: ak}
+}
+
+// Initialize sets up the public key to the data supplied from the account-authenticator configuration
+func (esva Ed25519SignatureVerification) Initialize(config []byte) (Authenticator, error) {
+	if len(config) != ed25519.PubKeySize {
+		return nil, fmt.Errorf("invalid ed25519 public key size, expected %d, got %d", ed25519.PubKeySize, len(config))
+	}
+	esva.PubKey = &ed25519.PubKey{Key: config}
+	return esva, nil
+}
+
+// Authenticate validates the ed25519 signature of the signer over the sign bytes of the transaction
+func (esva Ed25519SignatureVerification) Authenticate(ctx sdk.Context, request AuthenticationRequest) error {
+	// First consume gas for verifying the signature
+	params := esva.ak.GetParams(ctx)
+	ctx.GasMeter().ConsumeGas(params.SigVerifyCostED25519, "ed25519 signature verification")
+	// after gas consumption continue to verify signatures
+
+	if request.Simulate || ctx.IsReCheckTx() {
+		return nil
+	}
+	if esva.PubKey == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "pubkey not set on authenticator")
+	}
+
+	if !esva.PubKey.VerifySignature(request.SignModeTxData.Direct, request.Signature) {
+		return errorsmod.Wrapf(
+			sdkerrors.ErrUnauthorized,
+			"ed25519 signature verification failed; please verify account number (%d), sequence (%d) and chain-id (%s)",
+			request.TxData.AccountNumber,
+			request.TxData.AccountSequence,
+			request.TxData.ChainID,
+		)
+	}
+	return nil
+}
+
+func (esva Ed25519SignatureVerification) Track(ctx sdk.Context, request AuthenticationRequest) error {
+	return nil
+}
+
+func (esva Ed25519SignatureVerification) ConfirmExecution(ctx sdk.Context, request AuthenticationRequest) error {
+	return nil
+}
+
+func (esva Ed25519SignatureVerification) OnAuthenticatorAdded(ctx sdk.Context, account sdk.AccAddress, config []byte, authenticatorId string) error {
+	if len(config) != ed25519.PubKeySize {
+		return fmt.Errorf("invalid ed25519 public key size, expected %d, got %d", ed25519.PubKeySize, len(config))
+	}
+	return nil
+}
+
+func (esva Ed25519SignatureVerification) OnAuthenticatorRemoved(ctx sdk.Context, account sdk.AccAddress, config []byte, authenticatorId string) error {
+	return nil
+}
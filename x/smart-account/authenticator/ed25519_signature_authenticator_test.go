@@ -0,0 +1,152 @@
+package authenticator_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/bitsongofficial/go-bitsong/app"
+	"github.com/bitsongofficial/go-bitsong/x/smart-account/authenticator"
+)
+
+type Ed25519SigVerifyAuthenticationSuite struct {
+	BaseAuthenticatorSuite
+
+	Ed25519Authenticator authenticator.Ed25519SignatureVerification
+	Ed25519PrivKeys      []cryptotypes.PrivKey
+}
+
+func TestEd25519SigVerifyAuthenticationSuite(t *testing.T) {
+	suite.Run(t, new(Ed25519SigVerifyAuthenticationSuite))
+}
+
+func (s *Ed25519SigVerifyAuthenticationSuite) SetupTest() {
+	s.SetupKeys()
+
+	s.EncodingConfig = app.MakeEncodingConfig()
+	ak := s.BitsongApp.AppKeepers.AccountKeeper
+
+	// Create a new Ed25519SignatureVerification authenticator for testing
+	s.Ed25519Authenticator = authenticator.NewEd25519SignatureVerification(ak)
+
+	s.Ed25519PrivKeys = []cryptotypes.PrivKey{
+		ed25519.GenPrivKeyFromSecret([]byte("ed25519 test key 1")),
+		ed25519.GenPrivKeyFromSecret([]byte("ed25519 test key 2")),
+	}
+}
+
+func (s *Ed25519SigVerifyAuthenticationSuite) TearDownTest() {
+	os.RemoveAll(s.HomeDir)
+}
+
+// TestEd25519SignatureAuthenticator tests the verification of ed25519 signatures
+func (s *Ed25519SigVerifyAuthenticationSuite) TestEd25519SignatureAuthenticator() {
+	bitsongToken := "bitsong"
+	coins := sdk.Coins{sdk.NewInt64Coin(bitsongToken, 2500)}
+	feeCoins := sdk.Coins{sdk.NewInt64Coin(bitsongToken, 2500)}
+
+	signerAddr := sdk.AccAddress(s.Ed25519PrivKeys[0].PubKey().Address())
+	testMsg := &banktypes.MsgSend{
+		FromAddress: sdk.MustBech32ifyAddressBytes(bitsongToken, signerAddr),
+		ToAddress:   sdk.MustBech32ifyAddressBytes(bitsongToken, s.TestAccAddress[1]),
+		Amount:      coins,
+	}
+
+	tests := []struct {
+		Description   string
+		Signature     cryptotypes.PrivKey
+		PubKey        cryptotypes.PubKey
+		ShouldSucceed bool
+	}{
+		{
+			Description:   "Test: successfully verified ed25519 signature: PASS",
+			Signature:     s.Ed25519PrivKeys[0],
+			PubKey:        s.Ed25519PrivKeys[0].PubKey(),
+			ShouldSucceed: true,
+		},
+		{
+			Description:   "Test: signature of another ed25519 key: FAIL",
+			Signature:     s.Ed25519PrivKeys[1],
+			PubKey:        s.Ed25519PrivKeys[0].PubKey(),
+			ShouldSucceed: false,
+		},
+		{
+			Description:   "Test: signature verified against another ed25519 key: FAIL",
+			Signature:     s.Ed25519PrivKeys[0],
+			PubKey:        s.Ed25519PrivKeys[1].PubKey(),
+			ShouldSucceed: false,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.Description, func() {
+			// Generate a transaction based on the test cases
+			tx, err := GenTx(
+				s.Ctx,
+				s.EncodingConfig.TxConfig,
+				[]sdk.Msg{testMsg},
+				feeCoins,
+				300000,
+				"",
+				[]uint64{0},
+				[]uint64{0},
+				[]cryptotypes.PrivKey{s.Ed25519PrivKeys[0]},
+				[]cryptotypes.PrivKey{tc.Signature},
+			)
+			s.Require().NoError(err)
+
+			ak := s.BitsongApp.AppKeepers.AccountKeeper
+			sigModeHandler := s.EncodingConfig.TxConfig.SignModeHandler()
+
+			request, err := authenticator.GenerateAuthenticationRequest(s.Ctx, s.BitsongApp.AppCodec(), ak, sigModeHandler, signerAddr, signerAddr, nil, sdk.NewCoins(), testMsg, tx, 0, false, authenticator.SequenceMatch)
+			s.Require().NoError(err)
+
+			initialized, err := s.Ed25519Authenticator.Initialize(tc.PubKey.Bytes())
+			s.Require().NoError(err)
+
+			// Ensure the ed25519 gas cost of the auth params is consumed
+			gasBefore := s.Ctx.GasMeter().GasConsumed()
+			err = initialized.Authenticate(s.Ctx, request)
+			s.Require().GreaterOrEqual(s.Ctx.GasMeter().GasConsumed()-gasBefore, ak.GetParams(s.Ctx).SigVerifyCostED25519)
+
+			if tc.ShouldSucceed {
+				s.Require().NoError(err)
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
+
+// TestEd25519PublicKeySize tests that only ed25519 public keys are accepted
+func (s *Ed25519SigVerifyAuthenticationSuite) TestEd25519PublicKeySize() {
+	tests := []struct {
+		Description string
+		Config      []byte
+		Valid       bool
+	}{
+		{"ed25519 public key", s.Ed25519PrivKeys[0].PubKey().Bytes(), true},
+		{"secp256k1 public key", s.TestPrivKeys[0].PubKey().Bytes(), false},
+		{"empty public key", []byte{}, false},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.Description, func() {
+			err := s.Ed25519Authenticator.OnAuthenticatorAdded(s.Ctx, s.TestAccAddress[0], tc.Config, "1")
+			_, initErr := s.Ed25519Authenticator.Initialize(tc.Config)
+			if tc.Valid {
+				s.Require().NoError(err)
+				s.Require().NoError(initErr)
+			} else {
+				s.Require().Error(err)
+				s.Require().Error(initErr)
+			}
+		})
+	}
+}
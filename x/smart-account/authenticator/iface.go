@@ -1,6 +1,8 @@
 package authenticator
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -47,3 +49,12 @@ type Authenticator interface {
 	// This function is used for updating global data or preventing removal when necessary to maintain system stability.
 	OnAuthenticatorRemoved(ctx sdk.Context, account sdk.AccAddress, config []byte, authenticatorId string) error
 }
+
+// ExpiringAuthenticator is implemented by the authenticators which are only valid until a block time.
+// The keeper indexes them by expiry when they are added, and removes them from the account once
+// their expiry has passed.
+type ExpiringAuthenticator interface {
+	// Expiry returns the block time after which the authenticator with the given configuration is no
+	// longer valid.
+	Expiry(config []byte) (time.Time, error)
+}
@@ -0,0 +1,329 @@
+package authenticator
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/bitsongofficial/go-bitsong/x/smart-account/types"
+)
+
+// SpendLimitType represents a type of authenticator limiting the amounts an account spends over a
+// period.
+const SpendLimitType = "SpendLimit"
+
+// SpendLimitPeriod is the length of the rolling period over which the spendings of an account are
+// limited.
+type SpendLimitPeriod string
+
+const (
+	SpendLimitPeriodDay   SpendLimitPeriod = "day"
+	SpendLimitPeriodWeek  SpendLimitPeriod = "week"
+	SpendLimitPeriodMonth SpendLimitPeriod = "month"
+)
+
+// End returns the time at which a spending made at the given time leaves the rolling period.
+func (p SpendLimitPeriod) End(start time.Time) time.Time {
+	switch p {
+	case SpendLimitPeriodWeek:
+		return start.AddDate(0, 0, 7)
+	case SpendLimitPeriodMonth:
+		return start.AddDate(0, 1, 0)
+	default:
+		return start.AddDate(0, 0, 1)
+	}
+}
+
+// Validate ensures the period is a day, a week or a month.
+func (p SpendLimitPeriod) Validate() error {
+	switch p {
+	case SpendLimitPeriodDay, SpendLimitPeriodWeek, SpendLimitPeriodMonth:
+		return nil
+	default:
+		return fmt.Errorf("invalid spend limit period %q, expected day, week or month", p)
+	}
+}
+
+// SpendLimitConfig is the configuration of a spend limit authenticator.
+type SpendLimitConfig struct {
+	Limits sdk.Coins        `json:"limits"`
+	Period SpendLimitPeriod `json:"period"`
+}
+
+// SpendLimitEntry is an amount spent at a given time.
+type SpendLimitEntry struct {
+	Time   time.Time `json:"time"`
+	Amount sdk.Coins `json:"amount"`
+}
+
+// SpendLimitUsage is the usage of a spend limit authenticator: the entries spent in the rolling
+// period, from the oldest to the newest.
+type SpendLimitUsage struct {
+	Limits  sdk.Coins         `json:"limits"`
+	Period  SpendLimitPeriod  `json:"period"`
+	Entries []SpendLimitEntry `json:"entries"`
+}
+
+// Spent returns the sum of the entries.
+func (u SpendLimitUsage) Spent() sdk.Coins {
+	var spent sdk.Coins
+	for _, entry := range u.Entries {
+		spent = spent.Add(entry.Amount...)
+	}
+	return spent
+}
+
+// Prune removes the entries which left the rolling period ending at the given time.
+func (u SpendLimitUsage) Prune(now time.Time) SpendLimitUsage {
+	var entries []SpendLimitEntry
+	for _, entry := range u.Entries {
+		if now.Before(u.Period.End(entry.Time)) {
+			entries = append(entries, entry)
+		}
+	}
+	u.Entries = entries
+	return u
+}
+
+// NextRelease returns the time at which the oldest entry leaves the rolling period, and false when
+// there is no entry.
+func (u SpendLimitUsage) NextRelease() (time.Time, bool) {
+	if len(u.Entries) == 0 {
+		return time.Time{}, false
+	}
+	return u.Period.End(u.Entries[0].Time), true
+}
+
+// Remaining returns the amounts which can still be spent in the rolling period.
+func (u SpendLimitUsage) Remaining() sdk.Coins {
+	remaining, _ := u.Limits.SafeSub(u.Spent()...)
+	var positive sdk.Coins
+	for _, coin := range remaining {
+		if coin.IsPositive() {
+			positive = append(positive, coin)
+		}
+	}
+	return positive
+}
+
+// SpendLimit limits the net amounts of the limited denoms an account spends over a period of a day,
+// a week or a month. It does not verify any signature and is meant to be composed with a signature
+// authenticator, e.g. in an AllOf.
+//
+// The balances of the account are snapshotted in Track, before the messages are executed, and the
+// decrease of the balances is recorded with the block time in ConfirmExecution, which fails when the
+// amounts spent in the rolling period ending at the block time exceed a limit. An amount spent counts
+// for a whole period after it is spent, so the limits cannot be spent twice across a boundary.
+type SpendLimit struct {
+	storeKey   storetypes.StoreKey
+	bankKeeper types.BankKeeper
+
+	limits sdk.Coins
+	period SpendLimitPeriod
+}
+
+var _ Authenticator = &SpendLimit{}
+
+// NewSpendLimit creates a new SpendLimit authenticator
+func NewSpendLimit(storeKey storetypes.StoreKey, bankKeeper types.BankKeeper) SpendLimit {
+	return SpendLimit{
+		storeKey:   storeKey,
+		bankKeeper: bankKeeper,
+	}
+}
+
+func (sl SpendLimit) Type() string {
+	return SpendLimitType
+}
+
+func (sl SpendLimit) StaticGas() uint64 {
+	return 0
+}
+
+// Initialize sets up the limits and the period from the JSON configuration.
+func (sl SpendLimit) Initialize(config []byte) (Authenticator, error) {
+	cfg, err := parseSpendLimitConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	sl.limits = cfg.Limits
+	sl.period = cfg.Period
+	return sl, nil
+}
+
+// Authenticate does not restrict the messages, the spendings are checked once they are executed.
+func (sl SpendLimit) Authenticate(ctx sdk.Context, request AuthenticationRequest) error {
+	return nil
+}
+
+// Track snapshots the balances of the limited denoms before the messages are executed.
+func (sl SpendLimit) Track(ctx sdk.Context, request AuthenticationRequest) error {
+	return sl.setSnapshot(ctx, request.Account, request.AuthenticatorId, sl.balances(ctx, request.Account))
+}
+
+// ConfirmExecution records the decrease of the balances since the snapshot in the usage, and fails if
+// a limit is exceeded in the rolling period. The snapshot is then moved to the current balances, so
+// that the other messages of the transaction confirmed by the same authenticator are not counted
+// twice.
+func (sl SpendLimit) ConfirmExecution(ctx sdk.Context, request AuthenticationRequest) error {
+	snapshot, found, err := sl.getSnapshot(ctx, request.Account, request.AuthenticatorId)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "no balances snapshot for spend limit (authenticator id = %s)", request.AuthenticatorId)
+	}
+
+	balances := sl.balances(ctx, request.Account)
+	var spent sdk.Coins
+	for _, limit := range sl.limits {
+		before := snapshot.AmountOf(limit.Denom)
+		after := balances.AmountOf(limit.Denom)
+		if before.GT(after) {
+			spent = spent.Add(sdk.NewCoin(limit.Denom, before.Sub(after)))
+		}
+	}
+
+	usage, err := sl.GetUsage(ctx, request.Account, request.AuthenticatorId)
+	if err != nil {
+		return err
+	}
+	if !spent.IsZero() {
+		// Merge the spendings of the same block in a single entry
+		if n := len(usage.Entries); n > 0 && usage.Entries[n-1].Time.Equal(ctx.BlockTime()) {
+			usage.Entries[n-1].Amount = usage.Entries[n-1].Amount.Add(spent...)
+		} else {
+			usage.Entries = append(usage.Entries, SpendLimitEntry{Time: ctx.BlockTime(), Amount: spent})
+		}
+	}
+	if total := usage.Spent(); !total.IsAllLTE(sl.limits) {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "spend limit exceeded: spent %s of %s per %s", total, sl.limits, sl.period)
+	}
+
+	if err := sl.SetUsage(ctx, request.Account, request.AuthenticatorId, usage); err != nil {
+		return err
+	}
+	return sl.setSnapshot(ctx, request.Account, request.AuthenticatorId, balances)
+}
+
+// OnAuthenticatorAdded ensures the limits are valid and positive, and the period is a day, a week or
+// a month, then stores an empty usage for the authenticator.
+func (sl SpendLimit) OnAuthenticatorAdded(ctx sdk.Context, account sdk.AccAddress, config []byte, authenticatorId string) error {
+	cfg, err := parseSpendLimitConfig(config)
+	if err != nil {
+		return err
+	}
+
+	return sl.SetUsage(ctx, account, authenticatorId, SpendLimitUsage{
+		Limits: cfg.Limits,
+		Period: cfg.Period,
+	})
+}
+
+// OnAuthenticatorRemoved deletes the usage and the snapshot of the authenticator.
+func (sl SpendLimit) OnAuthenticatorRemoved(ctx sdk.Context, account sdk.AccAddress, config []byte, authenticatorId string) error {
+	store := ctx.KVStore(sl.storeKey)
+	store.Delete(types.KeySpendLimitUsage(account, authenticatorId))
+	store.Delete(types.KeySpendLimitSnapshot(account, authenticatorId))
+	return nil
+}
+
+// GetUsage returns the usage of the authenticator in the rolling period ending at the block time,
+// without the entries which left it.
+func (sl SpendLimit) GetUsage(ctx sdk.Context, account sdk.AccAddress, authenticatorId string) (SpendLimitUsage, error) {
+	stored, _, err := sl.GetStoredUsage(ctx, account, authenticatorId)
+	if err != nil {
+		return SpendLimitUsage{}, err
+	}
+
+	usage := SpendLimitUsage{
+		Limits:  sl.limits,
+		Period:  sl.period,
+		Entries: stored.Entries,
+	}
+	return usage.Prune(ctx.BlockTime()), nil
+}
+
+// GetStoredUsage returns the stored usage of an authenticator, which may hold entries which left the
+// rolling period.
+func (sl SpendLimit) GetStoredUsage(ctx sdk.Context, account sdk.AccAddress, authenticatorId string) (SpendLimitUsage, bool, error) {
+	bz := ctx.KVStore(sl.storeKey).Get(types.KeySpendLimitUsage(account, authenticatorId))
+	if bz == nil {
+		return SpendLimitUsage{}, false, nil
+	}
+
+	var usage SpendLimitUsage
+	if err := json.Unmarshal(bz, &usage); err != nil {
+		return SpendLimitUsage{}, false, errorsmod.Wrap(err, "failed to unmarshal spend limit usage")
+	}
+	return usage, true, nil
+}
+
+// SetUsage stores the usage of an authenticator, e.g. when it is imported from genesis.
+func (sl SpendLimit) SetUsage(ctx sdk.Context, account sdk.AccAddress, authenticatorId string, usage SpendLimitUsage) error {
+	bz, err := json.Marshal(usage)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(sl.storeKey).Set(types.KeySpendLimitUsage(account, authenticatorId), bz)
+	return nil
+}
+
+func (sl SpendLimit) getSnapshot(ctx sdk.Context, account sdk.AccAddress, authenticatorId string) (sdk.Coins, bool, error) {
+	bz := ctx.KVStore(sl.storeKey).Get(types.KeySpendLimitSnapshot(account, authenticatorId))
+	if bz == nil {
+		return nil, false, nil
+	}
+
+	var snapshot sdk.Coins
+	if err := json.Unmarshal(bz, &snapshot); err != nil {
+		return nil, false, errorsmod.Wrap(err, "failed to unmarshal spend limit snapshot")
+	}
+	return snapshot, true, nil
+}
+
+func (sl SpendLimit) setSnapshot(ctx sdk.Context, account sdk.AccAddress, authenticatorId string, balances sdk.Coins) error {
+	bz, err := json.Marshal(balances)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(sl.storeKey).Set(types.KeySpendLimitSnapshot(account, authenticatorId), bz)
+	return nil
+}
+
+// balances returns the balances of the account in the limited denoms
+func (sl SpendLimit) balances(ctx sdk.Context, account sdk.AccAddress) sdk.Coins {
+	var balances sdk.Coins
+	for _, limit := range sl.limits {
+		balances = balances.Add(sl.bankKeeper.GetBalance(ctx, account, limit.Denom))
+	}
+	return balances
+}
+
+// parseSpendLimitConfig parses the JSON configuration of a spend limit authenticator.
+func parseSpendLimitConfig(config []byte) (SpendLimitConfig, error) {
+	var cfg SpendLimitConfig
+	if err := json.Unmarshal(config, &cfg); err != nil {
+		return SpendLimitConfig{}, errorsmod.Wrap(err, "invalid spend limit config")
+	}
+
+	if cfg.Limits.Empty() {
+		return SpendLimitConfig{}, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "invalid spend limit config: no limits")
+	}
+	cfg.Limits = cfg.Limits.Sort()
+	if err := cfg.Limits.Validate(); err != nil {
+		return SpendLimitConfig{}, errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid spend limit config: %s", err)
+	}
+	if err := cfg.Period.Validate(); err != nil {
+		return SpendLimitConfig{}, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return cfg, nil
+}
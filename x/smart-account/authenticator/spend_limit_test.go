@@ -0,0 +1,274 @@
+package authenticator_test
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/bitsongofficial/go-bitsong/x/smart-account/authenticator"
+	smartaccounttypes "github.com/bitsongofficial/go-bitsong/x/smart-account/types"
+)
+
+type SpendLimitTest struct {
+	BaseAuthenticatorSuite
+
+	SpendLimit authenticator.SpendLimit
+}
+
+func TestSpendLimitTest(t *testing.T) {
+	suite.Run(t, new(SpendLimitTest))
+}
+
+func (s *SpendLimitTest) SetupTest() {
+	s.SetupKeys()
+	s.Ctx = s.Ctx.WithBlockTime(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	s.SpendLimit = authenticator.NewSpendLimit(
+		s.BitsongApp.AppKeepers.GetKVStoreKey()[smartaccounttypes.StoreKey],
+		s.BitsongApp.AppKeepers.BankKeeper,
+	)
+
+	err := testutil.FundAccount(s.Ctx, s.BitsongApp.AppKeepers.BankKeeper, s.TestAccAddress[0], sdk.NewCoins(
+		sdk.NewInt64Coin("ubtsg", 1_000_000),
+		sdk.NewInt64Coin("uclay", 1_000_000),
+	))
+	s.Require().NoError(err)
+}
+
+func (s *SpendLimitTest) TearDownTest() {
+	os.RemoveAll(s.HomeDir)
+}
+
+func (s *SpendLimitTest) spendLimitConfig(period authenticator.SpendLimitPeriod, limits ...sdk.Coin) []byte {
+	bz, err := json.Marshal(authenticator.SpendLimitConfig{Limits: limits, Period: period})
+	s.Require().NoError(err)
+	return bz
+}
+
+// addSpendLimit adds a spend limit authenticator to the first test account and initializes it
+func (s *SpendLimitTest) addSpendLimit(id string, period authenticator.SpendLimitPeriod, limits ...sdk.Coin) authenticator.Authenticator {
+	config := s.spendLimitConfig(period, limits...)
+	s.Require().NoError(s.SpendLimit.OnAuthenticatorAdded(s.Ctx, s.TestAccAddress[0], config, id))
+
+	auth, err := s.SpendLimit.Initialize(config)
+	s.Require().NoError(err)
+	return auth
+}
+
+// execute tracks the authenticator, sends the given coins from the first test account, then confirms
+// the execution
+func (s *SpendLimitTest) execute(auth authenticator.Authenticator, id string, amount sdk.Coins) error {
+	request := authenticator.AuthenticationRequest{AuthenticatorId: id, Account: s.TestAccAddress[0]}
+	s.Require().NoError(auth.Authenticate(s.Ctx, request))
+	s.Require().NoError(auth.Track(s.Ctx, request))
+
+	err := s.BitsongApp.AppKeepers.BankKeeper.SendCoins(s.Ctx, s.TestAccAddress[0], s.TestAccAddress[1], amount)
+	s.Require().NoError(err)
+
+	return auth.ConfirmExecution(s.Ctx, request)
+}
+
+func (s *SpendLimitTest) TestSpendLimit() {
+	auth := s.addSpendLimit("1", authenticator.SpendLimitPeriodDay, sdk.NewInt64Coin("ubtsg", 1000))
+
+	// Spendings are accumulated over the period
+	s.Require().NoError(s.execute(auth, "1", sdk.NewCoins(sdk.NewInt64Coin("ubtsg", 600))))
+	s.Require().NoError(s.execute(auth, "1", sdk.NewCoins(sdk.NewInt64Coin("ubtsg", 400))))
+	s.Require().ErrorContains(s.execute(auth, "1", sdk.NewCoins(sdk.NewInt64Coin("ubtsg", 1))), "spend limit exceeded")
+
+	// Denoms without a limit are not restricted
+	s.Require().NoError(s.execute(auth, "1", sdk.NewCoins(sdk.NewInt64Coin("uclay", 100_000))))
+
+	// The spendings are available again once they left the period
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(23 * time.Hour))
+	s.Require().Error(s.execute(auth, "1", sdk.NewCoins(sdk.NewInt64Coin("ubtsg", 1))))
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Hour))
+	s.Require().NoError(s.execute(auth, "1", sdk.NewCoins(sdk.NewInt64Coin("ubtsg", 1000))))
+}
+
+// TestRollingPeriod ensures the limit applies to any period ending at the block time, so that it
+// cannot be spent twice across the end of a period.
+func (s *SpendLimitTest) TestRollingPeriod() {
+	auth := s.addSpendLimit("1", authenticator.SpendLimitPeriodDay, sdk.NewInt64Coin("ubtsg", 1000))
+	start := s.Ctx.BlockTime()
+
+	s.Require().NoError(s.execute(auth, "1", sdk.NewCoins(sdk.NewInt64Coin("ubtsg", 100))))
+	s.Ctx = s.Ctx.WithBlockTime(start.Add(24*time.Hour - time.Second))
+	s.Require().NoError(s.execute(auth, "1", sdk.NewCoins(sdk.NewInt64Coin("ubtsg", 900))))
+
+	// Only the first spending left the period once a day passed since it
+	s.Ctx = s.Ctx.WithBlockTime(start.Add(24 * time.Hour))
+	s.Require().ErrorContains(s.execute(auth, "1", sdk.NewCoins(sdk.NewInt64Coin("ubtsg", 101))), "spend limit exceeded")
+	s.Require().NoError(s.execute(auth, "1", sdk.NewCoins(sdk.NewInt64Coin("ubtsg", 100))))
+
+	usage, err := s.SpendLimit.GetUsage(s.Ctx, s.TestAccAddress[0], "1")
+	s.Require().NoError(err)
+	s.Require().Equal([]authenticator.SpendLimitEntry{
+		{Time: start.Add(24*time.Hour - time.Second), Amount: sdk.NewCoins(sdk.NewInt64Coin("ubtsg", 900))},
+		{Time: start.Add(24 * time.Hour), Amount: sdk.NewCoins(sdk.NewInt64Coin("ubtsg", 100))},
+	}, usage.Entries)
+
+	release, ok := usage.NextRelease()
+	s.Require().True(ok)
+	s.Require().Equal(start.Add(48*time.Hour-time.Second), release)
+}
+
+func (s *SpendLimitTest) TestSpendLimitPerDenom() {
+	auth := s.addSpendLimit("1", authenticator.SpendLimitPeriodWeek, sdk.NewInt64Coin("ubtsg", 1000), sdk.NewInt64Coin("uclay", 10))
+
+	s.Require().NoError(s.execute(auth, "1", sdk.NewCoins(sdk.NewInt64Coin("ubtsg", 1000), sdk.NewInt64Coin("uclay", 10))))
+	s.Require().Error(s.execute(auth, "1", sdk.NewCoins(sdk.NewInt64Coin("uclay", 1))))
+
+	// The week is not over after a few days
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().AddDate(0, 0, 6))
+	s.Require().Error(s.execute(auth, "1", sdk.NewCoins(sdk.NewInt64Coin("ubtsg", 1))))
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().AddDate(0, 0, 1))
+	s.Require().NoError(s.execute(auth, "1", sdk.NewCoins(sdk.NewInt64Coin("ubtsg", 1))))
+}
+
+// TestMultipleMessages ensures the spendings of a transaction are counted once, when its messages
+// are confirmed by the same authenticator.
+func (s *SpendLimitTest) TestMultipleMessages() {
+	auth := s.addSpendLimit("1", authenticator.SpendLimitPeriodDay, sdk.NewInt64Coin("ubtsg", 1000))
+	request := authenticator.AuthenticationRequest{AuthenticatorId: "1", Account: s.TestAccAddress[0]}
+
+	s.Require().NoError(auth.Track(s.Ctx, request))
+	s.Require().NoError(auth.Track(s.Ctx, request))
+	err := s.BitsongApp.AppKeepers.BankKeeper.SendCoins(s.Ctx, s.TestAccAddress[0], s.TestAccAddress[1], sdk.NewCoins(sdk.NewInt64Coin("ubtsg", 700)))
+	s.Require().NoError(err)
+	s.Require().NoError(auth.ConfirmExecution(s.Ctx, request))
+	s.Require().NoError(auth.ConfirmExecution(s.Ctx, request))
+
+	usage, err := s.SpendLimit.GetUsage(s.Ctx, s.TestAccAddress[0], "1")
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("ubtsg", 700)), usage.Spent())
+}
+
+// TestNetSpend ensures the limits apply to the net spendings of an execution: the funds received
+// during the execution offset the funds it sends, but an execution which increases the balances
+// does not reduce the usage.
+func (s *SpendLimitTest) TestNetSpend() {
+	bankKeeper := s.BitsongApp.AppKeepers.BankKeeper
+	auth := s.addSpendLimit("1", authenticator.SpendLimitPeriodDay, sdk.NewInt64Coin("ubtsg", 1000))
+	request := authenticator.AuthenticationRequest{AuthenticatorId: "1", Account: s.TestAccAddress[0]}
+
+	// Received funds do not count as spendings
+	s.Require().NoError(auth.Track(s.Ctx, request))
+	err := testutil.FundAccount(s.Ctx, bankKeeper, s.TestAccAddress[0], sdk.NewCoins(sdk.NewInt64Coin("ubtsg", 5000)))
+	s.Require().NoError(err)
+	s.Require().NoError(auth.ConfirmExecution(s.Ctx, request))
+
+	usage, err := s.SpendLimit.GetUsage(s.Ctx, s.TestAccAddress[0], "1")
+	s.Require().NoError(err)
+	s.Require().True(usage.Spent().IsZero())
+
+	// The funds received during an execution offset the funds it sends
+	s.Require().NoError(auth.Track(s.Ctx, request))
+	err = bankKeeper.SendCoins(s.Ctx, s.TestAccAddress[0], s.TestAccAddress[1], sdk.NewCoins(sdk.NewInt64Coin("ubtsg", 1000)))
+	s.Require().NoError(err)
+	err = bankKeeper.SendCoins(s.Ctx, s.TestAccAddress[1], s.TestAccAddress[0], sdk.NewCoins(sdk.NewInt64Coin("ubtsg", 400)))
+	s.Require().NoError(err)
+	s.Require().NoError(auth.ConfirmExecution(s.Ctx, request))
+
+	usage, err = s.SpendLimit.GetUsage(s.Ctx, s.TestAccAddress[0], "1")
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("ubtsg", 600)), usage.Spent())
+
+	// Funds received in other executions do not extend the limit
+	s.Require().NoError(s.execute(auth, "1", sdk.NewCoins(sdk.NewInt64Coin("ubtsg", 400))))
+	s.Require().Error(s.execute(auth, "1", sdk.NewCoins(sdk.NewInt64Coin("ubtsg", 1))))
+}
+
+// TestSeparateUsage ensures each authenticator id has its own usage.
+func (s *SpendLimitTest) TestSeparateUsage() {
+	first := s.addSpendLimit("1.0", authenticator.SpendLimitPeriodDay, sdk.NewInt64Coin("ubtsg", 1000))
+	second := s.addSpendLimit("2", authenticator.SpendLimitPeriodDay, sdk.NewInt64Coin("ubtsg", 1000))
+
+	s.Require().NoError(s.execute(first, "1.0", sdk.NewCoins(sdk.NewInt64Coin("ubtsg", 1000))))
+	s.Require().NoError(s.execute(second, "2", sdk.NewCoins(sdk.NewInt64Coin("ubtsg", 1000))))
+	s.Require().Error(s.execute(first, "1.0", sdk.NewCoins(sdk.NewInt64Coin("ubtsg", 1))))
+}
+
+func (s *SpendLimitTest) TestConfirmWithoutTrack() {
+	auth := s.addSpendLimit("1", authenticator.SpendLimitPeriodDay, sdk.NewInt64Coin("ubtsg", 1000))
+	request := authenticator.AuthenticationRequest{AuthenticatorId: "1", Account: s.TestAccAddress[0]}
+
+	s.Require().Error(auth.ConfirmExecution(s.Ctx, request))
+}
+
+func (s *SpendLimitTest) TestInvalidConfig() {
+	testCases := []struct {
+		name   string
+		config []byte
+	}{
+		{"no limits", s.spendLimitConfig(authenticator.SpendLimitPeriodDay)},
+		{"zero limit", []byte(`{"limits":[{"denom":"ubtsg","amount":"0"}],"period":"day"}`)},
+		{"duplicate denom", []byte(`{"limits":[{"denom":"ubtsg","amount":"1"},{"denom":"ubtsg","amount":"2"}],"period":"day"}`)},
+		{"invalid period", s.spendLimitConfig("year", sdk.NewInt64Coin("ubtsg", 1000))},
+		{"invalid json", []byte("invalid")},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.SpendLimit.Initialize(tc.config)
+			s.Require().Error(err)
+
+			err = s.SpendLimit.OnAuthenticatorAdded(s.Ctx, s.TestAccAddress[0], tc.config, "1")
+			s.Require().Error(err)
+		})
+	}
+}
+
+func (s *SpendLimitTest) TestQuerySpendLimit() {
+	sak := s.BitsongApp.AppKeepers.SmartAccountKeeper
+	config := s.spendLimitConfig(authenticator.SpendLimitPeriodMonth, sdk.NewInt64Coin("ubtsg", 1000))
+	id, err := sak.AddAuthenticator(s.Ctx, s.TestAccAddress[0], authenticator.SpendLimitType, config)
+	s.Require().NoError(err)
+	authId := "1"
+	s.Require().Equal(uint64(1), id)
+
+	query := func() *smartaccounttypes.GetSpendLimitResponse {
+		res, err := sak.GetSpendLimit(s.Ctx, &smartaccounttypes.GetSpendLimitRequest{
+			Account:         s.TestAccAddress[0].String(),
+			AuthenticatorId: authId,
+		})
+		s.Require().NoError(err)
+		return res
+	}
+
+	// Nothing is spent before the first execution
+	res := query()
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("ubtsg", 1000)), res.Limits)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("ubtsg", 1000)), res.Remaining)
+	s.Require().True(res.Spent.Empty())
+	s.Require().Equal("month", res.Period)
+	s.Require().Nil(res.PeriodEnd)
+
+	auth, err := s.SpendLimit.Initialize(config)
+	s.Require().NoError(err)
+	s.Require().NoError(s.execute(auth, authId, sdk.NewCoins(sdk.NewInt64Coin("ubtsg", 300))))
+
+	res = query()
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("ubtsg", 300)), res.Spent)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("ubtsg", 700)), res.Remaining)
+	s.Require().NotNil(res.PeriodEnd)
+	s.Require().Equal(s.Ctx.BlockTime().AddDate(0, 1, 0), *res.PeriodEnd)
+
+	// The whole limit is available once the spending left the period
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().AddDate(0, 1, 0))
+	res = query()
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("ubtsg", 1000)), res.Remaining)
+	s.Require().Nil(res.PeriodEnd)
+
+	// The usage is deleted with the authenticator
+	s.Require().NoError(sak.RemoveAuthenticator(s.Ctx, s.TestAccAddress[0], id))
+	_, err = sak.GetSpendLimit(s.Ctx, &smartaccounttypes.GetSpendLimitRequest{
+		Account:         s.TestAccAddress[0].String(),
+		AuthenticatorId: authId,
+	})
+	s.Require().Error(err)
+}
@@ -0,0 +1,242 @@
+package authenticator
+
+import (
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ThresholdType represents a composite authenticator requiring the sub-authenticators which
+// authenticate a message to reach a threshold of weight, e.g. 2 of 3 signers.
+const ThresholdType = "Threshold"
+
+// ThresholdSubAuthenticatorInitData is the initialization data of a weighted sub-authenticator.
+type ThresholdSubAuthenticatorInitData struct {
+	SubAuthenticatorInitData
+	Weight uint64 `json:"weight"`
+}
+
+// ThresholdConfig is the configuration of a threshold authenticator.
+type ThresholdConfig struct {
+	Threshold         uint64                              `json:"threshold"`
+	SubAuthenticators []ThresholdSubAuthenticatorInitData `json:"sub_authenticators"`
+}
+
+// ThresholdSignature is the signature of a threshold authenticator: the signatures of the
+// sub-authenticators signing the message, each with the index of its sub-authenticator in the
+// configuration, in strictly increasing order.
+type ThresholdSignature struct {
+	Indexes    []int           `json:"indexes"`
+	Signatures json.RawMessage `json:"signatures"`
+}
+
+// Threshold authenticates a message when the sub-authenticators given a signature all authenticate
+// it, and their total weight reaches the threshold. Only these sub-authenticators are tracked and
+// confirm the execution, as the others took no part in the authentication.
+type Threshold struct {
+	SubAuthenticators []Authenticator
+	Weights           []uint64
+	Threshold         uint64
+	am                *AuthenticatorManager
+}
+
+var _ Authenticator = &Threshold{}
+
+func NewThreshold(am *AuthenticatorManager) Threshold {
+	return Threshold{
+		am:                am,
+		SubAuthenticators: []Authenticator{},
+	}
+}
+
+func (t Threshold) Type() string {
+	return ThresholdType
+}
+
+func (t Threshold) StaticGas() uint64 {
+	var totalGas uint64
+	for _, auth := range t.SubAuthenticators {
+		totalGas += auth.StaticGas()
+	}
+	return totalGas
+}
+
+func (t Threshold) Initialize(config []byte) (Authenticator, error) {
+	cfg, err := parseThresholdConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call Initialize on each sub-authenticator with its appropriate data using AuthenticatorManager
+	t.SubAuthenticators = []Authenticator{}
+	t.Weights = []uint64{}
+	for _, initData := range cfg.SubAuthenticators {
+		authenticatorCode := t.am.GetAuthenticatorByType(initData.Type)
+		if authenticatorCode == nil {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "sub-authenticator type %s is not registered in manager", initData.Type)
+		}
+		instance, err := authenticatorCode.Initialize(initData.Config)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to initialize sub-authenticator (type = %s)", initData.Type)
+		}
+		t.SubAuthenticators = append(t.SubAuthenticators, instance)
+		t.Weights = append(t.Weights, initData.Weight)
+	}
+	t.Threshold = cfg.Threshold
+
+	return t, nil
+}
+
+func (t Threshold) Authenticate(ctx sdk.Context, request AuthenticationRequest) error {
+	if len(t.SubAuthenticators) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "no sub-authenticators provided")
+	}
+
+	indexes, signatures, err := t.splitIndexedSignatures(request.Signature)
+	if err != nil {
+		return err
+	}
+
+	var weight uint64
+	baseId := request.AuthenticatorId
+	for i, index := range indexes {
+		// update the request to include the sub-authenticator id and signature
+		request.AuthenticatorId = compositeId(baseId, index)
+		request.Signature = signatures[i]
+		if err := t.SubAuthenticators[index].Authenticate(ctx, request); err != nil {
+			return errorsmod.Wrapf(err, "sub-authenticator failed to authenticate (sub-authenticator id = %s)", request.AuthenticatorId)
+		}
+		weight += t.Weights[index]
+	}
+
+	if weight < t.Threshold {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "signatures weight %d is below the threshold %d", weight, t.Threshold)
+	}
+
+	return nil
+}
+
+// Track is called on the sub-authenticators given a signature.
+func (t Threshold) Track(ctx sdk.Context, request AuthenticationRequest) error {
+	indexes, signatures, err := t.splitIndexedSignatures(request.Signature)
+	if err != nil {
+		return err
+	}
+
+	baseId := request.AuthenticatorId
+	for i, index := range indexes {
+		request.AuthenticatorId = compositeId(baseId, index)
+		request.Signature = signatures[i]
+		if err := t.SubAuthenticators[index].Track(ctx, request); err != nil {
+			return errorsmod.Wrapf(err, "sub-authenticator track failed (sub-authenticator id = %s)", request.AuthenticatorId)
+		}
+	}
+	return nil
+}
+
+// ConfirmExecution is called on the sub-authenticators given a signature, which must all confirm the
+// execution.
+func (t Threshold) ConfirmExecution(ctx sdk.Context, request AuthenticationRequest) error {
+	indexes, signatures, err := t.splitIndexedSignatures(request.Signature)
+	if err != nil {
+		return err
+	}
+
+	baseId := request.AuthenticatorId
+	for i, index := range indexes {
+		request.AuthenticatorId = compositeId(baseId, index)
+		request.Signature = signatures[i]
+		if err := t.SubAuthenticators[index].ConfirmExecution(ctx, request); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t Threshold) OnAuthenticatorAdded(ctx sdk.Context, account sdk.AccAddress, config []byte, authenticatorId string) error {
+	cfg, err := parseThresholdConfig(config)
+	if err != nil {
+		return err
+	}
+
+	subData, err := json.Marshal(cfg.SubAuthenticators)
+	if err != nil {
+		return err
+	}
+	return onSubAuthenticatorsAdded(ctx, account, subData, authenticatorId, t.am)
+}
+
+func (t Threshold) OnAuthenticatorRemoved(ctx sdk.Context, account sdk.AccAddress, config []byte, authenticatorId string) error {
+	var cfg ThresholdConfig
+	if err := json.Unmarshal(config, &cfg); err != nil {
+		return err
+	}
+
+	subData, err := json.Marshal(cfg.SubAuthenticators)
+	if err != nil {
+		return err
+	}
+	return onSubAuthenticatorsRemoved(ctx, account, subData, authenticatorId, t.am)
+}
+
+// splitIndexedSignatures decodes the indexed signatures of the sub-authenticators, ensuring the
+// indexes are strictly increasing and in range.
+func (t Threshold) splitIndexedSignatures(signature []byte) ([]int, [][]byte, error) {
+	var thresholdSig ThresholdSignature
+	if err := json.Unmarshal(signature, &thresholdSig); err != nil {
+		return nil, nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "failed to parse threshold signature")
+	}
+	if len(thresholdSig.Indexes) == 0 {
+		return nil, nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "no signatures provided")
+	}
+
+	for i, index := range thresholdSig.Indexes {
+		if index < 0 || index >= len(t.SubAuthenticators) {
+			return nil, nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "signature index %d out of range", index)
+		}
+		if i > 0 && index <= thresholdSig.Indexes[i-1] {
+			return nil, nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "signature indexes must be strictly increasing")
+		}
+	}
+
+	signatures, err := splitSignatures(thresholdSig.Signatures, len(thresholdSig.Indexes))
+	if err != nil {
+		return nil, nil, err
+	}
+	return thresholdSig.Indexes, signatures, nil
+}
+
+// parseThresholdConfig decodes the configuration of a threshold authenticator, ensuring each
+// sub-authenticator has a weight and the threshold is reachable.
+func parseThresholdConfig(config []byte) (ThresholdConfig, error) {
+	var cfg ThresholdConfig
+	if err := json.Unmarshal(config, &cfg); err != nil {
+		return ThresholdConfig{}, errorsmod.Wrap(err, "failed to parse threshold initialization data")
+	}
+
+	if len(cfg.SubAuthenticators) <= 1 {
+		return ThresholdConfig{}, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "threshold must have at least 2 sub-authenticators")
+	}
+	if cfg.Threshold == 0 {
+		return ThresholdConfig{}, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "threshold must be positive")
+	}
+
+	var totalWeight uint64
+	for i, initData := range cfg.SubAuthenticators {
+		if initData.Weight == 0 {
+			return ThresholdConfig{}, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "weight of sub-authenticator %d must be positive", i)
+		}
+		if totalWeight+initData.Weight < totalWeight {
+			return ThresholdConfig{}, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "total weight overflows")
+		}
+		totalWeight += initData.Weight
+	}
+	if cfg.Threshold > totalWeight {
+		return ThresholdConfig{}, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "threshold %d exceeds the total weight %d", cfg.Threshold, totalWeight)
+	}
+
+	return cfg, nil
+}
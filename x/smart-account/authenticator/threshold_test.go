@@ -0,0 +1,235 @@
+package authenticator_test
+
+import (
+	"encoding/json"
+	"os"
+	"strconv"
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/bitsongofficial/go-bitsong/x/smart-account/authenticator"
+	"github.com/bitsongofficial/go-bitsong/x/smart-account/testutils"
+	smartaccounttypes "github.com/bitsongofficial/go-bitsong/x/smart-account/types"
+)
+
+type ThresholdAuthenticatorTest struct {
+	BaseAuthenticatorSuite
+
+	ThresholdAuth authenticator.Threshold
+	spyAuth       testutils.SpyAuthenticator
+}
+
+func TestThresholdAuthenticatorTest(t *testing.T) {
+	suite.Run(t, new(ThresholdAuthenticatorTest))
+}
+
+func (s *ThresholdAuthenticatorTest) SetupTest() {
+	s.SetupKeys()
+	am := authenticator.NewAuthenticatorManager()
+
+	s.ThresholdAuth = authenticator.NewThreshold(am)
+	s.spyAuth = testutils.NewSpyAuthenticator(
+		s.BitsongApp.AppKeepers.GetKVStoreKey()[smartaccounttypes.StoreKey],
+	)
+
+	am.RegisterAuthenticator(s.ThresholdAuth)
+	am.RegisterAuthenticator(s.spyAuth)
+
+	s.Ctx = s.Ctx.WithGasMeter(storetypes.NewGasMeter(2_000_000))
+}
+
+func (s *ThresholdAuthenticatorTest) TearDownTest() {
+	os.RemoveAll(s.HomeDir)
+}
+
+type thresholdSigner struct {
+	name    string
+	weight  uint64
+	failure testutils.FailureFlag
+}
+
+// thresholdConfig builds the configuration of a threshold authenticator of spy sub-authenticators
+func (s *ThresholdAuthenticatorTest) thresholdConfig(threshold uint64, signers ...thresholdSigner) []byte {
+	cfg := authenticator.ThresholdConfig{Threshold: threshold}
+	for _, signer := range signers {
+		spyData, err := json.Marshal(testutils.SpyAuthenticatorData{Name: signer.name, Failure: signer.failure})
+		s.Require().NoError(err)
+
+		cfg.SubAuthenticators = append(cfg.SubAuthenticators, authenticator.ThresholdSubAuthenticatorInitData{
+			SubAuthenticatorInitData: authenticator.SubAuthenticatorInitData{Type: s.spyAuth.Type(), Config: spyData},
+			Weight:                   signer.weight,
+		})
+	}
+
+	bz, err := json.Marshal(cfg)
+	s.Require().NoError(err)
+	return bz
+}
+
+// thresholdSignature builds an indexed signature list, signing each index with its own signature
+func (s *ThresholdAuthenticatorTest) thresholdSignature(indexes ...int) []byte {
+	signatures := [][]byte{}
+	for _, index := range indexes {
+		signatures = append(signatures, []byte{byte(index)})
+	}
+	sigs, err := json.Marshal(signatures)
+	s.Require().NoError(err)
+
+	bz, err := json.Marshal(authenticator.ThresholdSignature{Indexes: indexes, Signatures: sigs})
+	s.Require().NoError(err)
+	return bz
+}
+
+func (s *ThresholdAuthenticatorTest) authenticationRequest(signature []byte) authenticator.AuthenticationRequest {
+	msg := &bank.MsgSend{FromAddress: s.TestAccAddress[0].String(), ToAddress: "to", Amount: sdk.NewCoins(sdk.NewInt64Coin("foo", 1))}
+	encodedMsg, err := codectypes.NewAnyWithValue(msg)
+	s.Require().NoError(err)
+
+	return authenticator.AuthenticationRequest{
+		AuthenticatorId: "1",
+		Account:         s.TestAccAddress[0],
+		FeePayer:        s.TestAccAddress[0],
+		Msg:             authenticator.LocalAny{TypeURL: encodedMsg.TypeUrl, Value: encodedMsg.Value},
+		Signature:       signature,
+		SignModeTxData:  authenticator.SignModeData{Direct: []byte{1, 1, 1, 1, 1}},
+	}
+}
+
+func (s *ThresholdAuthenticatorTest) TestAuthenticate() {
+	pass := func(name string, weight uint64) thresholdSigner { return thresholdSigner{name: name, weight: weight} }
+	fail := func(name string, weight uint64) thresholdSigner {
+		return thresholdSigner{name: name, weight: weight, failure: testutils.AUTHENTICATE_FAIL}
+	}
+
+	testCases := []struct {
+		name      string
+		threshold uint64
+		signers   []thresholdSigner
+		signature []byte
+		success   bool
+	}{
+		{"2 of 3: first and last", 2, []thresholdSigner{pass("a", 1), pass("b", 1), pass("c", 1)}, s.thresholdSignature(0, 2), true},
+		{"2 of 3: all signers", 2, []thresholdSigner{pass("a", 1), pass("b", 1), pass("c", 1)}, s.thresholdSignature(0, 1, 2), true},
+		{"2 of 3: one signer", 2, []thresholdSigner{pass("a", 1), pass("b", 1), pass("c", 1)}, s.thresholdSignature(1), false},
+		{"2 of 3: failing signer", 2, []thresholdSigner{pass("a", 1), fail("b", 1), pass("c", 1)}, s.thresholdSignature(0, 1), false},
+		{"2 of 3: failing extra signer", 2, []thresholdSigner{pass("a", 1), fail("b", 1), pass("c", 1)}, s.thresholdSignature(0, 1, 2), false},
+		{"weighted: heavy signer", 2, []thresholdSigner{pass("a", 2), pass("b", 1), pass("c", 1)}, s.thresholdSignature(0), true},
+		{"weighted: light signers", 3, []thresholdSigner{pass("a", 2), pass("b", 1), pass("c", 1)}, s.thresholdSignature(1, 2), false},
+		{"no signatures", 1, []thresholdSigner{pass("a", 1), pass("b", 1)}, []byte(`{"indexes":[],"signatures":[]}`), false},
+		{"index out of range", 1, []thresholdSigner{pass("a", 1), pass("b", 1)}, s.thresholdSignature(2), false},
+		{"duplicate index", 2, []thresholdSigner{pass("a", 1), pass("b", 1)}, s.thresholdSignature(0, 0), false},
+		{"decreasing indexes", 2, []thresholdSigner{pass("a", 1), pass("b", 1)}, s.thresholdSignature(1, 0), false},
+		{"missing signature", 2, []thresholdSigner{pass("a", 1), pass("b", 1)}, []byte(`{"indexes":[0,1],"signatures":["AA=="]}`), false},
+		{"invalid signature", 1, []thresholdSigner{pass("a", 1), pass("b", 1)}, []byte("invalid"), false},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			auth, err := s.ThresholdAuth.Initialize(s.thresholdConfig(tc.threshold, tc.signers...))
+			s.Require().NoError(err)
+
+			err = auth.Authenticate(s.Ctx, s.authenticationRequest(tc.signature))
+			if tc.success {
+				s.Require().NoError(err)
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (s *ThresholdAuthenticatorTest) TestInvalidConfig() {
+	testCases := []struct {
+		name   string
+		config []byte
+	}{
+		{"zero threshold", s.thresholdConfig(0, thresholdSigner{name: "a", weight: 1}, thresholdSigner{name: "b", weight: 1})},
+		{"threshold above total weight", s.thresholdConfig(3, thresholdSigner{name: "a", weight: 1}, thresholdSigner{name: "b", weight: 1})},
+		{"zero weight", s.thresholdConfig(1, thresholdSigner{name: "a", weight: 1}, thresholdSigner{name: "b", weight: 0})},
+		{"single sub-authenticator", s.thresholdConfig(1, thresholdSigner{name: "a", weight: 1})},
+		{"invalid json", []byte("invalid")},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.ThresholdAuth.Initialize(tc.config)
+			s.Require().Error(err)
+
+			err = s.ThresholdAuth.OnAuthenticatorAdded(s.Ctx, s.TestAccAddress[0], tc.config, "1")
+			s.Require().Error(err)
+		})
+	}
+}
+
+// TestSignersOnlyAreTrackedAndConfirmed ensures the sub-authenticators without a signature are
+// neither tracked nor confirm the execution, and that the others receive their own composite id and
+// signature.
+func (s *ThresholdAuthenticatorTest) TestSignersOnlyAreTrackedAndConfirmed() {
+	config := s.thresholdConfig(2,
+		thresholdSigner{name: "a", weight: 1},
+		thresholdSigner{name: "b", weight: 1},
+		thresholdSigner{name: "c", weight: 1},
+	)
+	auth, err := s.ThresholdAuth.Initialize(config)
+	s.Require().NoError(err)
+
+	request := s.authenticationRequest(s.thresholdSignature(0, 2))
+	s.Require().NoError(auth.Authenticate(s.Ctx, request))
+	s.Require().NoError(auth.Track(s.Ctx, request))
+	s.Require().NoError(auth.ConfirmExecution(s.Ctx, request))
+
+	for name, index := range map[string]int{"a": 0, "c": 2} {
+		spy := testutils.SpyAuthenticator{KvStoreKey: s.spyAuth.KvStoreKey, Name: name}
+		calls := spy.GetLatestCalls(s.Ctx)
+
+		expectedId := compositeTestId("1", index)
+		s.Require().Equal(expectedId, calls.Authenticate.AuthenticatorId)
+		s.Require().Equal([]byte{byte(index)}, calls.Authenticate.Signature)
+		s.Require().Equal(expectedId, calls.Track.AuthenticatorId)
+		s.Require().Equal(expectedId, calls.ConfirmExecution.AuthenticatorId)
+		s.Require().Equal([]byte{byte(index)}, calls.ConfirmExecution.Signature)
+	}
+
+	spy := testutils.SpyAuthenticator{KvStoreKey: s.spyAuth.KvStoreKey, Name: "b"}
+	s.Require().Empty(spy.GetLatestCalls(s.Ctx))
+
+	// A signer failing to confirm the execution fails the confirmation
+	config = s.thresholdConfig(1,
+		thresholdSigner{name: "d", weight: 1},
+		thresholdSigner{name: "e", weight: 1, failure: testutils.CONFIRM_EXECUTION_FAIL},
+	)
+	auth, err = s.ThresholdAuth.Initialize(config)
+	s.Require().NoError(err)
+	s.Require().NoError(auth.ConfirmExecution(s.Ctx, s.authenticationRequest(s.thresholdSignature(0))))
+	s.Require().Error(auth.ConfirmExecution(s.Ctx, s.authenticationRequest(s.thresholdSignature(0, 1))))
+}
+
+func (s *ThresholdAuthenticatorTest) TestOnAuthenticatorAdded() {
+	config := s.thresholdConfig(2,
+		thresholdSigner{name: "a", weight: 1},
+		thresholdSigner{name: "b", weight: 1},
+	)
+	s.Require().NoError(s.ThresholdAuth.OnAuthenticatorAdded(s.Ctx, s.TestAccAddress[0], config, "7"))
+
+	for index, name := range []string{"a", "b"} {
+		spy := testutils.SpyAuthenticator{KvStoreKey: s.spyAuth.KvStoreKey, Name: name}
+		added := spy.GetLatestCalls(s.Ctx).OnAuthenticatorAdded
+		s.Require().Equal(compositeTestId("7", index), added.AuthenticatorId)
+	}
+
+	s.Require().NoError(s.ThresholdAuth.OnAuthenticatorRemoved(s.Ctx, s.TestAccAddress[0], config, "7"))
+	for index, name := range []string{"a", "b"} {
+		spy := testutils.SpyAuthenticator{KvStoreKey: s.spyAuth.KvStoreKey, Name: name}
+		removed := spy.GetLatestCalls(s.Ctx).OnAuthenticatorRemoved
+		s.Require().Equal(compositeTestId("7", index), removed.AuthenticatorId)
+	}
+}
+
+func compositeTestId(baseId string, index int) string {
+	return baseId + "." + strconv.Itoa(index)
+}
@@ -0,0 +1,178 @@
+package authenticator
+
+import (
+	"encoding/json"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// TimeWindowType represents a type of authenticator restricting a sub-authenticator to a window of
+// block times, e.g. to hand out short-lived session keys.
+const TimeWindowType = "TimeWindow"
+
+// TimeWindowConfig is the configuration of a time window authenticator. The start is optional, the
+// window is then open from the moment the authenticator is added.
+type TimeWindowConfig struct {
+	Start            time.Time                `json:"start"`
+	End              time.Time                `json:"end"`
+	SubAuthenticator SubAuthenticatorInitData `json:"sub_authenticator"`
+}
+
+// TimeWindow authenticates a message when the block time is within [start, end] and the
+// sub-authenticator authenticates it. It implements ExpiringAuthenticator, so that the keeper
+// removes it from the account once the end of the window has passed.
+type TimeWindow struct {
+	SubAuthenticator Authenticator
+	Start            time.Time
+	End              time.Time
+	am               *AuthenticatorManager
+}
+
+var (
+	_ Authenticator         = &TimeWindow{}
+	_ ExpiringAuthenticator = &TimeWindow{}
+)
+
+func NewTimeWindow(am *AuthenticatorManager) TimeWindow {
+	return TimeWindow{
+		am: am,
+	}
+}
+
+func (tw TimeWindow) Type() string {
+	return TimeWindowType
+}
+
+func (tw TimeWindow) StaticGas() uint64 {
+	if tw.SubAuthenticator == nil {
+		return 0
+	}
+	return tw.SubAuthenticator.StaticGas()
+}
+
+func (tw TimeWindow) Initialize(config []byte) (Authenticator, error) {
+	cfg, err := parseTimeWindowConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	authenticatorCode := tw.am.GetAuthenticatorByType(cfg.SubAuthenticator.Type)
+	if authenticatorCode == nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "sub-authenticator type %s is not registered in manager", cfg.SubAuthenticator.Type)
+	}
+	instance, err := authenticatorCode.Initialize(cfg.SubAuthenticator.Config)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to initialize sub-authenticator (type = %s)", cfg.SubAuthenticator.Type)
+	}
+
+	tw.SubAuthenticator = instance
+	tw.Start = cfg.Start
+	tw.End = cfg.End
+	return tw, nil
+}
+
+// Authenticate rejects the message outside of the window, and otherwise delegates to the
+// sub-authenticator.
+func (tw TimeWindow) Authenticate(ctx sdk.Context, request AuthenticationRequest) error {
+	if tw.SubAuthenticator == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "no sub-authenticator provided")
+	}
+
+	blockTime := ctx.BlockTime()
+	if blockTime.Before(tw.Start) {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "time window starts at %s, block time is %s", tw.Start, blockTime)
+	}
+	if blockTime.After(tw.End) {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "time window ended at %s, block time is %s", tw.End, blockTime)
+	}
+
+	request.AuthenticatorId = compositeId(request.AuthenticatorId, 0)
+	if err := tw.SubAuthenticator.Authenticate(ctx, request); err != nil {
+		return errorsmod.Wrapf(err, "sub-authenticator failed to authenticate (sub-authenticator id = %s)", request.AuthenticatorId)
+	}
+	return nil
+}
+
+func (tw TimeWindow) Track(ctx sdk.Context, request AuthenticationRequest) error {
+	request.AuthenticatorId = compositeId(request.AuthenticatorId, 0)
+	if err := tw.SubAuthenticator.Track(ctx, request); err != nil {
+		return errorsmod.Wrapf(err, "sub-authenticator track failed (sub-authenticator id = %s)", request.AuthenticatorId)
+	}
+	return nil
+}
+
+func (tw TimeWindow) ConfirmExecution(ctx sdk.Context, request AuthenticationRequest) error {
+	request.AuthenticatorId = compositeId(request.AuthenticatorId, 0)
+	return tw.SubAuthenticator.ConfirmExecution(ctx, request)
+}
+
+// OnAuthenticatorAdded ensures the window is valid, then adds the sub-authenticator. A window which
+// already ended is accepted, so that it can be imported from genesis; the keeper rejects it when an
+// account adds it.
+func (tw TimeWindow) OnAuthenticatorAdded(ctx sdk.Context, account sdk.AccAddress, config []byte, authenticatorId string) error {
+	cfg, err := parseTimeWindowConfig(config)
+	if err != nil {
+		return err
+	}
+
+	authenticatorCode := tw.am.GetAuthenticatorByType(cfg.SubAuthenticator.Type)
+	if authenticatorCode == nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "sub-authenticator type %s is not registered in manager", cfg.SubAuthenticator.Type)
+	}
+	subId := compositeId(authenticatorId, 0)
+	if err := authenticatorCode.OnAuthenticatorAdded(ctx, account, cfg.SubAuthenticator.Config, subId); err != nil {
+		return errorsmod.Wrapf(err, "sub-authenticator `OnAuthenticatorAdded` failed (sub-authenticator id = %s)", subId)
+	}
+	return nil
+}
+
+func (tw TimeWindow) OnAuthenticatorRemoved(ctx sdk.Context, account sdk.AccAddress, config []byte, authenticatorId string) error {
+	var cfg TimeWindowConfig
+	if err := json.Unmarshal(config, &cfg); err != nil {
+		return err
+	}
+
+	authenticatorCode := tw.am.GetAuthenticatorByType(cfg.SubAuthenticator.Type)
+	if authenticatorCode == nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "sub-authenticator type %s is not registered in manager", cfg.SubAuthenticator.Type)
+	}
+	subId := compositeId(authenticatorId, 0)
+	if err := authenticatorCode.OnAuthenticatorRemoved(ctx, account, cfg.SubAuthenticator.Config, subId); err != nil {
+		return errorsmod.Wrapf(err, "sub-authenticator `OnAuthenticatorRemoved` failed (sub-authenticator id = %s)", subId)
+	}
+	return nil
+}
+
+// Expiry returns the end of the window.
+func (tw TimeWindow) Expiry(config []byte) (time.Time, error) {
+	cfg, err := parseTimeWindowConfig(config)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return cfg.End, nil
+}
+
+// parseTimeWindowConfig decodes the configuration of a time window authenticator, ensuring the window
+// ends after it starts.
+func parseTimeWindowConfig(config []byte) (TimeWindowConfig, error) {
+	var cfg TimeWindowConfig
+	if err := json.Unmarshal(config, &cfg); err != nil {
+		return TimeWindowConfig{}, errorsmod.Wrap(err, "failed to parse time window initialization data")
+	}
+
+	if cfg.End.IsZero() {
+		return TimeWindowConfig{}, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "time window must have an end")
+	}
+	if !cfg.End.After(cfg.Start) {
+		return TimeWindowConfig{}, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "time window end %s must be after its start %s", cfg.End, cfg.Start)
+	}
+	if cfg.SubAuthenticator.Type == "" {
+		return TimeWindowConfig{}, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "time window must have a sub-authenticator")
+	}
+
+	return cfg, nil
+}
@@ -0,0 +1,171 @@
+package authenticator_test
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/bitsongofficial/go-bitsong/x/smart-account/authenticator"
+	"github.com/bitsongofficial/go-bitsong/x/smart-account/testutils"
+	smartaccounttypes "github.com/bitsongofficial/go-bitsong/x/smart-account/types"
+)
+
+type TimeWindowAuthenticatorTest struct {
+	BaseAuthenticatorSuite
+
+	TimeWindowAuth authenticator.TimeWindow
+	spyAuth        testutils.SpyAuthenticator
+	now            time.Time
+}
+
+func TestTimeWindowAuthenticatorTest(t *testing.T) {
+	suite.Run(t, new(TimeWindowAuthenticatorTest))
+}
+
+func (s *TimeWindowAuthenticatorTest) SetupTest() {
+	s.SetupKeys()
+	am := authenticator.NewAuthenticatorManager()
+
+	s.TimeWindowAuth = authenticator.NewTimeWindow(am)
+	s.spyAuth = testutils.NewSpyAuthenticator(
+		s.BitsongApp.AppKeepers.GetKVStoreKey()[smartaccounttypes.StoreKey],
+	)
+
+	am.RegisterAuthenticator(s.TimeWindowAuth)
+	am.RegisterAuthenticator(s.spyAuth)
+
+	s.now = time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	s.Ctx = s.Ctx.WithGasMeter(storetypes.NewGasMeter(2_000_000)).WithBlockTime(s.now)
+}
+
+func (s *TimeWindowAuthenticatorTest) TearDownTest() {
+	os.RemoveAll(s.HomeDir)
+}
+
+// timeWindowConfig builds the configuration of a time window authenticator of a spy sub-authenticator
+func (s *TimeWindowAuthenticatorTest) timeWindowConfig(start, end time.Time, name string, failure testutils.FailureFlag) []byte {
+	spyData, err := json.Marshal(testutils.SpyAuthenticatorData{Name: name, Failure: failure})
+	s.Require().NoError(err)
+
+	bz, err := json.Marshal(authenticator.TimeWindowConfig{
+		Start:            start,
+		End:              end,
+		SubAuthenticator: authenticator.SubAuthenticatorInitData{Type: s.spyAuth.Type(), Config: spyData},
+	})
+	s.Require().NoError(err)
+	return bz
+}
+
+func (s *TimeWindowAuthenticatorTest) authenticationRequest() authenticator.AuthenticationRequest {
+	msg := &bank.MsgSend{FromAddress: s.TestAccAddress[0].String(), ToAddress: "to", Amount: sdk.NewCoins(sdk.NewInt64Coin("foo", 1))}
+	encodedMsg, err := codectypes.NewAnyWithValue(msg)
+	s.Require().NoError(err)
+
+	return authenticator.AuthenticationRequest{
+		AuthenticatorId: "1",
+		Account:         s.TestAccAddress[0],
+		FeePayer:        s.TestAccAddress[0],
+		Msg:             authenticator.LocalAny{TypeURL: encodedMsg.TypeUrl, Value: encodedMsg.Value},
+		Signature:       []byte{1},
+		SignModeTxData:  authenticator.SignModeData{Direct: []byte{1, 1, 1, 1, 1}},
+	}
+}
+
+func (s *TimeWindowAuthenticatorTest) TestAuthenticate() {
+	start := s.now.Add(-time.Hour)
+	end := s.now.Add(time.Hour)
+
+	testCases := []struct {
+		name      string
+		blockTime time.Time
+		failure   testutils.FailureFlag
+		success   bool
+	}{
+		{"within the window", s.now, 0, true},
+		{"at the start", start, 0, true},
+		{"at the end", end, 0, true},
+		{"before the start", start.Add(-time.Second), 0, false},
+		{"after the end", end.Add(time.Second), 0, false},
+		{"failing sub-authenticator", s.now, testutils.AUTHENTICATE_FAIL, false},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			auth, err := s.TimeWindowAuth.Initialize(s.timeWindowConfig(start, end, "session", tc.failure))
+			s.Require().NoError(err)
+
+			err = auth.Authenticate(s.Ctx.WithBlockTime(tc.blockTime), s.authenticationRequest())
+			if tc.success {
+				s.Require().NoError(err)
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (s *TimeWindowAuthenticatorTest) TestInvalidConfig() {
+	testCases := []struct {
+		name   string
+		config []byte
+	}{
+		{"end before start", s.timeWindowConfig(s.now.Add(time.Hour), s.now, "session", 0)},
+		{"end equal to start", s.timeWindowConfig(s.now.Add(time.Hour), s.now.Add(time.Hour), "session", 0)},
+		{"no end", s.timeWindowConfig(time.Time{}, time.Time{}, "session", 0)},
+		{"no sub-authenticator", []byte(`{"end":"2026-01-02T00:00:00Z"}`)},
+		{"unregistered sub-authenticator", []byte(`{"end":"2026-01-02T00:00:00Z","sub_authenticator":{"type":"Unknown"}}`)},
+		{"invalid json", []byte("invalid")},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.TimeWindowAuth.Initialize(tc.config)
+			s.Require().Error(err)
+
+			err = s.TimeWindowAuth.OnAuthenticatorAdded(s.Ctx, s.TestAccAddress[0], tc.config, "1")
+			s.Require().Error(err)
+		})
+	}
+
+	// A window ending before the current block time is valid, so that it can be imported from genesis
+	config := s.timeWindowConfig(time.Time{}, s.now.Add(-time.Second), "session", 0)
+	_, err := s.TimeWindowAuth.Initialize(config)
+	s.Require().NoError(err)
+	s.Require().NoError(s.TimeWindowAuth.OnAuthenticatorAdded(s.Ctx, s.TestAccAddress[0], config, "1"))
+
+	expiry, err := s.TimeWindowAuth.Expiry(config)
+	s.Require().NoError(err)
+	s.Require().True(expiry.Equal(s.now.Add(-time.Second)))
+}
+
+// TestSubAuthenticatorCalls ensures the sub-authenticator receives the composite id of the time window
+func (s *TimeWindowAuthenticatorTest) TestSubAuthenticatorCalls() {
+	config := s.timeWindowConfig(time.Time{}, s.now.Add(time.Hour), "session", 0)
+	s.Require().NoError(s.TimeWindowAuth.OnAuthenticatorAdded(s.Ctx, s.TestAccAddress[0], config, "1"))
+
+	auth, err := s.TimeWindowAuth.Initialize(config)
+	s.Require().NoError(err)
+
+	request := s.authenticationRequest()
+	s.Require().NoError(auth.Authenticate(s.Ctx, request))
+	s.Require().NoError(auth.Track(s.Ctx, request))
+	s.Require().NoError(auth.ConfirmExecution(s.Ctx, request))
+	s.Require().NoError(s.TimeWindowAuth.OnAuthenticatorRemoved(s.Ctx, s.TestAccAddress[0], config, "1"))
+
+	spy := testutils.SpyAuthenticator{KvStoreKey: s.spyAuth.KvStoreKey, Name: "session"}
+	calls := spy.GetLatestCalls(s.Ctx)
+
+	expectedId := compositeTestId("1", 0)
+	s.Require().Equal(expectedId, calls.Authenticate.AuthenticatorId)
+	s.Require().Equal(expectedId, calls.Track.AuthenticatorId)
+	s.Require().Equal(expectedId, calls.ConfirmExecution.AuthenticatorId)
+	s.Require().Equal(expectedId, calls.OnAuthenticatorAdded.AuthenticatorId)
+	s.Require().Equal(expectedId, calls.OnAuthenticatorRemoved.AuthenticatorId)
+}
@@ -0,0 +1,262 @@
+package authenticator
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Compile time type assertion for the WebAuthn authenticator
+var _ Authenticator = &WebAuthn{}
+
+const (
+	// WebAuthnType represents a type of authenticator verifying the P-256 signatures of WebAuthn
+	// assertions, as produced by passkeys.
+	WebAuthnType = "WebAuthn"
+
+	// webAuthnGetType is the type of the client data of an assertion
+	webAuthnGetType = "webauthn.get"
+
+	// The flags of the authenticator data
+	webAuthnFlagUserPresent  = 0x01
+	webAuthnFlagUserVerified = 0x04
+
+	// webAuthnMinAuthDataSize is the size of the rpId hash, the flags and the sign counter at the
+	// start of the authenticator data
+	webAuthnMinAuthDataSize = sha256.Size + 1 + 4
+)
+
+// WebAuthnConfig is the configuration of a WebAuthn authenticator, given as JSON when it is added
+// to an account.
+type WebAuthnConfig struct {
+	// PublicKey is the compressed P-256 public key of the credential.
+	PublicKey []byte `json:"public_key"`
+	// RpId is the id of the relying party the credential is scoped to.
+	RpId string `json:"rp_id"`
+	// Origin is the origin of the client the assertions are made from.
+	Origin string `json:"origin"`
+	// RequireUserVerification requires the authenticator to verify the user, e.g. with a biometric
+	// check, on top of testing their presence.
+	RequireUserVerification bool `json:"require_user_verification,omitempty"`
+}
+
+// WebAuthnSignature is a WebAuthn assertion, given as JSON in the signature of a transaction.
+type WebAuthnSignature struct {
+	AuthenticatorData []byte `json:"authenticator_data"`
+	ClientDataJSON    []byte `json:"client_data_json"`
+	// Signature is the ASN.1 DER encoded ECDSA signature of the authenticator data followed by the
+	// SHA-256 hash of the client data.
+	Signature []byte `json:"signature"`
+}
+
+// webAuthnClientData holds the fields of the client data of an assertion which are verified.
+type webAuthnClientData struct {
+	Type        string `json:"type"`
+	Challenge   string `json:"challenge"`
+	Origin      string `json:"origin"`
+	CrossOrigin bool   `json:"crossOrigin"`
+}
+
+// WebAuthn verifies WebAuthn assertions of a P-256 credential, whose challenge is the sign bytes of
+// the transaction.
+type WebAuthn struct {
+	ak     authante.AccountKeeper
+	config WebAuthnConfig
+	pubKey *ecdsa.PublicKey
+}
+
+// NewWebAuthn creates a new WebAuthn authenticator
+func NewWebAuthn(ak authante.AccountKeeper) WebAuthn {
+	return WebAuthn{ak: ak}
+}
+
+// Type returns the type of the authenticator.
+func (w WebAuthn) Type() string {
+	return WebAuthnType
+}
+
+// StaticGas returns zero, the gas of the signature verification is consumed in Authenticate.
+func (w WebAuthn) StaticGas() uint64 {
+	return 0
+}
+
+// Initialize sets up the public key, the relying party and the origin from the JSON configuration.
+func (w WebAuthn) Initialize(config []byte) (Authenticator, error) {
+	cfg, pubKey, err := parseWebAuthnConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	w.config = cfg
+	w.pubKey = pubKey
+	return w, nil
+}
+
+// Authenticate verifies the WebAuthn assertion in the signature: the client data must be an
+// assertion of the configured origin whose challenge is the sign bytes of the transaction, the
+// authenticator data must be scoped to the configured relying party with the user present, and the
+// P-256 signature must be valid for both.
+func (w WebAuthn) Authenticate(ctx sdk.Context, request AuthenticationRequest) error {
+	// First consume gas for verifying the signature
+	params := w.ak.GetParams(ctx)
+	ctx.GasMeter().ConsumeGas(params.SigVerifyCostSecp256r1(), "webauthn signature verification")
+
+	if request.Simulate || ctx.IsReCheckTx() {
+		return nil
+	}
+	if w.pubKey == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "pubkey not set on authenticator")
+	}
+
+	var assertion WebAuthnSignature
+	if err := json.Unmarshal(request.Signature, &assertion); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "invalid webauthn signature: %s", err)
+	}
+
+	if err := w.verifyClientData(assertion.ClientDataJSON, request.SignModeTxData.Direct); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, err.Error())
+	}
+	if err := w.verifyAuthenticatorData(assertion.AuthenticatorData); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, err.Error())
+	}
+
+	clientDataHash := sha256.Sum256(assertion.ClientDataJSON)
+	digest := sha256.Sum256(append(bytes.Clone(assertion.AuthenticatorData), clientDataHash[:]...))
+	if !verifyNormalizedS(w.pubKey, digest[:], assertion.Signature) {
+		return errorsmod.Wrapf(
+			sdkerrors.ErrUnauthorized,
+			"webauthn signature verification failed; please verify account number (%d), sequence (%d) and chain-id (%s)",
+			request.TxData.AccountNumber,
+			request.TxData.AccountSequence,
+			request.TxData.ChainID,
+		)
+	}
+
+	return nil
+}
+
+// ecdsaSignature is the ASN.1 encoding of an ECDSA signature
+type ecdsaSignature struct {
+	R, S *big.Int
+}
+
+// verifyNormalizedS verifies the ASN.1 encoded signature of the digest once its s is normalized to the
+// lower half of the curve order. Passkeys produce high-S signatures about half of the time, and both
+// (r, s) and (r, N-s) are valid for the same digest, so they are accepted as one signature. Malleating
+// a signature does not allow to replay it, as the challenge is the sign bytes of the transaction,
+// which include the sequence of the account.
+func verifyNormalizedS(pubKey *ecdsa.PublicKey, digest []byte, signature []byte) bool {
+	var sig ecdsaSignature
+	rest, err := asn1.Unmarshal(signature, &sig)
+	if err != nil || len(rest) != 0 || sig.R == nil || sig.S == nil {
+		return false
+	}
+
+	n := pubKey.Curve.Params().N
+	if sig.R.Sign() <= 0 || sig.S.Sign() <= 0 || sig.S.Cmp(n) >= 0 {
+		return false
+	}
+	if sig.S.Cmp(new(big.Int).Rsh(n, 1)) > 0 {
+		sig.S = new(big.Int).Sub(n, sig.S)
+	}
+
+	return ecdsa.Verify(pubKey, digest, sig.R, sig.S)
+}
+
+// verifyClientData ensures the client data is an assertion made from the configured origin, whose
+// challenge is the given sign bytes.
+func (w WebAuthn) verifyClientData(clientDataJSON []byte, signBytes []byte) error {
+	var clientData webAuthnClientData
+	if err := json.Unmarshal(clientDataJSON, &clientData); err != nil {
+		return fmt.Errorf("invalid webauthn client data: %w", err)
+	}
+
+	if clientData.Type != webAuthnGetType {
+		return fmt.Errorf("invalid webauthn client data type %q, expected %q", clientData.Type, webAuthnGetType)
+	}
+	if clientData.Origin != w.config.Origin || clientData.CrossOrigin {
+		return fmt.Errorf("invalid webauthn origin %q, expected %q", clientData.Origin, w.config.Origin)
+	}
+	if clientData.Challenge != base64.RawURLEncoding.EncodeToString(signBytes) {
+		return fmt.Errorf("webauthn challenge does not match the sign bytes")
+	}
+
+	return nil
+}
+
+// verifyAuthenticatorData ensures the authenticator data is scoped to the configured relying party
+// and that the user is present, and verified when required.
+func (w WebAuthn) verifyAuthenticatorData(authData []byte) error {
+	if len(authData) < webAuthnMinAuthDataSize {
+		return fmt.Errorf("invalid webauthn authenticator data size %d, expected at least %d", len(authData), webAuthnMinAuthDataSize)
+	}
+
+	rpIdHash := sha256.Sum256([]byte(w.config.RpId))
+	if !bytes.Equal(authData[:sha256.Size], rpIdHash[:]) {
+		return fmt.Errorf("webauthn rp id hash does not match %q", w.config.RpId)
+	}
+
+	flags := authData[sha256.Size]
+	if flags&webAuthnFlagUserPresent == 0 {
+		return fmt.Errorf("webauthn user is not present")
+	}
+	if w.config.RequireUserVerification && flags&webAuthnFlagUserVerified == 0 {
+		return fmt.Errorf("webauthn user is not verified")
+	}
+
+	return nil
+}
+
+func (w WebAuthn) Track(ctx sdk.Context, request AuthenticationRequest) error {
+	return nil
+}
+
+func (w WebAuthn) ConfirmExecution(ctx sdk.Context, request AuthenticationRequest) error {
+	return nil
+}
+
+// OnAuthenticatorAdded ensures the configuration holds a valid P-256 public key, a relying party
+// and an origin.
+func (w WebAuthn) OnAuthenticatorAdded(ctx sdk.Context, account sdk.AccAddress, config []byte, authenticatorId string) error {
+	_, _, err := parseWebAuthnConfig(config)
+	return err
+}
+
+func (w WebAuthn) OnAuthenticatorRemoved(ctx sdk.Context, account sdk.AccAddress, config []byte, authenticatorId string) error {
+	return nil
+}
+
+// parseWebAuthnConfig parses the JSON configuration of a WebAuthn authenticator and its public key.
+func parseWebAuthnConfig(config []byte) (WebAuthnConfig, *ecdsa.PublicKey, error) {
+	var cfg WebAuthnConfig
+	if err := json.Unmarshal(config, &cfg); err != nil {
+		return WebAuthnConfig{}, nil, errorsmod.Wrap(err, "invalid webauthn config")
+	}
+
+	if cfg.RpId == "" {
+		return WebAuthnConfig{}, nil, fmt.Errorf("invalid webauthn config: rp_id is empty")
+	}
+	if cfg.Origin == "" {
+		return WebAuthnConfig{}, nil, fmt.Errorf("invalid webauthn config: origin is empty")
+	}
+
+	x, y := elliptic.UnmarshalCompressed(elliptic.P256(), cfg.PublicKey)
+	if x == nil {
+		return WebAuthnConfig{}, nil, errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "invalid webauthn config: public_key must be a compressed P-256 public key")
+	}
+
+	return cfg, &ecdsa.PublicKey{Curve: elliptic.P256(), X: x, Y: y}, nil
+}
@@ -0,0 +1,220 @@
+package authenticator_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/bitsongofficial/go-bitsong/x/smart-account/authenticator"
+)
+
+const (
+	webAuthnTestRpId   = "app.bitsong.io"
+	webAuthnTestOrigin = "https://app.bitsong.io"
+)
+
+type WebAuthnAuthenticatorSuite struct {
+	BaseAuthenticatorSuite
+
+	WebAuthn authenticator.WebAuthn
+	PrivKey  *ecdsa.PrivateKey
+}
+
+func TestWebAuthnAuthenticatorSuite(t *testing.T) {
+	suite.Run(t, new(WebAuthnAuthenticatorSuite))
+}
+
+func (s *WebAuthnAuthenticatorSuite) SetupTest() {
+	s.SetupKeys()
+	s.WebAuthn = authenticator.NewWebAuthn(s.BitsongApp.AppKeepers.AccountKeeper)
+
+	privKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	s.Require().NoError(err)
+	s.PrivKey = privKey
+}
+
+func (s *WebAuthnAuthenticatorSuite) TearDownTest() {
+	os.RemoveAll(s.HomeDir)
+}
+
+// config returns the JSON configuration of the test credential
+func (s *WebAuthnAuthenticatorSuite) config(rpId, origin string, requireUV bool) []byte {
+	bz, err := json.Marshal(authenticator.WebAuthnConfig{
+		PublicKey:               elliptic.MarshalCompressed(elliptic.P256(), s.PrivKey.X, s.PrivKey.Y),
+		RpId:                    rpId,
+		Origin:                  origin,
+		RequireUserVerification: requireUV,
+	})
+	s.Require().NoError(err)
+	return bz
+}
+
+// assertion signs a WebAuthn assertion of the given challenge with the test credential
+func (s *WebAuthnAuthenticatorSuite) assertion(clientDataType, challenge, origin, rpId string, flags byte) []byte {
+	clientData := []byte(fmt.Sprintf(`{"type":%q,"challenge":%q,"origin":%q,"crossOrigin":false}`, clientDataType, challenge, origin))
+
+	rpIdHash := sha256.Sum256([]byte(rpId))
+	authData := append(rpIdHash[:], flags, 0, 0, 0, 1)
+
+	clientDataHash := sha256.Sum256(clientData)
+	digest := sha256.Sum256(append(append([]byte{}, authData...), clientDataHash[:]...))
+	r, sigS, err := ecdsa.Sign(rand.Reader, s.PrivKey, digest[:])
+	s.Require().NoError(err)
+
+	bz, err := json.Marshal(authenticator.WebAuthnSignature{
+		AuthenticatorData: authData,
+		ClientDataJSON:    clientData,
+		Signature:         s.encodeSignature(r, sigS),
+	})
+	s.Require().NoError(err)
+	return bz
+}
+
+// encodeSignature encodes an ECDSA signature in ASN.1
+func (s *WebAuthnAuthenticatorSuite) encodeSignature(r, sigS *big.Int) []byte {
+	bz, err := asn1.Marshal(struct{ R, S *big.Int }{r, sigS})
+	s.Require().NoError(err)
+	return bz
+}
+
+func (s *WebAuthnAuthenticatorSuite) TestAuthenticate() {
+	signBytes := []byte("sign bytes of the transaction")
+	challenge := base64.RawURLEncoding.EncodeToString(signBytes)
+
+	tests := []struct {
+		name      string
+		requireUV bool
+		signature []byte
+		simulate  bool
+		success   bool
+	}{
+		{"valid assertion", false, s.assertion("webauthn.get", challenge, webAuthnTestOrigin, webAuthnTestRpId, 0x01), false, true},
+		{"valid assertion with user verification", true, s.assertion("webauthn.get", challenge, webAuthnTestOrigin, webAuthnTestRpId, 0x05), false, true},
+		{"user not verified", true, s.assertion("webauthn.get", challenge, webAuthnTestOrigin, webAuthnTestRpId, 0x01), false, false},
+		{"user not present", false, s.assertion("webauthn.get", challenge, webAuthnTestOrigin, webAuthnTestRpId, 0x04), false, false},
+		{"wrong origin", false, s.assertion("webauthn.get", challenge, "https://evil.io", webAuthnTestRpId, 0x01), false, false},
+		{"wrong rp id", false, s.assertion("webauthn.get", challenge, webAuthnTestOrigin, "evil.io", 0x01), false, false},
+		{"wrong challenge", false, s.assertion("webauthn.get", base64.RawURLEncoding.EncodeToString([]byte("other")), webAuthnTestOrigin, webAuthnTestRpId, 0x01), false, false},
+		{"wrong type", false, s.assertion("webauthn.create", challenge, webAuthnTestOrigin, webAuthnTestRpId, 0x01), false, false},
+		{"invalid signature", false, []byte("not an assertion"), false, false},
+		{"simulation", false, []byte("not an assertion"), true, true},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			auth, err := s.WebAuthn.Initialize(s.config(webAuthnTestRpId, webAuthnTestOrigin, tc.requireUV))
+			s.Require().NoError(err)
+
+			request := authenticator.AuthenticationRequest{
+				Signature:      tc.signature,
+				SignModeTxData: authenticator.SignModeData{Direct: signBytes},
+				Simulate:       tc.simulate,
+			}
+			err = auth.Authenticate(s.Ctx, request)
+			if tc.success {
+				s.Require().NoError(err)
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (s *WebAuthnAuthenticatorSuite) TestTamperedSignature() {
+	signBytes := []byte("sign bytes of the transaction")
+	bz := s.assertion("webauthn.get", base64.RawURLEncoding.EncodeToString(signBytes), webAuthnTestOrigin, webAuthnTestRpId, 0x01)
+
+	var assertion authenticator.WebAuthnSignature
+	s.Require().NoError(json.Unmarshal(bz, &assertion))
+	assertion.AuthenticatorData[len(assertion.AuthenticatorData)-1]++
+	bz, err := json.Marshal(assertion)
+	s.Require().NoError(err)
+
+	auth, err := s.WebAuthn.Initialize(s.config(webAuthnTestRpId, webAuthnTestOrigin, false))
+	s.Require().NoError(err)
+
+	err = auth.Authenticate(s.Ctx, authenticator.AuthenticationRequest{
+		Signature:      bz,
+		SignModeTxData: authenticator.SignModeData{Direct: signBytes},
+	})
+	s.Require().ErrorContains(err, "webauthn signature verification failed")
+}
+
+// TestHighSSignature ensures a signature verifies whether its s is in the lower or the upper half of
+// the curve order, as passkeys produce both.
+func (s *WebAuthnAuthenticatorSuite) TestHighSSignature() {
+	signBytes := []byte("sign bytes of the transaction")
+	bz := s.assertion("webauthn.get", base64.RawURLEncoding.EncodeToString(signBytes), webAuthnTestOrigin, webAuthnTestRpId, 0x01)
+
+	auth, err := s.WebAuthn.Initialize(s.config(webAuthnTestRpId, webAuthnTestOrigin, false))
+	s.Require().NoError(err)
+	request := authenticator.AuthenticationRequest{
+		Signature:      bz,
+		SignModeTxData: authenticator.SignModeData{Direct: signBytes},
+	}
+
+	var assertion authenticator.WebAuthnSignature
+	s.Require().NoError(json.Unmarshal(bz, &assertion))
+	var sig struct{ R, S *big.Int }
+	_, err = asn1.Unmarshal(assertion.Signature, &sig)
+	s.Require().NoError(err)
+
+	n := elliptic.P256().Params().N
+	lowS, highS := sig.S, new(big.Int).Sub(n, sig.S)
+	if lowS.Cmp(new(big.Int).Rsh(n, 1)) > 0 {
+		lowS, highS = highS, lowS
+	}
+
+	for _, sigS := range []*big.Int{lowS, highS} {
+		assertion.Signature = s.encodeSignature(sig.R, sigS)
+		request.Signature, err = json.Marshal(assertion)
+		s.Require().NoError(err)
+		s.Require().NoError(auth.Authenticate(s.Ctx, request))
+	}
+
+	// The signature does not verify for other sign bytes
+	request.SignModeTxData = authenticator.SignModeData{Direct: []byte("other sign bytes")}
+	s.Require().Error(auth.Authenticate(s.Ctx, request))
+
+	// An s out of the curve order is rejected
+	assertion.Signature = s.encodeSignature(sig.R, new(big.Int).Add(n, lowS))
+	request.Signature, err = json.Marshal(assertion)
+	s.Require().NoError(err)
+	request.SignModeTxData = authenticator.SignModeData{Direct: signBytes}
+	s.Require().ErrorContains(auth.Authenticate(s.Ctx, request), "webauthn signature verification failed")
+}
+
+func (s *WebAuthnAuthenticatorSuite) TestOnAuthenticatorAdded() {
+	tests := []struct {
+		name   string
+		config []byte
+		valid  bool
+	}{
+		{"valid config", s.config(webAuthnTestRpId, webAuthnTestOrigin, false), true},
+		{"empty rp id", s.config("", webAuthnTestOrigin, false), false},
+		{"empty origin", s.config(webAuthnTestRpId, "", false), false},
+		{"invalid public key", []byte(fmt.Sprintf(`{"public_key":"AQID","rp_id":%q,"origin":%q}`, webAuthnTestRpId, webAuthnTestOrigin)), false},
+		{"invalid json", []byte("{"), false},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			err := s.WebAuthn.OnAuthenticatorAdded(s.Ctx, s.TestAccAddress[0], tc.config, "1")
+			if tc.valid {
+				s.Require().NoError(err)
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
@@ -12,6 +12,7 @@ func GetQueryCmd() *cobra.Command {
 	cmd := btsgcli.QueryIndexCmd(types.ModuleName)
 	btsgcli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdAuthenticators)
 	btsgcli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdAuthenticator)
+	btsgcli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdSpendLimit)
 	btsgcli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdParams)
 
 	return cmd
@@ -35,6 +36,15 @@ func GetCmdAuthenticator() (*btsgcli.QueryDescriptor, *types.GetAuthenticatorReq
 	}, &types.GetAuthenticatorRequest{}
 }
 
+func GetCmdSpendLimit() (*btsgcli.QueryDescriptor, *types.GetSpendLimitRequest) {
+	return &btsgcli.QueryDescriptor{
+		Use:   "spend-limit",
+		Short: "Query the remaining allowance of a spend limit authenticator by account and id",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} bitsong12smx2wdlyttvyzvzg54y2vnqwq2qjateuf7thj 17.1`,
+	}, &types.GetSpendLimitRequest{}
+}
+
 func GetCmdParams() (*btsgcli.QueryDescriptor, *types.QueryParamsRequest) {
 	return &btsgcli.QueryDescriptor{
 		Use:   "params",
@@ -38,6 +38,12 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 			}
 		}
 	}
+
+	for _, usage := range genState.SpendLimitUsages {
+		if err := k.SetSpendLimitUsage(ctx, usage); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis returns the module's exported genesis
@@ -52,5 +58,11 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	}
 	genesis.AuthenticatorData = allAuthenticators
 
+	spendLimitUsages, err := k.GetAllSpendLimitUsages(ctx)
+	if err != nil {
+		panic(err)
+	}
+	genesis.SpendLimitUsages = spendLimitUsages
+
 	return genesis
 }
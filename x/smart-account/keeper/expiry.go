@@ -0,0 +1,135 @@
+package keeper
+
+import (
+	"strconv"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/bitsongofficial/go-bitsong/x/smart-account/authenticator"
+	"github.com/bitsongofficial/go-bitsong/x/smart-account/types"
+)
+
+// MaxExpiredAuthenticatorsPerBlock is the maximum number of expired authenticators removed in a
+// block, the remaining ones are removed in the next blocks.
+const MaxExpiredAuthenticatorsPerBlock = 100
+
+// ensureNotExpired ensures the expiry of the authenticator is after the current block time if it is an
+// expiring authenticator. It is only checked when an account adds an authenticator, the expired
+// authenticators imported from genesis are removed in the first block.
+func (k Keeper) ensureNotExpired(ctx sdk.Context, impl authenticator.Authenticator, config []byte) error {
+	expiring, ok := impl.(authenticator.ExpiringAuthenticator)
+	if !ok {
+		return nil
+	}
+
+	expiry, err := expiring.Expiry(config)
+	if err != nil {
+		return err
+	}
+	if !expiry.After(ctx.BlockTime()) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "authenticator expiry %s must be after the current block time %s", expiry, ctx.BlockTime())
+	}
+	return nil
+}
+
+// setAuthenticatorExpiry indexes the authenticator by expiry if it is an expiring authenticator.
+func (k Keeper) setAuthenticatorExpiry(ctx sdk.Context, impl authenticator.Authenticator, account sdk.AccAddress, config []byte, id uint64) error {
+	expiring, ok := impl.(authenticator.ExpiringAuthenticator)
+	if !ok {
+		return nil
+	}
+
+	expiry, err := expiring.Expiry(config)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(types.KeyAuthenticatorExpiry(expiry, account, id), []byte{0x01})
+	return nil
+}
+
+// deleteAuthenticatorExpiry deletes the expiry index of the authenticator if it is an expiring
+// authenticator.
+func (k Keeper) deleteAuthenticatorExpiry(ctx sdk.Context, impl authenticator.Authenticator, account sdk.AccAddress, config []byte, id uint64) {
+	expiring, ok := impl.(authenticator.ExpiringAuthenticator)
+	if !ok {
+		return
+	}
+
+	expiry, err := expiring.Expiry(config)
+	if err != nil {
+		return
+	}
+	ctx.KVStore(k.storeKey).Delete(types.KeyAuthenticatorExpiry(expiry, account, id))
+}
+
+type expiredAuthenticator struct {
+	key     []byte
+	account sdk.AccAddress
+	id      uint64
+}
+
+// RemoveExpiredAuthenticators removes from their accounts the authenticators whose expiry is before
+// the current block time, so that accounts do not accumulate dead keys. An authenticator which fails
+// to be removed is logged and dropped from the expiry index, it can still be removed by its account.
+// The malformed entries of the index are deleted, and count towards the maximum per block.
+func (k Keeper) RemoveExpiredAuthenticators(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	blockTime := sdk.FormatTimeString(ctx.BlockTime())
+
+	var expired []expiredAuthenticator
+	var malformed [][]byte
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyAuthenticatorExpiryPrefixId())
+	for ; iterator.Valid() && len(expired)+len(malformed) < MaxExpiredAuthenticatorsPerBlock; iterator.Next() {
+		// The key is formatted as prefix|expiry|account|id|
+		parts := strings.Split(string(iterator.Key()), types.KeySeparator)
+		if len(parts) < 4 {
+			k.Logger(ctx).Error("invalid authenticator expiry index", "key", string(iterator.Key()))
+			malformed = append(malformed, iterator.Key())
+			continue
+		}
+		if parts[1] >= blockTime {
+			break
+		}
+
+		account, err := sdk.AccAddressFromBech32(parts[2])
+		if err != nil {
+			k.Logger(ctx).Error("invalid account in authenticator expiry index", "key", string(iterator.Key()), "error", err)
+			malformed = append(malformed, iterator.Key())
+			continue
+		}
+		id, err := strconv.ParseUint(parts[3], 10, 64)
+		if err != nil {
+			k.Logger(ctx).Error("invalid id in authenticator expiry index", "key", string(iterator.Key()), "error", err)
+			malformed = append(malformed, iterator.Key())
+			continue
+		}
+		expired = append(expired, expiredAuthenticator{key: iterator.Key(), account: account, id: id})
+	}
+	iterator.Close()
+
+	for _, key := range malformed {
+		store.Delete(key)
+	}
+
+	for _, auth := range expired {
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.RemoveAuthenticator(cacheCtx, auth.account, auth.id); err != nil {
+			k.Logger(ctx).Error("failed to remove expired authenticator", "account", auth.account, "id", auth.id, "error", err)
+			store.Delete(auth.key)
+			continue
+		}
+		write()
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeAuthenticatorExpired,
+				sdk.NewAttribute(types.AttributeKeyAccount, auth.account.String()),
+				sdk.NewAttribute(types.AttributeKeyAuthenticatorId, strconv.FormatUint(auth.id, 10)),
+			),
+		)
+	}
+}
@@ -0,0 +1,137 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bitsongofficial/go-bitsong/x/smart-account/authenticator"
+	"github.com/bitsongofficial/go-bitsong/x/smart-account/keeper"
+	"github.com/bitsongofficial/go-bitsong/x/smart-account/types"
+)
+
+func (s *KeeperTestSuite) TestKeeper_RemoveExpiredAuthenticators() {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	ctx := s.Ctx.WithBlockTime(now)
+	smartAccountKeeper := s.App.AppKeepers.SmartAccountKeeper
+
+	// Set up account
+	key := "6cf5103c60c939a5f38e383b52239c5296c968579eec1c68a47d70fbf1d19159"
+	bz, _ := hex.DecodeString(key)
+	priv := &secp256k1.PrivKey{Key: bz}
+	accAddress := sdk.AccAddress(priv.PubKey().Address())
+
+	sessionConfig := func(end time.Time) []byte {
+		config, err := json.Marshal(authenticator.TimeWindowConfig{
+			End: end,
+			SubAuthenticator: authenticator.SubAuthenticatorInitData{
+				Type:   authenticator.SignatureVerificationType,
+				Config: priv.PubKey().Bytes(),
+			},
+		})
+		s.Require().NoError(err)
+		return config
+	}
+
+	permanentId, err := smartAccountKeeper.AddAuthenticator(ctx, accAddress, authenticator.SignatureVerificationType, priv.PubKey().Bytes())
+	s.Require().NoError(err)
+	shortId, err := smartAccountKeeper.AddAuthenticator(ctx, accAddress, authenticator.TimeWindowType, sessionConfig(now.Add(time.Hour)))
+	s.Require().NoError(err)
+	longId, err := smartAccountKeeper.AddAuthenticator(ctx, accAddress, authenticator.TimeWindowType, sessionConfig(now.Add(2*time.Hour)))
+	s.Require().NoError(err)
+	removedId, err := smartAccountKeeper.AddAuthenticator(ctx, accAddress, authenticator.TimeWindowType, sessionConfig(now.Add(time.Hour)))
+	s.Require().NoError(err)
+
+	// A window which already ended cannot be added
+	_, err = smartAccountKeeper.AddAuthenticator(ctx, accAddress, authenticator.TimeWindowType, sessionConfig(now.Add(-time.Hour)))
+	s.Require().Error(err)
+
+	// An authenticator removed by its account is dropped from the expiry index
+	s.Require().NoError(smartAccountKeeper.RemoveAuthenticator(ctx, accAddress, removedId))
+
+	authenticatorIds := func(ctx sdk.Context) []uint64 {
+		authenticators, err := smartAccountKeeper.GetAuthenticatorDataForAccount(ctx, accAddress)
+		s.Require().NoError(err)
+
+		ids := []uint64{}
+		for _, auth := range authenticators {
+			ids = append(ids, auth.Id)
+		}
+		return ids
+	}
+
+	// Nothing is removed while the windows are open, including at their end
+	smartAccountKeeper.RemoveExpiredAuthenticators(ctx.WithBlockTime(now.Add(time.Hour)))
+	s.Require().ElementsMatch([]uint64{permanentId, shortId, longId}, authenticatorIds(ctx))
+
+	ctx = ctx.WithBlockTime(now.Add(time.Hour + time.Second)).WithEventManager(sdk.NewEventManager())
+	smartAccountKeeper.RemoveExpiredAuthenticators(ctx)
+	s.Require().ElementsMatch([]uint64{permanentId, longId}, authenticatorIds(ctx))
+	s.Require().Len(ctx.EventManager().Events(), 1)
+
+	ctx = ctx.WithBlockTime(now.Add(3 * time.Hour))
+	smartAccountKeeper.RemoveExpiredAuthenticators(ctx)
+	s.Require().ElementsMatch([]uint64{permanentId}, authenticatorIds(ctx))
+
+	// A window which already ended is imported from genesis, and removed in the next block
+	importedId := uint64(100)
+	s.Require().NoError(smartAccountKeeper.AddAuthenticatorWithId(ctx, accAddress, authenticator.TimeWindowType, sessionConfig(now), importedId))
+	s.Require().ElementsMatch([]uint64{permanentId, importedId}, authenticatorIds(ctx))
+	smartAccountKeeper.RemoveExpiredAuthenticators(ctx)
+	s.Require().ElementsMatch([]uint64{permanentId}, authenticatorIds(ctx))
+}
+
+func (s *KeeperTestSuite) TestKeeper_RemoveMalformedExpiryIndex() {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	ctx := s.Ctx.WithBlockTime(now)
+	smartAccountKeeper := s.App.AppKeepers.SmartAccountKeeper
+	store := ctx.KVStore(s.App.AppKeepers.GetKVStoreKey()[types.StoreKey])
+
+	// Set up account
+	key := "6cf5103c60c939a5f38e383b52239c5296c968579eec1c68a47d70fbf1d19159"
+	bz, _ := hex.DecodeString(key)
+	priv := &secp256k1.PrivKey{Key: bz}
+	accAddress := sdk.AccAddress(priv.PubKey().Address())
+
+	config, err := json.Marshal(authenticator.TimeWindowConfig{
+		End: now.Add(2 * time.Hour),
+		SubAuthenticator: authenticator.SubAuthenticatorInitData{
+			Type:   authenticator.SignatureVerificationType,
+			Config: priv.PubKey().Bytes(),
+		},
+	})
+	s.Require().NoError(err)
+	sessionId, err := smartAccountKeeper.AddAuthenticator(ctx, accAddress, authenticator.TimeWindowType, config)
+	s.Require().NoError(err)
+
+	// Index malformed entries expiring before the session
+	for i := 0; i <= keeper.MaxExpiredAuthenticatorsPerBlock; i++ {
+		store.Set(types.BuildKey(types.KeyAuthenticatorExpiryPrefix, sdk.FormatTimeString(now.Add(time.Hour)), "invalid", i), []byte{0x01})
+	}
+	indexSize := func() int {
+		iterator := storetypes.KVStorePrefixIterator(store, types.KeyAuthenticatorExpiryPrefixId())
+		defer iterator.Close()
+
+		size := 0
+		for ; iterator.Valid(); iterator.Next() {
+			size++
+		}
+		return size
+	}
+
+	// The malformed entries count towards the maximum per block and are deleted
+	ctx = ctx.WithBlockTime(now.Add(3 * time.Hour))
+	smartAccountKeeper.RemoveExpiredAuthenticators(ctx)
+	s.Require().Equal(2, indexSize())
+	_, err = smartAccountKeeper.GetSelectedAuthenticatorData(ctx, accAddress, int(sessionId))
+	s.Require().NoError(err)
+
+	smartAccountKeeper.RemoveExpiredAuthenticators(ctx)
+	s.Require().Equal(0, indexSize())
+	_, err = smartAccountKeeper.GetSelectedAuthenticatorData(ctx, accAddress, int(sessionId))
+	s.Require().Error(err)
+}
@@ -1,12 +1,14 @@
 package keeper
 
 import (
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/bitsongofficial/go-bitsong/x/smart-account/authenticator"
 	"github.com/bitsongofficial/go-bitsong/x/smart-account/types"
 
 	storetypes "cosmossdk.io/store/types"
@@ -68,6 +70,9 @@ func (k Keeper) AddAuthenticatorWithId(ctx sdk.Context, account sdk.AccAddress,
 	if err != nil {
 		return err
 	}
+	if err := k.setAuthenticatorExpiry(ctx, impl, account, config, id); err != nil {
+		return err
+	}
 	types.MustSet(ctx.KVStore(k.storeKey),
 		types.KeyAccountId(account, id),
 		&types.AccountAuthenticator{
@@ -77,3 +82,65 @@ func (k Keeper) AddAuthenticatorWithId(ctx sdk.Context, account sdk.AccAddress,
 		})
 	return nil
 }
+
+// GetAllSpendLimitUsages is used in genesis export to export the usage of all the spend limit
+// authenticators
+func (k Keeper) GetAllSpendLimitUsages(ctx sdk.Context) ([]types.SpendLimitUsageData, error) {
+	usages := []types.SpendLimitUsageData{}
+
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.BuildKey(types.KeySpendLimitUsagePrefix))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		// The key is formatted as prefix|account|id|
+		parts := strings.Split(string(iterator.Key()), types.KeySeparator)
+		if len(parts) < 3 {
+			return nil, fmt.Errorf("invalid spend limit usage key %s", iterator.Key())
+		}
+
+		var usage authenticator.SpendLimitUsage
+		if err := json.Unmarshal(iterator.Value(), &usage); err != nil {
+			return nil, err
+		}
+
+		entries := make([]types.SpendLimitEntry, 0, len(usage.Entries))
+		for _, entry := range usage.Entries {
+			entries = append(entries, types.SpendLimitEntry{Time: entry.Time, Amount: entry.Amount})
+		}
+
+		usages = append(usages, types.SpendLimitUsageData{
+			Address:         parts[1],
+			AuthenticatorId: parts[2],
+			Limits:          usage.Limits,
+			Period:          string(usage.Period),
+			Entries:         entries,
+		})
+	}
+
+	return usages, nil
+}
+
+// SetSpendLimitUsage sets the usage of a spend limit authenticator, this function is used in genesis
+// import since the usage stored when the authenticator is added is discarded
+func (k Keeper) SetSpendLimitUsage(ctx sdk.Context, data types.SpendLimitUsageData) error {
+	spendLimit, ok := k.AuthenticatorManager.GetAuthenticatorByType(authenticator.SpendLimitType).(authenticator.SpendLimit)
+	if !ok {
+		return fmt.Errorf("authenticator type %s is not registered", authenticator.SpendLimitType)
+	}
+
+	account, err := sdk.AccAddressFromBech32(data.Address)
+	if err != nil {
+		return err
+	}
+
+	entries := make([]authenticator.SpendLimitEntry, 0, len(data.Entries))
+	for _, entry := range data.Entries {
+		entries = append(entries, authenticator.SpendLimitEntry{Time: entry.Time, Amount: entry.Amount})
+	}
+
+	return spendLimit.SetUsage(ctx, account, data.AuthenticatorId, authenticator.SpendLimitUsage{
+		Limits:  data.Limits,
+		Period:  authenticator.SpendLimitPeriod(data.Period),
+		Entries: entries,
+	})
+}
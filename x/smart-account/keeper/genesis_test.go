@@ -2,11 +2,14 @@ package keeper_test
 
 import (
 	"encoding/hex"
+	"encoding/json"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/bitsongofficial/go-bitsong/x/smart-account/authenticator"
+	"github.com/bitsongofficial/go-bitsong/x/smart-account/types"
 )
 
 func (s *KeeperTestSuite) TestKeeper_AddAuthenticatorWithId() {
@@ -93,3 +96,66 @@ func (s *KeeperTestSuite) TestKeeper_GetAllAuthenticatorDataGenesis() {
 	s.Require().Equal(5, len(authenticators[0].Authenticators), "Getting authenticators returning incorrect data")
 	s.Require().Equal(accAddress.String(), authenticators[0].Address, "Authenticator Address is incorrect")
 }
+
+func (s *KeeperTestSuite) TestKeeper_SpendLimitUsageGenesis() {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	ctx := s.Ctx.WithBlockTime(now)
+	smartAccountKeeper := s.App.AppKeepers.SmartAccountKeeper
+
+	// Set up account
+	key := "6cf5103c60c939a5f38e383b52239c5296c968579eec1c68a47d70fbf1d19159"
+	bz, _ := hex.DecodeString(key)
+	priv := &secp256k1.PrivKey{Key: bz}
+	accAddress := sdk.AccAddress(priv.PubKey().Address())
+
+	limits := sdk.NewCoins(sdk.NewInt64Coin("ubtsg", 1000))
+	spendLimitConfig, err := json.Marshal(authenticator.SpendLimitConfig{Limits: limits, Period: authenticator.SpendLimitPeriodDay})
+	s.Require().NoError(err)
+	allOfConfig, err := json.Marshal([]authenticator.SubAuthenticatorInitData{
+		{Type: authenticator.SignatureVerificationType, Config: priv.PubKey().Bytes()},
+		{Type: authenticator.SpendLimitType, Config: spendLimitConfig},
+	})
+	s.Require().NoError(err)
+
+	// The usage is not stored when the authenticator is imported
+	s.Require().NoError(smartAccountKeeper.AddAuthenticatorWithId(ctx, accAddress, "AllOf", allOfConfig, 1))
+	usages, err := smartAccountKeeper.GetAllSpendLimitUsages(ctx)
+	s.Require().NoError(err)
+	s.Require().Empty(usages)
+
+	// The query falls back to an empty usage of the limits of the authenticator
+	res, err := smartAccountKeeper.GetSpendLimit(ctx, &types.GetSpendLimitRequest{Account: accAddress.String(), AuthenticatorId: "1.1"})
+	s.Require().NoError(err)
+	s.Require().Equal(limits, res.Limits)
+	s.Require().Empty(res.Spent)
+	s.Require().Equal(limits, res.Remaining)
+	s.Require().Nil(res.PeriodEnd)
+
+	for _, id := range []string{"1.0", "1.2", "2.1", "invalid"} {
+		_, err = smartAccountKeeper.GetSpendLimit(ctx, &types.GetSpendLimitRequest{Account: accAddress.String(), AuthenticatorId: id})
+		s.Require().Error(err, id)
+	}
+
+	// The usage is imported and exported as is
+	usage := types.SpendLimitUsageData{
+		Address:         accAddress.String(),
+		AuthenticatorId: "1.1",
+		Limits:          limits,
+		Period:          string(authenticator.SpendLimitPeriodDay),
+		Entries: []types.SpendLimitEntry{
+			{Time: now.Add(-2 * time.Hour), Amount: sdk.NewCoins(sdk.NewInt64Coin("ubtsg", 100))},
+			{Time: now.Add(-time.Hour), Amount: sdk.NewCoins(sdk.NewInt64Coin("ubtsg", 300))},
+		},
+	}
+	s.Require().NoError(smartAccountKeeper.SetSpendLimitUsage(ctx, usage))
+
+	usages, err = smartAccountKeeper.GetAllSpendLimitUsages(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal([]types.SpendLimitUsageData{usage}, usages)
+
+	res, err = smartAccountKeeper.GetSpendLimit(ctx, &types.GetSpendLimitRequest{Account: accAddress.String(), AuthenticatorId: "1.1"})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("ubtsg", 400)), res.Spent)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("ubtsg", 600)), res.Remaining)
+	s.Require().Equal(now.Add(22*time.Hour), *res.PeriodEnd)
+}
@@ -186,6 +186,11 @@ func (k Keeper) AddAuthenticator(ctx sdk.Context, account sdk.AccAddress, authen
 		return 0, fmt.Errorf("authenticator type %s is not registered", authenticatorType)
 	}
 
+	// Ensure an expiring authenticator has not already expired
+	if err := k.ensureNotExpired(ctx, impl, config); err != nil {
+		return 0, errorsmod.Wrapf(err, "failed to add authenticator type %s", authenticatorType)
+	}
+
 	// Get the next global id value for authenticators from the store
 	id := k.InitializeOrGetNextAuthenticatorId(ctx)
 
@@ -195,6 +200,10 @@ func (k Keeper) AddAuthenticator(ctx sdk.Context, account sdk.AccAddress, authen
 		return 0, errorsmod.Wrapf(err, "`OnAuthenticatorAdded` failed on authenticator type %s", authenticatorType)
 	}
 
+	if err := k.setAuthenticatorExpiry(ctx, impl, account, config, id); err != nil {
+		return 0, errorsmod.Wrapf(err, "failed to index the expiry of authenticator type %s", authenticatorType)
+	}
+
 	k.SetNextAuthenticatorId(ctx, id+1)
 
 	types.MustSet(ctx.KVStore(k.storeKey),
@@ -231,6 +240,7 @@ func (k Keeper) RemoveAuthenticator(ctx sdk.Context, account sdk.AccAddress, aut
 		return errorsmod.Wrapf(err, "`OnAuthenticatorRemoved` failed on authenticator type %s", existing.Type)
 	}
 
+	k.deleteAuthenticatorExpiry(ctx, impl, account, existing.Config, authenticatorId)
 	store.Delete(key)
 	return nil
 }
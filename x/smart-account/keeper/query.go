@@ -2,11 +2,16 @@ package keeper
 
 import (
 	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/bitsongofficial/go-bitsong/x/smart-account/authenticator"
 	"github.com/bitsongofficial/go-bitsong/x/smart-account/types"
 )
 
@@ -55,3 +60,101 @@ func (k Keeper) GetAuthenticator(
 
 	return &types.GetAuthenticatorResponse{AccountAuthenticator: authenticator}, nil
 }
+
+func (k Keeper) GetSpendLimit(
+	ctx context.Context,
+	request *types.GetSpendLimitRequest,
+) (*types.GetSpendLimitResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	acc, err := sdk.AccAddressFromBech32(request.Account)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	spendLimit, ok := k.AuthenticatorManager.GetAuthenticatorByType(authenticator.SpendLimitType).(authenticator.SpendLimit)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "spend limit authenticator is not registered")
+	}
+
+	usage, found, err := spendLimit.GetStoredUsage(sdkCtx, acc, request.AuthenticatorId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// Fall back to an empty usage of the limits of the authenticator, nothing is spent until its
+	// first execution
+	if !found {
+		initialized, err := k.getSpendLimit(sdkCtx, acc, request.AuthenticatorId)
+		if err != nil {
+			return nil, status.Errorf(codes.NotFound, "no spend limit for authenticator %s of account %s: %s", request.AuthenticatorId, request.Account, err)
+		}
+
+		usage, err = initialized.GetUsage(sdkCtx, acc, request.AuthenticatorId)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	// Only the entries in the rolling period ending at the block time are spent
+	usage = usage.Prune(sdkCtx.BlockTime())
+	var periodEnd *time.Time
+	if release, ok := usage.NextRelease(); ok {
+		periodEnd = &release
+	}
+
+	return &types.GetSpendLimitResponse{
+		Limits:    usage.Limits,
+		Spent:     usage.Spent(),
+		Remaining: usage.Remaining(),
+		Period:    string(usage.Period),
+		PeriodEnd: periodEnd,
+	}, nil
+}
+
+// getSpendLimit returns the initialized spend limit authenticator of the account with the given id,
+// which is the composite id of a sub-authenticator, e.g. 17.1.
+func (k Keeper) getSpendLimit(ctx sdk.Context, account sdk.AccAddress, authenticatorId string) (authenticator.SpendLimit, error) {
+	ids := strings.Split(authenticatorId, ".")
+	id, err := strconv.ParseUint(ids[0], 10, 64)
+	if err != nil {
+		return authenticator.SpendLimit{}, err
+	}
+
+	initialized, err := k.GetInitializedAuthenticatorForAccount(ctx, account, int(id))
+	if err != nil {
+		return authenticator.SpendLimit{}, err
+	}
+
+	// Walk down the sub-authenticators of the composite id
+	auth := initialized.Authenticator
+	for _, subId := range ids[1:] {
+		var subAuthenticators []authenticator.Authenticator
+		switch composite := auth.(type) {
+		case authenticator.AllOf:
+			subAuthenticators = composite.SubAuthenticators
+		case authenticator.AnyOf:
+			subAuthenticators = composite.SubAuthenticators
+		case authenticator.TimeWindow:
+			subAuthenticators = []authenticator.Authenticator{composite.SubAuthenticator}
+		}
+
+		position, err := strconv.Atoi(subId)
+		if err != nil {
+			return authenticator.SpendLimit{}, err
+		}
+		if position < 0 || position >= len(subAuthenticators) {
+			return authenticator.SpendLimit{}, fmt.Errorf("sub-authenticator %s not found", authenticatorId)
+		}
+		auth = subAuthenticators[position]
+	}
+
+	spendLimit, ok := auth.(authenticator.SpendLimit)
+	if !ok {
+		return authenticator.SpendLimit{}, fmt.Errorf("authenticator %s is not a spend limit", authenticatorId)
+	}
+	return spendLimit, nil
+}
@@ -5,17 +5,23 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "github.com/gogo/protobuf/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -81,6 +87,147 @@ func (m *AuthenticatorData) GetAuthenticators() []AccountAuthenticator {
 	return nil
 }
 
+// SpendLimitEntry represents an amount spent by a spend limit authenticator at
+// a given time.
+type SpendLimitEntry struct {
+	// time is the block time at which the amount was spent.
+	Time time.Time `protobuf:"bytes,1,opt,name=time,proto3,stdtime" json:"time"`
+	// amount is the amount spent.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *SpendLimitEntry) Reset()         { *m = SpendLimitEntry{} }
+func (m *SpendLimitEntry) String() string { return proto.CompactTextString(m) }
+func (*SpendLimitEntry) ProtoMessage()    {}
+func (*SpendLimitEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7c85edf9db7b669, []int{1}
+}
+func (m *SpendLimitEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpendLimitEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpendLimitEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SpendLimitEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpendLimitEntry.Merge(m, src)
+}
+func (m *SpendLimitEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpendLimitEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpendLimitEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpendLimitEntry proto.InternalMessageInfo
+
+func (m *SpendLimitEntry) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *SpendLimitEntry) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// SpendLimitUsageData represents the genesis exported usage of a spend limit
+// authenticator, keyed by its account and its id.
+type SpendLimitUsageData struct {
+	// address is the account of the authenticator.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// authenticator_id is the id of the authenticator, or the composite id of a
+	// sub-authenticator, e.g. 17.1.
+	AuthenticatorId string `protobuf:"bytes,2,opt,name=authenticator_id,json=authenticatorId,proto3" json:"authenticator_id,omitempty"`
+	// limits are the amounts which can be spent in a period.
+	Limits github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=limits,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"limits"`
+	// period is the length of a period: day, week or month.
+	Period string `protobuf:"bytes,4,opt,name=period,proto3" json:"period,omitempty"`
+	// entries are the amounts spent in the rolling period, from the oldest to
+	// the newest.
+	Entries []SpendLimitEntry `protobuf:"bytes,5,rep,name=entries,proto3" json:"entries"`
+}
+
+func (m *SpendLimitUsageData) Reset()         { *m = SpendLimitUsageData{} }
+func (m *SpendLimitUsageData) String() string { return proto.CompactTextString(m) }
+func (*SpendLimitUsageData) ProtoMessage()    {}
+func (*SpendLimitUsageData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7c85edf9db7b669, []int{2}
+}
+func (m *SpendLimitUsageData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpendLimitUsageData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpendLimitUsageData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SpendLimitUsageData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpendLimitUsageData.Merge(m, src)
+}
+func (m *SpendLimitUsageData) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpendLimitUsageData) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpendLimitUsageData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpendLimitUsageData proto.InternalMessageInfo
+
+func (m *SpendLimitUsageData) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *SpendLimitUsageData) GetAuthenticatorId() string {
+	if m != nil {
+		return m.AuthenticatorId
+	}
+	return ""
+}
+
+func (m *SpendLimitUsageData) GetLimits() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Limits
+	}
+	return nil
+}
+
+func (m *SpendLimitUsageData) GetPeriod() string {
+	if m != nil {
+		return m.Period
+	}
+	return ""
+}
+
+func (m *SpendLimitUsageData) GetEntries() []SpendLimitEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
 // GenesisState defines the authenticator module's genesis state.
 type GenesisState struct {
 	// params define the parameters for the authenticator module.
@@ -90,13 +237,15 @@ type GenesisState struct {
 	// authenticator_data contains the data for multiple accounts, each with their
 	// authenticators.
 	AuthenticatorData []AuthenticatorData `protobuf:"bytes,3,rep,name=authenticator_data,json=authenticatorData,proto3" json:"authenticator_data"`
+	// spend_limit_usages contains the usage of the spend limit authenticators.
+	SpendLimitUsages []SpendLimitUsageData `protobuf:"bytes,4,rep,name=spend_limit_usages,json=spendLimitUsages,proto3" json:"spend_limit_usages"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7c85edf9db7b669, []int{1}
+	return fileDescriptor_f7c85edf9db7b669, []int{3}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -146,8 +295,17 @@ func (m *GenesisState) GetAuthenticatorData() []AuthenticatorData {
 	return nil
 }
 
+func (m *GenesisState) GetSpendLimitUsages() []SpendLimitUsageData {
+	if m != nil {
+		return m.SpendLimitUsages
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*AuthenticatorData)(nil), "bitsong.smartaccount.v1beta1.AuthenticatorData")
+	proto.RegisterType((*SpendLimitEntry)(nil), "bitsong.smartaccount.v1beta1.SpendLimitEntry")
+	proto.RegisterType((*SpendLimitUsageData)(nil), "bitsong.smartaccount.v1beta1.SpendLimitUsageData")
 	proto.RegisterType((*GenesisState)(nil), "bitsong.smartaccount.v1beta1.GenesisState")
 }
 
@@ -156,29 +314,43 @@ func init() {
 }
 
 var fileDescriptor_f7c85edf9db7b669 = []byte{
-	// 344 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0x41, 0x4b, 0x02, 0x41,
-	0x14, 0xc7, 0x77, 0x54, 0x8c, 0xc6, 0x08, 0xdc, 0x0a, 0x16, 0x89, 0x4d, 0xa4, 0x83, 0x05, 0xee,
-	0xe2, 0x76, 0xec, 0xa4, 0x04, 0xd1, 0x2d, 0x8c, 0x2e, 0x5d, 0xec, 0xed, 0xce, 0xb8, 0x0e, 0xb8,
-	0x3b, 0xb2, 0xf3, 0x0c, 0xfb, 0x14, 0xf5, 0xb1, 0x3c, 0x7a, 0xec, 0x14, 0xa1, 0xd7, 0x3e, 0x44,
-	0xb8, 0x3b, 0x82, 0x6b, 0x60, 0xdd, 0x66, 0x78, 0xbf, 0xf7, 0xfe, 0xef, 0xff, 0xfe, 0xf4, 0xd2,
-	0x17, 0xa8, 0x64, 0x1c, 0xba, 0x2a, 0x82, 0x04, 0x21, 0x08, 0xe4, 0x24, 0x46, 0xf7, 0xa5, 0xed,
-	0x73, 0x84, 0xb6, 0x1b, 0xf2, 0x98, 0x2b, 0xa1, 0x9c, 0x71, 0x22, 0x51, 0x9a, 0xa7, 0x9a, 0x75,
-	0x36, 0x59, 0x47, 0xb3, 0xb5, 0xe3, 0x50, 0x86, 0x32, 0x05, 0xdd, 0xd5, 0x2b, 0xeb, 0xa9, 0x5d,
-	0xec, 0x9c, 0x3f, 0x86, 0x04, 0x22, 0xf5, 0x2f, 0x34, 0x92, 0x8c, 0x8f, 0x34, 0xda, 0x78, 0x23,
-	0xb4, 0xda, 0x99, 0xe0, 0x90, 0xc7, 0x28, 0x02, 0x40, 0x99, 0xdc, 0x00, 0x82, 0x69, 0xd1, 0x3d,
-	0x60, 0x2c, 0xe1, 0x4a, 0x59, 0xa4, 0x4e, 0x9a, 0xfb, 0xbd, 0xf5, 0xd7, 0x7c, 0xa6, 0x87, 0xb0,
-	0x89, 0x2b, 0xab, 0x50, 0x2f, 0x36, 0x2b, 0x9e, 0xe7, 0xec, 0xb2, 0xe4, 0x74, 0xb2, 0x7f, 0x4e,
-	0xa9, 0x5b, 0x9a, 0x7d, 0x9e, 0x19, 0xbd, 0xad, 0x79, 0x8d, 0x6f, 0x42, 0x0f, 0x6e, 0xb3, 0x6b,
-	0x3d, 0x20, 0x20, 0x37, 0xbb, 0xb4, 0x9c, 0xb9, 0x4b, 0x77, 0xa9, 0x78, 0xe7, 0xbb, 0xa5, 0xee,
-	0x53, 0x56, 0x0f, 0xd7, 0x9d, 0xa6, 0x47, 0x4f, 0x62, 0x3e, 0xc5, 0x7e, 0x4e, 0xab, 0x2f, 0x98,
-	0x55, 0xa8, 0x93, 0x66, 0xa9, 0x77, 0xb4, 0x2a, 0xe6, 0x96, 0xbb, 0x63, 0x26, 0xa3, 0x66, 0x1e,
-	0x67, 0x80, 0x60, 0x15, 0x53, 0xbb, 0xee, 0x1f, 0x76, 0xb7, 0x2f, 0xaa, 0xd7, 0xa9, 0xc2, 0xaf,
-	0xc2, 0xe3, 0x6c, 0x61, 0x93, 0xf9, 0xc2, 0x26, 0x5f, 0x0b, 0x9b, 0xbc, 0x2f, 0x6d, 0x63, 0xbe,
-	0xb4, 0x8d, 0x8f, 0xa5, 0x6d, 0x3c, 0x5d, 0x87, 0x02, 0x87, 0x13, 0xdf, 0x09, 0x64, 0xe4, 0x6a,
-	0x35, 0x39, 0x18, 0x88, 0x40, 0xc0, 0xc8, 0x0d, 0x65, 0x6b, 0x9d, 0xf1, 0x34, 0x4b, 0xb9, 0xb5,
-	0x8e, 0x19, 0x5f, 0xc7, 0x5c, 0xf9, 0xe5, 0x34, 0xde, 0xab, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0xb8, 0x67, 0x4f, 0xaf, 0x96, 0x02, 0x00, 0x00,
+	// 572 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xc1, 0x6e, 0x13, 0x31,
+	0x10, 0xcd, 0xa6, 0x21, 0x05, 0x17, 0xd1, 0xd6, 0x05, 0xb4, 0x54, 0x68, 0x53, 0x45, 0x1c, 0x52,
+	0xa4, 0xd8, 0x24, 0x5c, 0x90, 0x38, 0x25, 0x80, 0x10, 0x12, 0x48, 0x28, 0xa5, 0x17, 0x2e, 0xc1,
+	0xbb, 0xeb, 0x6c, 0x2d, 0xb2, 0xf6, 0x6a, 0xc7, 0x41, 0xed, 0x57, 0xd0, 0xaf, 0xe0, 0xc0, 0x89,
+	0xcf, 0xe8, 0xb1, 0x12, 0x17, 0x4e, 0x14, 0x25, 0x3f, 0x82, 0xec, 0x75, 0x42, 0x12, 0x50, 0xda,
+	0x0b, 0xa7, 0x64, 0xd6, 0x6f, 0xde, 0x8c, 0xdf, 0x9b, 0x31, 0x7a, 0x18, 0x0a, 0x0d, 0x4a, 0x26,
+	0x14, 0x52, 0x96, 0x6b, 0x16, 0x45, 0x6a, 0x24, 0x35, 0xfd, 0xd4, 0x0a, 0xb9, 0x66, 0x2d, 0x9a,
+	0x70, 0xc9, 0x41, 0x00, 0xc9, 0x72, 0xa5, 0x15, 0xbe, 0xef, 0xb0, 0x64, 0x1e, 0x4b, 0x1c, 0x76,
+	0xf7, 0x76, 0xa2, 0x12, 0x65, 0x81, 0xd4, 0xfc, 0x2b, 0x72, 0x76, 0x83, 0x48, 0x41, 0xaa, 0x80,
+	0x86, 0x0c, 0xf8, 0x8c, 0x36, 0x52, 0x42, 0xba, 0xf3, 0x5a, 0xa2, 0x54, 0x32, 0xe4, 0xd4, 0x46,
+	0xe1, 0x68, 0x40, 0xb5, 0x48, 0x39, 0x68, 0x96, 0x66, 0x0e, 0xb0, 0xbf, 0xb2, 0xc1, 0x8c, 0xe5,
+	0x2c, 0x85, 0x2b, 0x41, 0x53, 0x15, 0xf3, 0xa1, 0x83, 0xd6, 0x3f, 0x7b, 0x68, 0xbb, 0x33, 0xd2,
+	0x47, 0x5c, 0x6a, 0x11, 0x31, 0xad, 0xf2, 0xe7, 0x4c, 0x33, 0xec, 0xa3, 0x75, 0x16, 0xc7, 0x39,
+	0x07, 0xf0, 0xbd, 0x3d, 0xaf, 0x71, 0xa3, 0x37, 0x0d, 0xf1, 0x07, 0x74, 0x8b, 0xcd, 0xc3, 0xc1,
+	0x2f, 0xef, 0xad, 0x35, 0x36, 0xda, 0x6d, 0xb2, 0x4a, 0x13, 0xd2, 0x29, 0xe2, 0x85, 0x4a, 0xdd,
+	0xca, 0xd9, 0xcf, 0x5a, 0xa9, 0xb7, 0xc4, 0x57, 0xff, 0xe6, 0xa1, 0xcd, 0x83, 0x8c, 0xcb, 0xf8,
+	0xb5, 0x48, 0x85, 0x7e, 0x21, 0x75, 0x7e, 0x82, 0x9f, 0xa0, 0x8a, 0x91, 0xc3, 0x36, 0xb3, 0xd1,
+	0xde, 0x25, 0x85, 0x56, 0x64, 0xaa, 0x15, 0x79, 0x37, 0xd5, 0xaa, 0x7b, 0xdd, 0x70, 0x9e, 0x5e,
+	0xd4, 0xbc, 0x9e, 0xcd, 0xc0, 0x11, 0xaa, 0xb2, 0xd4, 0x94, 0x76, 0x7d, 0xde, 0x23, 0x85, 0x0f,
+	0xc4, 0xf8, 0x30, 0x6b, 0xef, 0x99, 0x12, 0xb2, 0xfb, 0xc8, 0xa4, 0x7e, 0xbd, 0xa8, 0x35, 0x12,
+	0xa1, 0x8f, 0x46, 0x21, 0x89, 0x54, 0x4a, 0x9d, 0x69, 0xc5, 0x4f, 0x13, 0xe2, 0x8f, 0x54, 0x9f,
+	0x64, 0x1c, 0x6c, 0x02, 0xf4, 0x1c, 0x75, 0xfd, 0x4b, 0x19, 0xed, 0xfc, 0x69, 0xf9, 0x10, 0x58,
+	0xc2, 0x2f, 0x91, 0x71, 0x1f, 0x6d, 0x2d, 0x5c, 0xbb, 0x2f, 0x62, 0xbf, 0x6c, 0x21, 0x9b, 0x0b,
+	0xdf, 0x5f, 0xc5, 0xe6, 0x06, 0x43, 0x43, 0x0b, 0xfe, 0xda, 0x7f, 0xb8, 0x41, 0x41, 0x8d, 0xef,
+	0xa2, 0x6a, 0xc6, 0x73, 0xa1, 0x62, 0xbf, 0x62, 0xbb, 0x70, 0x11, 0x7e, 0x83, 0xd6, 0xb9, 0xd4,
+	0xb9, 0xe0, 0xe0, 0x5f, 0xb3, 0xd5, 0x9b, 0xab, 0x7d, 0x5e, 0x32, 0xce, 0x59, 0x3c, 0xe5, 0xa8,
+	0x7f, 0x2f, 0xa3, 0x9b, 0x2f, 0x8b, 0x55, 0x3a, 0xd0, 0x4c, 0x73, 0xdc, 0x45, 0xd5, 0x62, 0x72,
+	0x9d, 0xb5, 0x0f, 0x56, 0xd3, 0xbf, 0xb5, 0x58, 0xc7, 0xea, 0x32, 0x71, 0x1b, 0xdd, 0x91, 0xfc,
+	0x58, 0xf7, 0xff, 0x29, 0x68, 0xa5, 0xb7, 0x63, 0x0e, 0x3b, 0x4b, 0xa2, 0xc6, 0x08, 0x2f, 0xc2,
+	0x63, 0xa6, 0x99, 0x13, 0x98, 0x5e, 0x32, 0xca, 0xcb, 0xdb, 0xe2, 0xda, 0xd9, 0x66, 0x7f, 0xad,
+	0x11, 0x47, 0x18, 0x8c, 0x20, 0x7d, 0xab, 0x72, 0x7f, 0x64, 0x06, 0x03, 0xfc, 0x8a, 0xad, 0xd2,
+	0xba, 0xaa, 0x90, 0xb3, 0x71, 0x72, 0x75, 0xb6, 0x60, 0xf1, 0x08, 0xba, 0x87, 0x67, 0xe3, 0xc0,
+	0x3b, 0x1f, 0x07, 0xde, 0xaf, 0x71, 0xe0, 0x9d, 0x4e, 0x82, 0xd2, 0xf9, 0x24, 0x28, 0xfd, 0x98,
+	0x04, 0xa5, 0xf7, 0x4f, 0xe7, 0x06, 0xc1, 0x95, 0x53, 0x83, 0x81, 0x88, 0x04, 0x1b, 0xd2, 0x44,
+	0x35, 0xdd, 0x27, 0x7a, 0x5c, 0x3c, 0x14, 0xcd, 0xe9, 0x4b, 0x61, 0x27, 0x24, 0xac, 0xda, 0xf5,
+	0x7a, 0xfc, 0x7b, 0x00, 0x9c, 0xeb, 0x9a, 0xdf, 0x1a, 0x05, 0x00, 0x00,
 }
 
 func (m *AuthenticatorData) Marshal() (dAtA []byte, err error) {
@@ -225,6 +397,123 @@ func (m *AuthenticatorData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SpendLimitEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpendLimitEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpendLimitEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintGenesis(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SpendLimitUsageData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpendLimitUsageData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpendLimitUsageData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Period) > 0 {
+		i -= len(m.Period)
+		copy(dAtA[i:], m.Period)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Period)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Limits) > 0 {
+		for iNdEx := len(m.Limits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Limits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.AuthenticatorId) > 0 {
+		i -= len(m.AuthenticatorId)
+		copy(dAtA[i:], m.AuthenticatorId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.AuthenticatorId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -245,6 +534,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SpendLimitUsages) > 0 {
+		for iNdEx := len(m.SpendLimitUsages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimitUsages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.AuthenticatorData) > 0 {
 		for iNdEx := len(m.AuthenticatorData) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -307,6 +610,56 @@ func (m *AuthenticatorData) Size() (n int) {
 	return n
 }
 
+func (m *SpendLimitEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SpendLimitUsageData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.AuthenticatorId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Limits) > 0 {
+		for _, e := range m.Limits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = len(m.Period)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *GenesisState) Size() (n int) {
 	if m == nil {
 		return 0
@@ -324,6 +677,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.SpendLimitUsages) > 0 {
+		for _, e := range m.SpendLimitUsages {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -449,7 +808,7 @@ func (m *AuthenticatorData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *SpendLimitEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -472,15 +831,15 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: SpendLimitEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SpendLimitEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -507,32 +866,13 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextAuthenticatorId", wireType)
-			}
-			m.NextAuthenticatorId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NextAuthenticatorId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthenticatorData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -559,8 +899,392 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AuthenticatorData = append(m.AuthenticatorData, AuthenticatorData{})
-			if err := m.AuthenticatorData[len(m.AuthenticatorData)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SpendLimitUsageData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpendLimitUsageData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpendLimitUsageData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthenticatorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuthenticatorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Limits = append(m.Limits, types1.Coin{})
+			if err := m.Limits[len(m.Limits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Period = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, SpendLimitEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextAuthenticatorId", wireType)
+			}
+			m.NextAuthenticatorId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextAuthenticatorId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthenticatorData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuthenticatorData = append(m.AuthenticatorData, AuthenticatorData{})
+			if err := m.AuthenticatorData[len(m.AuthenticatorData)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimitUsages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendLimitUsages = append(m.SpendLimitUsages, SpendLimitUsageData{})
+			if err := m.SpendLimitUsages[len(m.SpendLimitUsages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3,6 +3,7 @@ package types
 import (
 	fmt "fmt"
 	"strings"
+	"time"
 
 	storetypes "cosmossdk.io/store/types"
 	db "github.com/cosmos/cosmos-db"
@@ -25,6 +26,9 @@ const (
 	AttributeValueCategory        = ModuleName
 	AttributeKeyAuthenticatorType = "authenticator_type"
 	AttributeKeyAuthenticatorId   = "authenticator_id"
+	AttributeKeyAccount           = "account"
+
+	EventTypeAuthenticatorExpired = "authenticator_expired"
 
 	AtrributeKeyIsSmartAccountActive = "is_smart_account_active"
 
@@ -36,6 +40,9 @@ var (
 	// Store prefix keys
 	KeyNextAccountAuthenticatorIdPrefix = []byte{0x01}
 	KeyAccountAuthenticatorsPrefix      = []byte{0x02}
+	KeySpendLimitUsagePrefix            = []byte{0x03}
+	KeySpendLimitSnapshotPrefix         = []byte{0x04}
+	KeyAuthenticatorExpiryPrefix        = []byte{0x05}
 
 	// Parameter keys
 	KeyMaximumUnauthenticatedGas = []byte("MaximumUnauthenticatedGas")
@@ -59,6 +66,25 @@ func KeyAccountAuthenticatorsPrefixId() []byte {
 	return BuildKey(KeyAccountAuthenticatorsPrefix)
 }
 
+func KeySpendLimitUsage(account sdk.AccAddress, authenticatorId string) []byte {
+	return BuildKey(KeySpendLimitUsagePrefix, account.String(), authenticatorId)
+}
+
+func KeySpendLimitSnapshot(account sdk.AccAddress, authenticatorId string) []byte {
+	return BuildKey(KeySpendLimitSnapshotPrefix, account.String(), authenticatorId)
+}
+
+// KeyAuthenticatorExpiryPrefixId returns the prefix of the authenticators indexed by expiry.
+func KeyAuthenticatorExpiryPrefixId() []byte {
+	return BuildKey(KeyAuthenticatorExpiryPrefix)
+}
+
+// KeyAuthenticatorExpiry returns the key indexing an authenticator by expiry. The expiry is formatted
+// as a sortable time string, so that the index is iterated in expiry order.
+func KeyAuthenticatorExpiry(expiry time.Time, account sdk.AccAddress, id uint64) []byte {
+	return BuildKey(KeyAuthenticatorExpiryPrefix, sdk.FormatTimeString(expiry), account.String(), id)
+}
+
 // BuildKey creates a key by concatenating the provided elements with the key separator.
 func BuildKey(elements ...interface{}) []byte {
 	strElements := make([]string, len(elements))
@@ -6,10 +6,14 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "github.com/gogo/protobuf/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -17,12 +21,14 @@ import (
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -301,6 +307,145 @@ func (m *GetAuthenticatorResponse) GetAccountAuthenticator() *AccountAuthenticat
 	return nil
 }
 
+// GetSpendLimitRequest defines the Query/GetSpendLimit request type.
+type GetSpendLimitRequest struct {
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// authenticator_id is the id of the SpendLimit authenticator, which is a
+	// composite id such as 3.1 for a sub-authenticator.
+	AuthenticatorId string `protobuf:"bytes,2,opt,name=authenticator_id,json=authenticatorId,proto3" json:"authenticator_id,omitempty"`
+}
+
+func (m *GetSpendLimitRequest) Reset()         { *m = GetSpendLimitRequest{} }
+func (m *GetSpendLimitRequest) String() string { return proto.CompactTextString(m) }
+func (*GetSpendLimitRequest) ProtoMessage()    {}
+func (*GetSpendLimitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5fa4c2125aa7288, []int{6}
+}
+func (m *GetSpendLimitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetSpendLimitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetSpendLimitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetSpendLimitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSpendLimitRequest.Merge(m, src)
+}
+func (m *GetSpendLimitRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetSpendLimitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSpendLimitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSpendLimitRequest proto.InternalMessageInfo
+
+func (m *GetSpendLimitRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *GetSpendLimitRequest) GetAuthenticatorId() string {
+	if m != nil {
+		return m.AuthenticatorId
+	}
+	return ""
+}
+
+// GetSpendLimitResponse defines the Query/GetSpendLimit response type.
+type GetSpendLimitResponse struct {
+	// limits are the amounts which can be spent in a period.
+	Limits github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=limits,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"limits"`
+	// spent are the amounts spent in the rolling period ending at the block
+	// time.
+	Spent github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=spent,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spent"`
+	// remaining are the amounts which can still be spent in the rolling period.
+	Remaining github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=remaining,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"remaining"`
+	// period is the length of a period: day, week or month.
+	Period string `protobuf:"bytes,4,opt,name=period,proto3" json:"period,omitempty"`
+	// period_end is the time at which the oldest spending leaves the rolling
+	// period, unset when nothing is spent.
+	PeriodEnd *time.Time `protobuf:"bytes,5,opt,name=period_end,json=periodEnd,proto3,stdtime" json:"period_end,omitempty"`
+}
+
+func (m *GetSpendLimitResponse) Reset()         { *m = GetSpendLimitResponse{} }
+func (m *GetSpendLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*GetSpendLimitResponse) ProtoMessage()    {}
+func (*GetSpendLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5fa4c2125aa7288, []int{7}
+}
+func (m *GetSpendLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetSpendLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetSpendLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetSpendLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSpendLimitResponse.Merge(m, src)
+}
+func (m *GetSpendLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetSpendLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSpendLimitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSpendLimitResponse proto.InternalMessageInfo
+
+func (m *GetSpendLimitResponse) GetLimits() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Limits
+	}
+	return nil
+}
+
+func (m *GetSpendLimitResponse) GetSpent() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Spent
+	}
+	return nil
+}
+
+func (m *GetSpendLimitResponse) GetRemaining() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Remaining
+	}
+	return nil
+}
+
+func (m *GetSpendLimitResponse) GetPeriod() string {
+	if m != nil {
+		return m.Period
+	}
+	return ""
+}
+
+func (m *GetSpendLimitResponse) GetPeriodEnd() *time.Time {
+	if m != nil {
+		return m.PeriodEnd
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "bitsong.smartaccount.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "bitsong.smartaccount.v1beta1.QueryParamsResponse")
@@ -308,6 +453,8 @@ func init() {
 	proto.RegisterType((*GetAuthenticatorsResponse)(nil), "bitsong.smartaccount.v1beta1.GetAuthenticatorsResponse")
 	proto.RegisterType((*GetAuthenticatorRequest)(nil), "bitsong.smartaccount.v1beta1.GetAuthenticatorRequest")
 	proto.RegisterType((*GetAuthenticatorResponse)(nil), "bitsong.smartaccount.v1beta1.GetAuthenticatorResponse")
+	proto.RegisterType((*GetSpendLimitRequest)(nil), "bitsong.smartaccount.v1beta1.GetSpendLimitRequest")
+	proto.RegisterType((*GetSpendLimitResponse)(nil), "bitsong.smartaccount.v1beta1.GetSpendLimitResponse")
 }
 
 func init() {
@@ -315,40 +462,54 @@ func init() {
 }
 
 var fileDescriptor_c5fa4c2125aa7288 = []byte{
-	// 524 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0xcd, 0x96, 0x10, 0xc4, 0xf6, 0x40, 0x59, 0x52, 0x30, 0x51, 0x64, 0x22, 0xab, 0x87, 0x14,
-	0xa9, 0xde, 0x26, 0x40, 0x39, 0x70, 0xa1, 0xb9, 0x00, 0x37, 0xb0, 0xc4, 0x01, 0x0e, 0x54, 0x6b,
-	0x67, 0xeb, 0xae, 0x14, 0x7b, 0x5c, 0xef, 0x1a, 0x51, 0x55, 0xbd, 0x80, 0xc4, 0x19, 0xa9, 0x7f,
-	0xc1, 0x37, 0xf0, 0x01, 0x95, 0xb8, 0x54, 0xe2, 0xc2, 0x09, 0xa1, 0x84, 0x0f, 0x41, 0xb5, 0xd7,
-	0x01, 0x27, 0xc6, 0x34, 0xbd, 0x65, 0x27, 0xef, 0xcd, 0x7b, 0x6f, 0x66, 0x64, 0xdc, 0x75, 0x85,
-	0x92, 0x10, 0xfa, 0x54, 0x06, 0x2c, 0x56, 0xcc, 0xf3, 0x20, 0x09, 0x15, 0x7d, 0xdb, 0x73, 0xb9,
-	0x62, 0x3d, 0xba, 0x9f, 0xf0, 0xf8, 0xc0, 0x8e, 0x62, 0x50, 0x40, 0xda, 0x1a, 0x69, 0xff, 0x8d,
-	0xb4, 0x35, 0xb2, 0xd5, 0xf4, 0xc1, 0x87, 0x14, 0x48, 0xcf, 0x7e, 0x65, 0x9c, 0x56, 0xdb, 0x07,
-	0xf0, 0x47, 0x9c, 0xb2, 0x48, 0x50, 0x16, 0x86, 0xa0, 0x98, 0x12, 0x10, 0x4a, 0xfd, 0xef, 0x5d,
-	0x0f, 0x64, 0x00, 0x92, 0xba, 0x4c, 0xf2, 0x4c, 0x6a, 0x2a, 0x1c, 0x31, 0x5f, 0x84, 0x29, 0x58,
-	0x63, 0xd7, 0x2b, 0x7d, 0x46, 0x2c, 0x66, 0x81, 0x3c, 0x17, 0x34, 0x80, 0x21, 0x1f, 0x69, 0xa8,
-	0xd5, 0xc4, 0xe4, 0xc5, 0x99, 0xee, 0xf3, 0x94, 0xef, 0xf0, 0xfd, 0x84, 0x4b, 0x65, 0xbd, 0xc2,
-	0x37, 0x0a, 0x55, 0x19, 0x41, 0x28, 0x39, 0x19, 0xe0, 0x46, 0xa6, 0x63, 0xa0, 0x0e, 0xea, 0x2e,
-	0xf7, 0xd7, 0xec, 0xaa, 0x89, 0xd8, 0x19, 0x7b, 0x50, 0x3f, 0xf9, 0x71, 0xa7, 0xe6, 0x68, 0xa6,
-	0x75, 0x1f, 0x1b, 0x4f, 0xb8, 0xda, 0x4e, 0xd4, 0x1e, 0x0f, 0x95, 0xf0, 0x98, 0x82, 0x38, 0x97,
-	0x25, 0x06, 0xbe, 0xa2, 0x7b, 0xa4, 0x02, 0x57, 0x9d, 0xfc, 0x69, 0x7d, 0x44, 0xf8, 0x76, 0x09,
-	0x4d, 0xfb, 0x12, 0xf8, 0xa6, 0x06, 0xee, 0xb0, 0x02, 0xc2, 0x40, 0x9d, 0x4b, 0xdd, 0xe5, 0x7e,
-	0xbf, 0xda, 0xe7, 0x76, 0xf6, 0x2e, 0x34, 0x77, 0x56, 0x59, 0x49, 0x55, 0x5a, 0x6f, 0xf0, 0xad,
-	0x59, 0x1f, 0xff, 0x75, 0x4f, 0xd6, 0xf1, 0x4a, 0xc1, 0xd7, 0x8e, 0x18, 0x1a, 0x4b, 0x1d, 0xd4,
-	0xad, 0x3b, 0xd7, 0x0a, 0xf5, 0x67, 0x43, 0xeb, 0x03, 0x9a, 0x9f, 0xcf, 0x34, 0xa7, 0x8f, 0x57,
-	0x4b, 0x73, 0xea, 0x75, 0x5c, 0x24, 0x66, 0xb3, 0x2c, 0x66, 0xff, 0x73, 0x1d, 0x5f, 0x4e, 0x0f,
-	0x80, 0x1c, 0x23, 0xdc, 0xc8, 0xf6, 0x48, 0x36, 0xab, 0xdb, 0xcf, 0x9f, 0x51, 0xab, 0xb7, 0x00,
-	0x23, 0x8b, 0x68, 0xad, 0xbd, 0xff, 0xf6, 0xeb, 0x78, 0xc9, 0x24, 0x6d, 0x5a, 0x7a, 0xc3, 0xd9,
-	0x11, 0x91, 0xaf, 0x08, 0xaf, 0xcc, 0x4e, 0x89, 0x3c, 0xa8, 0x56, 0xfb, 0xc7, 0xda, 0x5a, 0x5b,
-	0x8b, 0xd2, 0xb4, 0xd3, 0xa7, 0xa9, 0xd3, 0x01, 0x79, 0x5c, 0xee, 0xb4, 0xb0, 0x20, 0x7a, 0xa8,
-	0xcb, 0x47, 0xf4, 0x70, 0xf6, 0x12, 0x8e, 0xc8, 0x17, 0x84, 0xaf, 0xcf, 0x1d, 0x37, 0x59, 0xd0,
-	0xd7, 0x74, 0xe8, 0x0f, 0x17, 0xe6, 0xe9, 0x40, 0x5b, 0x69, 0xa0, 0x4d, 0x62, 0x9f, 0x23, 0x90,
-	0xfc, 0x93, 0x68, 0xf0, 0xf2, 0x64, 0x6c, 0xa2, 0xd3, 0xb1, 0x89, 0x7e, 0x8e, 0x4d, 0xf4, 0x69,
-	0x62, 0xd6, 0x4e, 0x27, 0x66, 0xed, 0xfb, 0xc4, 0xac, 0xbd, 0x7e, 0xe4, 0x0b, 0xb5, 0x97, 0xb8,
-	0xb6, 0x07, 0x41, 0xde, 0x13, 0x76, 0x77, 0x85, 0x27, 0xd8, 0x88, 0xfa, 0xb0, 0x91, 0xcb, 0xbc,
-	0xcb, 0x84, 0x36, 0x72, 0x25, 0x75, 0x10, 0x71, 0xe9, 0x36, 0xd2, 0x0f, 0xd4, 0xbd, 0xdf, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0xfa, 0x21, 0xbf, 0xde, 0xa0, 0x05, 0x00, 0x00,
+	// 742 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xc1, 0x4f, 0x13, 0x4b,
+	0x1c, 0xee, 0x42, 0xdb, 0x97, 0x0e, 0x79, 0x79, 0xbc, 0x79, 0x85, 0xb7, 0x34, 0x64, 0x4b, 0x36,
+	0x1c, 0x8a, 0x09, 0xbb, 0x50, 0x14, 0x0f, 0x26, 0x0a, 0x35, 0x8a, 0x26, 0x1e, 0xb4, 0xea, 0x41,
+	0x4d, 0x6c, 0xa6, 0xbb, 0xc3, 0x32, 0xb1, 0x3b, 0xb3, 0x74, 0xa6, 0x46, 0x42, 0xb8, 0x68, 0xe2,
+	0x99, 0x84, 0x3f, 0xc1, 0x9b, 0x7f, 0x83, 0x07, 0x8f, 0x24, 0x5e, 0x48, 0xbc, 0x78, 0x12, 0x03,
+	0x5e, 0xfd, 0x1f, 0x4c, 0x67, 0x66, 0x0b, 0x6d, 0xd7, 0x42, 0x09, 0xa7, 0x76, 0x66, 0xbf, 0xdf,
+	0xef, 0xfb, 0xbe, 0x99, 0xf9, 0x7e, 0xa0, 0x54, 0x27, 0x82, 0x33, 0x1a, 0xb8, 0x3c, 0x44, 0x4d,
+	0x81, 0x3c, 0x8f, 0xb5, 0xa8, 0x70, 0x5f, 0x2f, 0xd6, 0xb1, 0x40, 0x8b, 0xee, 0x66, 0x0b, 0x37,
+	0xb7, 0x9c, 0xa8, 0xc9, 0x04, 0x83, 0xd3, 0x1a, 0xe9, 0x9c, 0x46, 0x3a, 0x1a, 0x59, 0xc8, 0x07,
+	0x2c, 0x60, 0x12, 0xe8, 0xb6, 0xff, 0xa9, 0x9a, 0xc2, 0x74, 0xc0, 0x58, 0xd0, 0xc0, 0x2e, 0x8a,
+	0x88, 0x8b, 0x28, 0x65, 0x02, 0x09, 0xc2, 0x28, 0xd7, 0x5f, 0xaf, 0x78, 0x8c, 0x87, 0x8c, 0xbb,
+	0x75, 0xc4, 0xb1, 0xa2, 0xea, 0x10, 0x47, 0x28, 0x20, 0x54, 0x82, 0x35, 0xd6, 0x3a, 0x8d, 0x8d,
+	0x51, 0x1e, 0x23, 0xf1, 0xf7, 0xa2, 0x66, 0x92, 0xab, 0x7a, 0x6b, 0xdd, 0x15, 0x24, 0xc4, 0x5c,
+	0xa0, 0x30, 0xd2, 0x80, 0xb9, 0x81, 0x46, 0x23, 0xd4, 0x44, 0x21, 0x3f, 0x17, 0x34, 0x64, 0x3e,
+	0x6e, 0x68, 0xa8, 0x9d, 0x07, 0xf0, 0x51, 0x5b, 0xf8, 0x43, 0x59, 0x5f, 0xc5, 0x9b, 0x2d, 0xcc,
+	0x85, 0xfd, 0x0c, 0xfc, 0xd7, 0xb5, 0xcb, 0x23, 0x46, 0x39, 0x86, 0x15, 0x90, 0x55, 0x3c, 0xa6,
+	0x31, 0x63, 0x94, 0xc6, 0xca, 0xb3, 0xce, 0xa0, 0x23, 0x75, 0x54, 0x75, 0x25, 0xbd, 0xff, 0xbd,
+	0x98, 0xaa, 0xea, 0x4a, 0xfb, 0x2a, 0x30, 0xd7, 0xb0, 0x58, 0x6d, 0x89, 0x0d, 0x4c, 0x05, 0xf1,
+	0x90, 0x60, 0xcd, 0x98, 0x16, 0x9a, 0xe0, 0x2f, 0xdd, 0x43, 0x12, 0xe4, 0xaa, 0xf1, 0xd2, 0x7e,
+	0x6f, 0x80, 0xa9, 0x84, 0x32, 0xad, 0x8b, 0x80, 0x49, 0x0d, 0xac, 0xa1, 0x2e, 0x84, 0x69, 0xcc,
+	0x8c, 0x96, 0xc6, 0xca, 0xe5, 0xc1, 0x3a, 0x57, 0xd5, 0xba, 0xab, 0x79, 0x75, 0x02, 0x25, 0xec,
+	0x72, 0xfb, 0x25, 0xf8, 0xbf, 0x57, 0xc7, 0x99, 0xea, 0xe1, 0x1c, 0x18, 0xef, 0xd2, 0x55, 0x23,
+	0xbe, 0x39, 0x32, 0x63, 0x94, 0xd2, 0xd5, 0x7f, 0xba, 0xf6, 0xef, 0xfb, 0xf6, 0x3b, 0xa3, 0xff,
+	0x7c, 0x3a, 0x3e, 0x03, 0x30, 0x91, 0xe8, 0x53, 0x5f, 0xc7, 0x45, 0x6c, 0xe6, 0x93, 0x6c, 0xda,
+	0x2f, 0x40, 0x7e, 0x0d, 0x8b, 0xc7, 0x11, 0xa6, 0xfe, 0x03, 0x12, 0x12, 0x71, 0x71, 0x8b, 0xb9,
+	0x7e, 0x8b, 0x1f, 0x46, 0xc1, 0x44, 0x4f, 0x77, 0xed, 0xcf, 0x03, 0xd9, 0x46, 0x7b, 0x23, 0xbe,
+	0xb7, 0x29, 0x47, 0x85, 0xc6, 0x69, 0x87, 0xa6, 0xe3, 0xe3, 0x36, 0x23, 0xb4, 0xb2, 0xd0, 0x7e,
+	0x54, 0x1f, 0x0f, 0x8b, 0xa5, 0x80, 0x88, 0x8d, 0x56, 0xdd, 0xf1, 0x58, 0xe8, 0xea, 0x84, 0xa9,
+	0x9f, 0x79, 0xee, 0xbf, 0x72, 0xc5, 0x56, 0x84, 0xb9, 0x2c, 0xe0, 0x55, 0xdd, 0x1a, 0x22, 0x90,
+	0xe1, 0x11, 0xa6, 0xc2, 0x1c, 0xb9, 0x7c, 0x0e, 0xd5, 0x19, 0x12, 0x90, 0x6b, 0xe2, 0x10, 0x11,
+	0x4a, 0x68, 0x60, 0x8e, 0x5e, 0x3e, 0xcd, 0x49, 0x77, 0x38, 0x09, 0xb2, 0x11, 0x6e, 0x12, 0xe6,
+	0x9b, 0x69, 0x79, 0xda, 0x7a, 0x05, 0x6f, 0x01, 0xa0, 0xfe, 0xd5, 0x30, 0xf5, 0xcd, 0x8c, 0x7c,
+	0x1f, 0x05, 0x47, 0xcd, 0x18, 0x27, 0x9e, 0x31, 0xce, 0x93, 0x78, 0xc6, 0x54, 0xd2, 0xbb, 0x87,
+	0x45, 0xa3, 0x9a, 0x53, 0x35, 0x77, 0xa8, 0x5f, 0xfe, 0x95, 0x01, 0x19, 0x39, 0x03, 0xe0, 0x9e,
+	0x01, 0xb2, 0x2a, 0xca, 0x70, 0x61, 0xf0, 0x0b, 0xeb, 0x9f, 0x24, 0x85, 0xc5, 0x21, 0x2a, 0xd4,
+	0x2b, 0xb0, 0x67, 0xdf, 0x7e, 0xfd, 0xb9, 0x37, 0x62, 0xc1, 0x69, 0x37, 0x71, 0x8c, 0xa9, 0x39,
+	0x02, 0xbf, 0x18, 0x60, 0xbc, 0x37, 0x28, 0xf0, 0xda, 0x60, 0xb6, 0x3f, 0x24, 0xb7, 0xb0, 0x3c,
+	0x6c, 0x99, 0x56, 0x7a, 0x4f, 0x2a, 0xad, 0xc0, 0x95, 0x64, 0xa5, 0x5d, 0x0f, 0xdf, 0xdd, 0xd6,
+	0xdb, 0x3b, 0xee, 0x76, 0x6f, 0x52, 0x76, 0xe0, 0x27, 0x03, 0xfc, 0xdb, 0x4b, 0xc3, 0xe1, 0x90,
+	0xba, 0x3a, 0x87, 0x7e, 0x7d, 0xe8, 0x3a, 0x6d, 0x68, 0x59, 0x1a, 0x5a, 0x80, 0xce, 0x39, 0x0c,
+	0xf1, 0x13, 0x47, 0xf0, 0xb3, 0x01, 0xfe, 0xee, 0x8a, 0x34, 0x2c, 0x9f, 0x29, 0xa1, 0x6f, 0xba,
+	0x14, 0x96, 0x86, 0xaa, 0xd1, 0x92, 0xef, 0x4a, 0xc9, 0x2b, 0xf0, 0x66, 0xb2, 0xe4, 0x76, 0x20,
+	0xfd, 0x9a, 0x8c, 0xfe, 0xc0, 0x1b, 0xa8, 0x3c, 0xdd, 0x3f, 0xb2, 0x8c, 0x83, 0x23, 0xcb, 0xf8,
+	0x71, 0x64, 0x19, 0xbb, 0xc7, 0x56, 0xea, 0xe0, 0xd8, 0x4a, 0x7d, 0x3b, 0xb6, 0x52, 0xcf, 0x6f,
+	0x9c, 0xca, 0xa5, 0xe6, 0x60, 0xeb, 0xeb, 0xc4, 0x23, 0xa8, 0xe1, 0x06, 0x6c, 0x3e, 0xa6, 0x7d,
+	0xa3, 0x88, 0xe7, 0x63, 0x66, 0x19, 0xd8, 0x7a, 0x56, 0x66, 0x6d, 0xe9, 0xf7, 0x00, 0xef, 0xb4,
+	0xda, 0xb3, 0xa7, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -367,6 +528,7 @@ type QueryClient interface {
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	GetAuthenticator(ctx context.Context, in *GetAuthenticatorRequest, opts ...grpc.CallOption) (*GetAuthenticatorResponse, error)
 	GetAuthenticators(ctx context.Context, in *GetAuthenticatorsRequest, opts ...grpc.CallOption) (*GetAuthenticatorsResponse, error)
+	GetSpendLimit(ctx context.Context, in *GetSpendLimitRequest, opts ...grpc.CallOption) (*GetSpendLimitResponse, error)
 }
 
 type queryClient struct {
@@ -404,12 +566,22 @@ func (c *queryClient) GetAuthenticators(ctx context.Context, in *GetAuthenticato
 	return out, nil
 }
 
+func (c *queryClient) GetSpendLimit(ctx context.Context, in *GetSpendLimitRequest, opts ...grpc.CallOption) (*GetSpendLimitResponse, error) {
+	out := new(GetSpendLimitResponse)
+	err := c.cc.Invoke(ctx, "/bitsong.smartaccount.v1beta1.Query/GetSpendLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	GetAuthenticator(context.Context, *GetAuthenticatorRequest) (*GetAuthenticatorResponse, error)
 	GetAuthenticators(context.Context, *GetAuthenticatorsRequest) (*GetAuthenticatorsResponse, error)
+	GetSpendLimit(context.Context, *GetSpendLimitRequest) (*GetSpendLimitResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -425,6 +597,9 @@ func (*UnimplementedQueryServer) GetAuthenticator(ctx context.Context, req *GetA
 func (*UnimplementedQueryServer) GetAuthenticators(ctx context.Context, req *GetAuthenticatorsRequest) (*GetAuthenticatorsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAuthenticators not implemented")
 }
+func (*UnimplementedQueryServer) GetSpendLimit(ctx context.Context, req *GetSpendLimitRequest) (*GetSpendLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSpendLimit not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -484,6 +659,24 @@ func _Query_GetAuthenticators_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetSpendLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSpendLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetSpendLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitsong.smartaccount.v1beta1.Query/GetSpendLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetSpendLimit(ctx, req.(*GetSpendLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "bitsong.smartaccount.v1beta1.Query",
@@ -501,6 +694,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetAuthenticators",
 			Handler:    _Query_GetAuthenticators_Handler,
 		},
+		{
+			MethodName: "GetSpendLimit",
+			Handler:    _Query_GetSpendLimit_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "bitsong/smartaccount/v1beta1/query.proto",
@@ -699,6 +896,125 @@ func (m *GetAuthenticatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *GetSpendLimitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetSpendLimitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetSpendLimitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AuthenticatorId) > 0 {
+		i -= len(m.AuthenticatorId)
+		copy(dAtA[i:], m.AuthenticatorId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AuthenticatorId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetSpendLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetSpendLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetSpendLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PeriodEnd != nil {
+		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.PeriodEnd, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.PeriodEnd):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintQuery(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Period) > 0 {
+		i -= len(m.Period)
+		copy(dAtA[i:], m.Period)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Period)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Remaining) > 0 {
+		for iNdEx := len(m.Remaining) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Remaining[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Spent) > 0 {
+		for iNdEx := len(m.Spent) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Spent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Limits) > 0 {
+		for iNdEx := len(m.Limits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Limits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -787,6 +1103,58 @@ func (m *GetAuthenticatorResponse) Size() (n int) {
 	return n
 }
 
+func (m *GetSpendLimitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AuthenticatorId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetSpendLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Limits) > 0 {
+		for _, e := range m.Limits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Spent) > 0 {
+		for _, e := range m.Spent {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Remaining) > 0 {
+		for _, e := range m.Remaining {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.Period)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PeriodEnd != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.PeriodEnd)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1279,6 +1647,340 @@ func (m *GetAuthenticatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GetSpendLimitRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetSpendLimitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetSpendLimitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthenticatorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuthenticatorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetSpendLimitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetSpendLimitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetSpendLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Limits = append(m.Limits, types.Coin{})
+			if err := m.Limits[len(m.Limits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spent = append(m.Spent, types.Coin{})
+			if err := m.Spent[len(m.Spent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Remaining = append(m.Remaining, types.Coin{})
+			if err := m.Remaining[len(m.Remaining)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Period = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodEnd", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PeriodEnd == nil {
+				m.PeriodEnd = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.PeriodEnd, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0